@@ -29,10 +29,33 @@ type AddPriceFeedRequest struct {
 
 	// market for which the price feed is created
 	Market *v2.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
-	// source is the name of the price source to use, e.g. kraken, bitfinex, coinbase etc.
+	// source is the name of the price source to use, e.g. kraken, bitfinex,
+	// coinbase, binance, bitstamp etc.
+	// Bitstamp prices are those of the trades of its live trades channel and
+	// carry no volume, thus they are ignored by volume weighted composites.
 	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	// ticker is the ticker of the market, e.g. "XBT/USDT", "XBT/EUR" etc.
+	// The headers of a rest ticker are stored apart and removed from the ticker
+	// returned by the price feed.
 	Ticker string `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// sources is the list of price sources of a composite price feed, in
+	// alternative to source and ticker.
+	Sources []*PriceSource `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	// aggregation is the way the prices of the sources of a composite price
+	// feed are combined.
+	Aggregation *PriceAggregation `protobuf:"bytes,5,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// legs is the list of tickers whose prices, or their inverse, are
+	// multiplied to compute the price of a derived price feed, in alternative
+	// to source and ticker. No price is produced while the last one of any leg
+	// is older than legs_max_age.
+	Legs []*PriceLeg `protobuf:"bytes,6,rep,name=legs,proto3" json:"legs,omitempty"`
+	// transformation is applied to the prices before updating the market.
+	Transformation *PriceTransformation `protobuf:"bytes,7,opt,name=transformation,proto3" json:"transformation,omitempty"`
+	// legs_max_age is the number of seconds after which the last price of a
+	// leg of a derived price feed is considered stale, defaults to 60. The
+	// sources send again their unchanged prices at least every 20 seconds
+	// while connected, so that quiet legs don't go stale.
+	LegsMaxAge uint32 `protobuf:"varint,8,opt,name=legs_max_age,json=legsMaxAge,proto3" json:"legs_max_age,omitempty"`
 }
 
 func (x *AddPriceFeedRequest) Reset() {
@@ -88,6 +111,41 @@ func (x *AddPriceFeedRequest) GetTicker() string {
 	return ""
 }
 
+func (x *AddPriceFeedRequest) GetSources() []*PriceSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *AddPriceFeedRequest) GetAggregation() *PriceAggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return nil
+}
+
+func (x *AddPriceFeedRequest) GetLegs() []*PriceLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *AddPriceFeedRequest) GetTransformation() *PriceTransformation {
+	if x != nil {
+		return x.Transformation
+	}
+	return nil
+}
+
+func (x *AddPriceFeedRequest) GetLegsMaxAge() uint32 {
+	if x != nil {
+		return x.LegsMaxAge
+	}
+	return 0
+}
+
 type AddPriceFeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -319,6 +377,17 @@ type UpdatePriceFeedRequest struct {
 	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 	// ticker is the ticker of the asset to use as price source.
 	Ticker string `protobuf:"bytes,3,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// sources is the new list of price sources of a composite price feed.
+	Sources []*PriceSource `protobuf:"bytes,4,rep,name=sources,proto3" json:"sources,omitempty"`
+	// aggregation is the new aggregation of a composite price feed.
+	Aggregation *PriceAggregation `protobuf:"bytes,5,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// legs is the new list of tickers of a derived price feed.
+	Legs []*PriceLeg `protobuf:"bytes,6,rep,name=legs,proto3" json:"legs,omitempty"`
+	// transformation is the new transformation applied to the prices.
+	Transformation *PriceTransformation `protobuf:"bytes,7,opt,name=transformation,proto3" json:"transformation,omitempty"`
+	// legs_max_age is the new max age in seconds of the prices of the legs of
+	// a derived price feed, left unchanged if zero.
+	LegsMaxAge uint32 `protobuf:"varint,8,opt,name=legs_max_age,json=legsMaxAge,proto3" json:"legs_max_age,omitempty"`
 }
 
 func (x *UpdatePriceFeedRequest) Reset() {
@@ -374,6 +443,41 @@ func (x *UpdatePriceFeedRequest) GetTicker() string {
 	return ""
 }
 
+func (x *UpdatePriceFeedRequest) GetSources() []*PriceSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *UpdatePriceFeedRequest) GetAggregation() *PriceAggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return nil
+}
+
+func (x *UpdatePriceFeedRequest) GetLegs() []*PriceLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *UpdatePriceFeedRequest) GetTransformation() *PriceTransformation {
+	if x != nil {
+		return x.Transformation
+	}
+	return nil
+}
+
+func (x *UpdatePriceFeedRequest) GetLegsMaxAge() uint32 {
+	if x != nil {
+		return x.LegsMaxAge
+	}
+	return 0
+}
+
 type UpdatePriceFeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -412,6 +516,102 @@ func (*UpdatePriceFeedResponse) Descriptor() ([]byte, []int) {
 	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{7}
 }
 
+type PushPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the id of the price feed.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// price is the price to notify. The missing price between base and quote
+	// is the inverse of the other one.
+	Price *v2.Price `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PushPriceRequest) Reset() {
+	*x = PushPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPriceRequest) ProtoMessage() {}
+
+func (x *PushPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPriceRequest.ProtoReflect.Descriptor instead.
+func (*PushPriceRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PushPriceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PushPriceRequest) GetPrice() *v2.Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type PushPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushPriceResponse) Reset() {
+	*x = PushPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushPriceResponse) ProtoMessage() {}
+
+func (x *PushPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushPriceResponse.ProtoReflect.Descriptor instead.
+func (*PushPriceResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{9}
+}
+
 type RemovePriceFeedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -424,7 +624,7 @@ type RemovePriceFeedRequest struct {
 func (x *RemovePriceFeedRequest) Reset() {
 	*x = RemovePriceFeedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[8]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -437,7 +637,7 @@ func (x *RemovePriceFeedRequest) String() string {
 func (*RemovePriceFeedRequest) ProtoMessage() {}
 
 func (x *RemovePriceFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[8]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +650,7 @@ func (x *RemovePriceFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePriceFeedRequest.ProtoReflect.Descriptor instead.
 func (*RemovePriceFeedRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{8}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RemovePriceFeedRequest) GetId() string {
@@ -469,7 +669,7 @@ type RemovePriceFeedResponse struct {
 func (x *RemovePriceFeedResponse) Reset() {
 	*x = RemovePriceFeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[9]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -482,7 +682,7 @@ func (x *RemovePriceFeedResponse) String() string {
 func (*RemovePriceFeedResponse) ProtoMessage() {}
 
 func (x *RemovePriceFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[9]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -495,7 +695,7 @@ func (x *RemovePriceFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePriceFeedResponse.ProtoReflect.Descriptor instead.
 func (*RemovePriceFeedResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{9}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{11}
 }
 
 type ListSupportedPriceSourcesRequest struct {
@@ -507,7 +707,7 @@ type ListSupportedPriceSourcesRequest struct {
 func (x *ListSupportedPriceSourcesRequest) Reset() {
 	*x = ListSupportedPriceSourcesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[10]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -520,7 +720,7 @@ func (x *ListSupportedPriceSourcesRequest) String() string {
 func (*ListSupportedPriceSourcesRequest) ProtoMessage() {}
 
 func (x *ListSupportedPriceSourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[10]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +733,7 @@ func (x *ListSupportedPriceSourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSupportedPriceSourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListSupportedPriceSourcesRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{10}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{12}
 }
 
 type ListSupportedPriceSourcesResponse struct {
@@ -548,7 +748,7 @@ type ListSupportedPriceSourcesResponse struct {
 func (x *ListSupportedPriceSourcesResponse) Reset() {
 	*x = ListSupportedPriceSourcesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[11]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -561,7 +761,7 @@ func (x *ListSupportedPriceSourcesResponse) String() string {
 func (*ListSupportedPriceSourcesResponse) ProtoMessage() {}
 
 func (x *ListSupportedPriceSourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[11]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +774,7 @@ func (x *ListSupportedPriceSourcesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListSupportedPriceSourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListSupportedPriceSourcesResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{11}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListSupportedPriceSourcesResponse) GetSources() []string {
@@ -596,7 +796,7 @@ type GetPriceFeedRequest struct {
 func (x *GetPriceFeedRequest) Reset() {
 	*x = GetPriceFeedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[12]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -609,7 +809,7 @@ func (x *GetPriceFeedRequest) String() string {
 func (*GetPriceFeedRequest) ProtoMessage() {}
 
 func (x *GetPriceFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[12]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +822,7 @@ func (x *GetPriceFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPriceFeedRequest.ProtoReflect.Descriptor instead.
 func (*GetPriceFeedRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{12}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetPriceFeedRequest) GetId() string {
@@ -644,7 +844,7 @@ type GetPriceFeedResponse struct {
 func (x *GetPriceFeedResponse) Reset() {
 	*x = GetPriceFeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[13]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +857,7 @@ func (x *GetPriceFeedResponse) String() string {
 func (*GetPriceFeedResponse) ProtoMessage() {}
 
 func (x *GetPriceFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[13]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +870,7 @@ func (x *GetPriceFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPriceFeedResponse.ProtoReflect.Descriptor instead.
 func (*GetPriceFeedResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{13}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetPriceFeedResponse) GetFeed() *PriceFeed {
@@ -689,7 +889,7 @@ type ListPriceFeedsRequest struct {
 func (x *ListPriceFeedsRequest) Reset() {
 	*x = ListPriceFeedsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[14]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -702,7 +902,7 @@ func (x *ListPriceFeedsRequest) String() string {
 func (*ListPriceFeedsRequest) ProtoMessage() {}
 
 func (x *ListPriceFeedsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[14]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,7 +915,7 @@ func (x *ListPriceFeedsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPriceFeedsRequest.ProtoReflect.Descriptor instead.
 func (*ListPriceFeedsRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{14}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{16}
 }
 
 type ListPriceFeedsResponse struct {
@@ -730,7 +930,7 @@ type ListPriceFeedsResponse struct {
 func (x *ListPriceFeedsResponse) Reset() {
 	*x = ListPriceFeedsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[15]
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -743,7 +943,7 @@ func (x *ListPriceFeedsResponse) String() string {
 func (*ListPriceFeedsResponse) ProtoMessage() {}
 
 func (x *ListPriceFeedsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[15]
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +956,7 @@ func (x *ListPriceFeedsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPriceFeedsResponse.ProtoReflect.Descriptor instead.
 func (*ListPriceFeedsResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{15}
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListPriceFeedsResponse) GetFeeds() []*PriceFeed {
@@ -766,6 +966,131 @@ func (x *ListPriceFeedsResponse) GetFeeds() []*PriceFeed {
 	return nil
 }
 
+type ListPriceTicksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the id of the price feed.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional, list only the ticks of the given type.
+	Type PriceTickType `protobuf:"varint,2,opt,name=type,proto3,enum=tdex_daemon.v2.PriceTickType" json:"type,omitempty"`
+	// Optional, list only the ticks within the given time range.
+	TimeRange *TimeRange `protobuf:"bytes,3,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Optional, the page for a paginated reply. Only page numbers are supported.
+	// Without a page, only the 100 most recent ticks are returned. Ticks are
+	// deleted together with their price feed and, by default, after 30 days.
+	Page *Page `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *ListPriceTicksRequest) Reset() {
+	*x = ListPriceTicksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceTicksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceTicksRequest) ProtoMessage() {}
+
+func (x *ListPriceTicksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceTicksRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceTicksRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListPriceTicksRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListPriceTicksRequest) GetType() PriceTickType {
+	if x != nil {
+		return x.Type
+	}
+	return PriceTickType_PRICE_TICK_TYPE_UNSPECIFIED
+}
+
+func (x *ListPriceTicksRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *ListPriceTicksRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type ListPriceTicksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ticks is the list of price ticks.
+	Ticks []*PriceTick `protobuf:"bytes,1,rep,name=ticks,proto3" json:"ticks,omitempty"`
+}
+
+func (x *ListPriceTicksResponse) Reset() {
+	*x = ListPriceTicksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceTicksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceTicksResponse) ProtoMessage() {}
+
+func (x *ListPriceTicksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_feeder_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceTicksResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceTicksResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_feeder_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPriceTicksResponse) GetTicks() []*PriceTick {
+	if x != nil {
+		return x.Ticks
+	}
+	return nil
+}
+
 var File_tdex_daemon_v2_feeder_proto protoreflect.FileDescriptor
 
 var file_tdex_daemon_v2_feeder_proto_rawDesc = []byte{
@@ -776,134 +1101,207 @@ var file_tdex_daemon_v2_feeder_proto_rawDesc = []byte{
 	0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x74, 0x64, 0x65, 0x78, 0x2f,
 	0x76, 0x32, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x13,
-	0x41, 0x64, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x86, 0x03, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x35, 0x0a,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x67,
+	0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x65, 0x67, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x73, 0x4d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a,
+	0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x26, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xf0, 0x02, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x22, 0x26, 0x0a, 0x14,
-	0x41, 0x64, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x65, 0x67, 0x52,
+	0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x65, 0x67, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x73, 0x4d, 0x61,
+	0x78, 0x41, 0x67, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x48, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x75, 0x73,
+	0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
+	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
 	0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a,
-	0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x17, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x04,
+	0x66, 0x65, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a,
-	0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
-	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x04, 0x66,
-	0x65, 0x65, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x16,
-	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x05, 0x66, 0x65, 0x65, 0x64, 0x73, 0x32, 0x85, 0x08, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x64,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x70, 0x0a, 0x0c, 0x41, 0x64, 0x64,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78,
-	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
-	0x41, 0x64, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22,
-	0x0a, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x12, 0x7c, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x25, 0x2e,
-	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65,
-	0x64, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x78, 0x0a, 0x0d, 0x53, 0x74, 0x6f,
-	0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
-	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x73,
-	0x74, 0x6f, 0x70, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
-	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
-	0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x7e, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78,
-	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65,
-	0x72, 0x2f, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x72, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x52, 0x05, 0x74,
+	0x69, 0x63, 0x6b, 0x73, 0x32, 0xf3, 0x09, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x70, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x50, 0x72, 0x69,
 	0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65,
 	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x64, 0x64,
 	0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66,
-	0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x74,
-	0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65,
-	0x65, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73,
-	0x12, 0x9c, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x30,
+	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76,
+	0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x12, 0x7c, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72,
+	0x2f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x78, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22,
+	0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x73, 0x74, 0x6f, 0x70,
+	0x12, 0x80, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a,
+	0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x6c, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a,
+	0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x75, 0x73,
+	0x68, 0x12, 0x7e, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74,
+	0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a,
+	0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x64, 0x72, 0x6f,
+	0x70, 0x12, 0x72, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x64, 0x12, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
 	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b,
+	0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x73, 0x12, 0x7e, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54,
+	0x69, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x19,
 	0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x32,
-	0x2f, 0x66, 0x65, 0x65, 0x64, 0x65, 0x72, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42,
-	0xce, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x42, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x74,
-	0x64, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73,
-	0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x3b,
-	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x76, 0x32, 0xa2, 0x02, 0x03,
-	0x54, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x56, 0x32, 0xca, 0x02, 0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x5c, 0x56, 0x32, 0xe2, 0x02, 0x19, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0e, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x65, 0x72, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x42, 0xce, 0x01, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x42, 0x0b, 0x46, 0x65, 0x65, 0x64, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x64, 0x65,
+	0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x64, 0x65, 0x78,
+	0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02,
+	0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x32, 0xca, 0x02,
+	0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0xe2, 0x02,
+	0x19, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x54, 0x64, 0x65,
+	0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -918,7 +1316,7 @@ func file_tdex_daemon_v2_feeder_proto_rawDescGZIP() []byte {
 	return file_tdex_daemon_v2_feeder_proto_rawDescData
 }
 
-var file_tdex_daemon_v2_feeder_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_tdex_daemon_v2_feeder_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_tdex_daemon_v2_feeder_proto_goTypes = []interface{}{
 	(*AddPriceFeedRequest)(nil),               // 0: tdex_daemon.v2.AddPriceFeedRequest
 	(*AddPriceFeedResponse)(nil),              // 1: tdex_daemon.v2.AddPriceFeedResponse
@@ -928,42 +1326,72 @@ var file_tdex_daemon_v2_feeder_proto_goTypes = []interface{}{
 	(*StopPriceFeedResponse)(nil),             // 5: tdex_daemon.v2.StopPriceFeedResponse
 	(*UpdatePriceFeedRequest)(nil),            // 6: tdex_daemon.v2.UpdatePriceFeedRequest
 	(*UpdatePriceFeedResponse)(nil),           // 7: tdex_daemon.v2.UpdatePriceFeedResponse
-	(*RemovePriceFeedRequest)(nil),            // 8: tdex_daemon.v2.RemovePriceFeedRequest
-	(*RemovePriceFeedResponse)(nil),           // 9: tdex_daemon.v2.RemovePriceFeedResponse
-	(*ListSupportedPriceSourcesRequest)(nil),  // 10: tdex_daemon.v2.ListSupportedPriceSourcesRequest
-	(*ListSupportedPriceSourcesResponse)(nil), // 11: tdex_daemon.v2.ListSupportedPriceSourcesResponse
-	(*GetPriceFeedRequest)(nil),               // 12: tdex_daemon.v2.GetPriceFeedRequest
-	(*GetPriceFeedResponse)(nil),              // 13: tdex_daemon.v2.GetPriceFeedResponse
-	(*ListPriceFeedsRequest)(nil),             // 14: tdex_daemon.v2.ListPriceFeedsRequest
-	(*ListPriceFeedsResponse)(nil),            // 15: tdex_daemon.v2.ListPriceFeedsResponse
-	(*v2.Market)(nil),                         // 16: tdex.v2.Market
-	(*PriceFeed)(nil),                         // 17: tdex_daemon.v2.PriceFeed
+	(*PushPriceRequest)(nil),                  // 8: tdex_daemon.v2.PushPriceRequest
+	(*PushPriceResponse)(nil),                 // 9: tdex_daemon.v2.PushPriceResponse
+	(*RemovePriceFeedRequest)(nil),            // 10: tdex_daemon.v2.RemovePriceFeedRequest
+	(*RemovePriceFeedResponse)(nil),           // 11: tdex_daemon.v2.RemovePriceFeedResponse
+	(*ListSupportedPriceSourcesRequest)(nil),  // 12: tdex_daemon.v2.ListSupportedPriceSourcesRequest
+	(*ListSupportedPriceSourcesResponse)(nil), // 13: tdex_daemon.v2.ListSupportedPriceSourcesResponse
+	(*GetPriceFeedRequest)(nil),               // 14: tdex_daemon.v2.GetPriceFeedRequest
+	(*GetPriceFeedResponse)(nil),              // 15: tdex_daemon.v2.GetPriceFeedResponse
+	(*ListPriceFeedsRequest)(nil),             // 16: tdex_daemon.v2.ListPriceFeedsRequest
+	(*ListPriceFeedsResponse)(nil),            // 17: tdex_daemon.v2.ListPriceFeedsResponse
+	(*ListPriceTicksRequest)(nil),             // 18: tdex_daemon.v2.ListPriceTicksRequest
+	(*ListPriceTicksResponse)(nil),            // 19: tdex_daemon.v2.ListPriceTicksResponse
+	(*v2.Market)(nil),                         // 20: tdex.v2.Market
+	(*PriceSource)(nil),                       // 21: tdex_daemon.v2.PriceSource
+	(*PriceAggregation)(nil),                  // 22: tdex_daemon.v2.PriceAggregation
+	(*PriceLeg)(nil),                          // 23: tdex_daemon.v2.PriceLeg
+	(*PriceTransformation)(nil),               // 24: tdex_daemon.v2.PriceTransformation
+	(*v2.Price)(nil),                          // 25: tdex.v2.Price
+	(*PriceFeed)(nil),                         // 26: tdex_daemon.v2.PriceFeed
+	(PriceTickType)(0),                        // 27: tdex_daemon.v2.PriceTickType
+	(*TimeRange)(nil),                         // 28: tdex_daemon.v2.TimeRange
+	(*Page)(nil),                              // 29: tdex_daemon.v2.Page
+	(*PriceTick)(nil),                         // 30: tdex_daemon.v2.PriceTick
 }
 var file_tdex_daemon_v2_feeder_proto_depIdxs = []int32{
-	16, // 0: tdex_daemon.v2.AddPriceFeedRequest.market:type_name -> tdex.v2.Market
-	17, // 1: tdex_daemon.v2.GetPriceFeedResponse.feed:type_name -> tdex_daemon.v2.PriceFeed
-	17, // 2: tdex_daemon.v2.ListPriceFeedsResponse.feeds:type_name -> tdex_daemon.v2.PriceFeed
-	0,  // 3: tdex_daemon.v2.FeederService.AddPriceFeed:input_type -> tdex_daemon.v2.AddPriceFeedRequest
-	2,  // 4: tdex_daemon.v2.FeederService.StartPriceFeed:input_type -> tdex_daemon.v2.StartPriceFeedRequest
-	4,  // 5: tdex_daemon.v2.FeederService.StopPriceFeed:input_type -> tdex_daemon.v2.StopPriceFeedRequest
-	6,  // 6: tdex_daemon.v2.FeederService.UpdatePriceFeed:input_type -> tdex_daemon.v2.UpdatePriceFeedRequest
-	8,  // 7: tdex_daemon.v2.FeederService.RemovePriceFeed:input_type -> tdex_daemon.v2.RemovePriceFeedRequest
-	12, // 8: tdex_daemon.v2.FeederService.GetPriceFeed:input_type -> tdex_daemon.v2.GetPriceFeedRequest
-	14, // 9: tdex_daemon.v2.FeederService.ListPriceFeeds:input_type -> tdex_daemon.v2.ListPriceFeedsRequest
-	10, // 10: tdex_daemon.v2.FeederService.ListSupportedPriceSources:input_type -> tdex_daemon.v2.ListSupportedPriceSourcesRequest
-	1,  // 11: tdex_daemon.v2.FeederService.AddPriceFeed:output_type -> tdex_daemon.v2.AddPriceFeedResponse
-	3,  // 12: tdex_daemon.v2.FeederService.StartPriceFeed:output_type -> tdex_daemon.v2.StartPriceFeedResponse
-	5,  // 13: tdex_daemon.v2.FeederService.StopPriceFeed:output_type -> tdex_daemon.v2.StopPriceFeedResponse
-	7,  // 14: tdex_daemon.v2.FeederService.UpdatePriceFeed:output_type -> tdex_daemon.v2.UpdatePriceFeedResponse
-	9,  // 15: tdex_daemon.v2.FeederService.RemovePriceFeed:output_type -> tdex_daemon.v2.RemovePriceFeedResponse
-	13, // 16: tdex_daemon.v2.FeederService.GetPriceFeed:output_type -> tdex_daemon.v2.GetPriceFeedResponse
-	15, // 17: tdex_daemon.v2.FeederService.ListPriceFeeds:output_type -> tdex_daemon.v2.ListPriceFeedsResponse
-	11, // 18: tdex_daemon.v2.FeederService.ListSupportedPriceSources:output_type -> tdex_daemon.v2.ListSupportedPriceSourcesResponse
-	11, // [11:19] is the sub-list for method output_type
-	3,  // [3:11] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	20, // 0: tdex_daemon.v2.AddPriceFeedRequest.market:type_name -> tdex.v2.Market
+	21, // 1: tdex_daemon.v2.AddPriceFeedRequest.sources:type_name -> tdex_daemon.v2.PriceSource
+	22, // 2: tdex_daemon.v2.AddPriceFeedRequest.aggregation:type_name -> tdex_daemon.v2.PriceAggregation
+	23, // 3: tdex_daemon.v2.AddPriceFeedRequest.legs:type_name -> tdex_daemon.v2.PriceLeg
+	24, // 4: tdex_daemon.v2.AddPriceFeedRequest.transformation:type_name -> tdex_daemon.v2.PriceTransformation
+	21, // 5: tdex_daemon.v2.UpdatePriceFeedRequest.sources:type_name -> tdex_daemon.v2.PriceSource
+	22, // 6: tdex_daemon.v2.UpdatePriceFeedRequest.aggregation:type_name -> tdex_daemon.v2.PriceAggregation
+	23, // 7: tdex_daemon.v2.UpdatePriceFeedRequest.legs:type_name -> tdex_daemon.v2.PriceLeg
+	24, // 8: tdex_daemon.v2.UpdatePriceFeedRequest.transformation:type_name -> tdex_daemon.v2.PriceTransformation
+	25, // 9: tdex_daemon.v2.PushPriceRequest.price:type_name -> tdex.v2.Price
+	26, // 10: tdex_daemon.v2.GetPriceFeedResponse.feed:type_name -> tdex_daemon.v2.PriceFeed
+	26, // 11: tdex_daemon.v2.ListPriceFeedsResponse.feeds:type_name -> tdex_daemon.v2.PriceFeed
+	27, // 12: tdex_daemon.v2.ListPriceTicksRequest.type:type_name -> tdex_daemon.v2.PriceTickType
+	28, // 13: tdex_daemon.v2.ListPriceTicksRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
+	29, // 14: tdex_daemon.v2.ListPriceTicksRequest.page:type_name -> tdex_daemon.v2.Page
+	30, // 15: tdex_daemon.v2.ListPriceTicksResponse.ticks:type_name -> tdex_daemon.v2.PriceTick
+	0,  // 16: tdex_daemon.v2.FeederService.AddPriceFeed:input_type -> tdex_daemon.v2.AddPriceFeedRequest
+	2,  // 17: tdex_daemon.v2.FeederService.StartPriceFeed:input_type -> tdex_daemon.v2.StartPriceFeedRequest
+	4,  // 18: tdex_daemon.v2.FeederService.StopPriceFeed:input_type -> tdex_daemon.v2.StopPriceFeedRequest
+	6,  // 19: tdex_daemon.v2.FeederService.UpdatePriceFeed:input_type -> tdex_daemon.v2.UpdatePriceFeedRequest
+	8,  // 20: tdex_daemon.v2.FeederService.PushPrice:input_type -> tdex_daemon.v2.PushPriceRequest
+	10, // 21: tdex_daemon.v2.FeederService.RemovePriceFeed:input_type -> tdex_daemon.v2.RemovePriceFeedRequest
+	14, // 22: tdex_daemon.v2.FeederService.GetPriceFeed:input_type -> tdex_daemon.v2.GetPriceFeedRequest
+	16, // 23: tdex_daemon.v2.FeederService.ListPriceFeeds:input_type -> tdex_daemon.v2.ListPriceFeedsRequest
+	18, // 24: tdex_daemon.v2.FeederService.ListPriceTicks:input_type -> tdex_daemon.v2.ListPriceTicksRequest
+	12, // 25: tdex_daemon.v2.FeederService.ListSupportedPriceSources:input_type -> tdex_daemon.v2.ListSupportedPriceSourcesRequest
+	1,  // 26: tdex_daemon.v2.FeederService.AddPriceFeed:output_type -> tdex_daemon.v2.AddPriceFeedResponse
+	3,  // 27: tdex_daemon.v2.FeederService.StartPriceFeed:output_type -> tdex_daemon.v2.StartPriceFeedResponse
+	5,  // 28: tdex_daemon.v2.FeederService.StopPriceFeed:output_type -> tdex_daemon.v2.StopPriceFeedResponse
+	7,  // 29: tdex_daemon.v2.FeederService.UpdatePriceFeed:output_type -> tdex_daemon.v2.UpdatePriceFeedResponse
+	9,  // 30: tdex_daemon.v2.FeederService.PushPrice:output_type -> tdex_daemon.v2.PushPriceResponse
+	11, // 31: tdex_daemon.v2.FeederService.RemovePriceFeed:output_type -> tdex_daemon.v2.RemovePriceFeedResponse
+	15, // 32: tdex_daemon.v2.FeederService.GetPriceFeed:output_type -> tdex_daemon.v2.GetPriceFeedResponse
+	17, // 33: tdex_daemon.v2.FeederService.ListPriceFeeds:output_type -> tdex_daemon.v2.ListPriceFeedsResponse
+	19, // 34: tdex_daemon.v2.FeederService.ListPriceTicks:output_type -> tdex_daemon.v2.ListPriceTicksResponse
+	13, // 35: tdex_daemon.v2.FeederService.ListSupportedPriceSources:output_type -> tdex_daemon.v2.ListSupportedPriceSourcesResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_tdex_daemon_v2_feeder_proto_init() }
@@ -1070,7 +1498,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePriceFeedRequest); i {
+			switch v := v.(*PushPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1082,7 +1510,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemovePriceFeedResponse); i {
+			switch v := v.(*PushPriceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1094,7 +1522,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSupportedPriceSourcesRequest); i {
+			switch v := v.(*RemovePriceFeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1106,7 +1534,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSupportedPriceSourcesResponse); i {
+			switch v := v.(*RemovePriceFeedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1118,7 +1546,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPriceFeedRequest); i {
+			switch v := v.(*ListSupportedPriceSourcesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1130,7 +1558,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPriceFeedResponse); i {
+			switch v := v.(*ListSupportedPriceSourcesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1142,7 +1570,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPriceFeedsRequest); i {
+			switch v := v.(*GetPriceFeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1154,6 +1582,30 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_feeder_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_feeder_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceFeedsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_feeder_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListPriceFeedsResponse); i {
 			case 0:
 				return &v.state
@@ -1165,6 +1617,30 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 				return nil
 			}
 		}
+		file_tdex_daemon_v2_feeder_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceTicksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_feeder_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceTicksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1172,7 +1648,7 @@ func file_tdex_daemon_v2_feeder_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tdex_daemon_v2_feeder_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,12 +26,19 @@ type FeederServiceClient interface {
 	StopPriceFeed(ctx context.Context, in *StopPriceFeedRequest, opts ...grpc.CallOption) (*StopPriceFeedResponse, error)
 	// UpdatePriceFeed allows to change source and/or ticker of the given price feed.
 	UpdatePriceFeed(ctx context.Context, in *UpdatePriceFeedRequest, opts ...grpc.CallOption) (*UpdatePriceFeedResponse, error)
+	// PushPrice notifies the given price for the started price feed with the
+	// given id, if its source supports it, like the static one.
+	PushPrice(ctx context.Context, in *PushPriceRequest, opts ...grpc.CallOption) (*PushPriceResponse, error)
 	// RemovePriceFeed removes the price feed with the given id.
 	RemovePriceFeed(ctx context.Context, in *RemovePriceFeedRequest, opts ...grpc.CallOption) (*RemovePriceFeedResponse, error)
 	// GetPriceFeed returns the price feed for the given market.
 	GetPriceFeed(ctx context.Context, in *GetPriceFeedRequest, opts ...grpc.CallOption) (*GetPriceFeedResponse, error)
 	// ListPriceFeeds returns the list of price feeds of all markets.
 	ListPriceFeeds(ctx context.Context, in *ListPriceFeedsRequest, opts ...grpc.CallOption) (*ListPriceFeedsResponse, error)
+	// ListPriceTicks returns the prices received and applied by the price feed
+	// with the given id, most recent first. Applied prices refer to the
+	// received one that produced them.
+	ListPriceTicks(ctx context.Context, in *ListPriceTicksRequest, opts ...grpc.CallOption) (*ListPriceTicksResponse, error)
 	// ListSupportedPriceSources returns the list of supported price sources.
 	ListSupportedPriceSources(ctx context.Context, in *ListSupportedPriceSourcesRequest, opts ...grpc.CallOption) (*ListSupportedPriceSourcesResponse, error)
 }
@@ -80,6 +87,15 @@ func (c *feederServiceClient) UpdatePriceFeed(ctx context.Context, in *UpdatePri
 	return out, nil
 }
 
+func (c *feederServiceClient) PushPrice(ctx context.Context, in *PushPriceRequest, opts ...grpc.CallOption) (*PushPriceResponse, error) {
+	out := new(PushPriceResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.FeederService/PushPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *feederServiceClient) RemovePriceFeed(ctx context.Context, in *RemovePriceFeedRequest, opts ...grpc.CallOption) (*RemovePriceFeedResponse, error) {
 	out := new(RemovePriceFeedResponse)
 	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.FeederService/RemovePriceFeed", in, out, opts...)
@@ -107,6 +123,15 @@ func (c *feederServiceClient) ListPriceFeeds(ctx context.Context, in *ListPriceF
 	return out, nil
 }
 
+func (c *feederServiceClient) ListPriceTicks(ctx context.Context, in *ListPriceTicksRequest, opts ...grpc.CallOption) (*ListPriceTicksResponse, error) {
+	out := new(ListPriceTicksResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.FeederService/ListPriceTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *feederServiceClient) ListSupportedPriceSources(ctx context.Context, in *ListSupportedPriceSourcesRequest, opts ...grpc.CallOption) (*ListSupportedPriceSourcesResponse, error) {
 	out := new(ListSupportedPriceSourcesResponse)
 	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.FeederService/ListSupportedPriceSources", in, out, opts...)
@@ -128,12 +153,19 @@ type FeederServiceServer interface {
 	StopPriceFeed(context.Context, *StopPriceFeedRequest) (*StopPriceFeedResponse, error)
 	// UpdatePriceFeed allows to change source and/or ticker of the given price feed.
 	UpdatePriceFeed(context.Context, *UpdatePriceFeedRequest) (*UpdatePriceFeedResponse, error)
+	// PushPrice notifies the given price for the started price feed with the
+	// given id, if its source supports it, like the static one.
+	PushPrice(context.Context, *PushPriceRequest) (*PushPriceResponse, error)
 	// RemovePriceFeed removes the price feed with the given id.
 	RemovePriceFeed(context.Context, *RemovePriceFeedRequest) (*RemovePriceFeedResponse, error)
 	// GetPriceFeed returns the price feed for the given market.
 	GetPriceFeed(context.Context, *GetPriceFeedRequest) (*GetPriceFeedResponse, error)
 	// ListPriceFeeds returns the list of price feeds of all markets.
 	ListPriceFeeds(context.Context, *ListPriceFeedsRequest) (*ListPriceFeedsResponse, error)
+	// ListPriceTicks returns the prices received and applied by the price feed
+	// with the given id, most recent first. Applied prices refer to the
+	// received one that produced them.
+	ListPriceTicks(context.Context, *ListPriceTicksRequest) (*ListPriceTicksResponse, error)
 	// ListSupportedPriceSources returns the list of supported price sources.
 	ListSupportedPriceSources(context.Context, *ListSupportedPriceSourcesRequest) (*ListSupportedPriceSourcesResponse, error)
 }
@@ -154,6 +186,9 @@ func (UnimplementedFeederServiceServer) StopPriceFeed(context.Context, *StopPric
 func (UnimplementedFeederServiceServer) UpdatePriceFeed(context.Context, *UpdatePriceFeedRequest) (*UpdatePriceFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceFeed not implemented")
 }
+func (UnimplementedFeederServiceServer) PushPrice(context.Context, *PushPriceRequest) (*PushPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPrice not implemented")
+}
 func (UnimplementedFeederServiceServer) RemovePriceFeed(context.Context, *RemovePriceFeedRequest) (*RemovePriceFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceFeed not implemented")
 }
@@ -163,6 +198,9 @@ func (UnimplementedFeederServiceServer) GetPriceFeed(context.Context, *GetPriceF
 func (UnimplementedFeederServiceServer) ListPriceFeeds(context.Context, *ListPriceFeedsRequest) (*ListPriceFeedsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPriceFeeds not implemented")
 }
+func (UnimplementedFeederServiceServer) ListPriceTicks(context.Context, *ListPriceTicksRequest) (*ListPriceTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceTicks not implemented")
+}
 func (UnimplementedFeederServiceServer) ListSupportedPriceSources(context.Context, *ListSupportedPriceSourcesRequest) (*ListSupportedPriceSourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedPriceSources not implemented")
 }
@@ -250,6 +288,24 @@ func _FeederService_UpdatePriceFeed_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeederService_PushPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeederServiceServer).PushPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.FeederService/PushPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeederServiceServer).PushPrice(ctx, req.(*PushPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FeederService_RemovePriceFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemovePriceFeedRequest)
 	if err := dec(in); err != nil {
@@ -304,6 +360,24 @@ func _FeederService_ListPriceFeeds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeederService_ListPriceTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeederServiceServer).ListPriceTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.FeederService/ListPriceTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeederServiceServer).ListPriceTicks(ctx, req.(*ListPriceTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FeederService_ListSupportedPriceSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSupportedPriceSourcesRequest)
 	if err := dec(in); err != nil {
@@ -345,6 +419,10 @@ var FeederService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePriceFeed",
 			Handler:    _FeederService_UpdatePriceFeed_Handler,
 		},
+		{
+			MethodName: "PushPrice",
+			Handler:    _FeederService_PushPrice_Handler,
+		},
 		{
 			MethodName: "RemovePriceFeed",
 			Handler:    _FeederService_RemovePriceFeed_Handler,
@@ -357,6 +435,10 @@ var FeederService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPriceFeeds",
 			Handler:    _FeederService_ListPriceFeeds_Handler,
 		},
+		{
+			MethodName: "ListPriceTicks",
+			Handler:    _FeederService_ListPriceTicks_Handler,
+		},
 		{
 			MethodName: "ListSupportedPriceSources",
 			Handler:    _FeederService_ListSupportedPriceSources_Handler,
@@ -1646,6 +1646,101 @@ func (*UpdateMarketAssetsPrecisionResponse) Descriptor() ([]byte, []int) {
 	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{31}
 }
 
+type UpdateMarketTradeExpiryTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The market for which updating the trade expiry time.
+	Market *v2.Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// The new trade expiry time in seconds. Zero means the daemon's default is used.
+	TradeExpiryTime uint64 `protobuf:"varint,2,opt,name=trade_expiry_time,json=tradeExpiryTime,proto3" json:"trade_expiry_time,omitempty"`
+}
+
+func (x *UpdateMarketTradeExpiryTimeRequest) Reset() {
+	*x = UpdateMarketTradeExpiryTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketTradeExpiryTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketTradeExpiryTimeRequest) ProtoMessage() {}
+
+func (x *UpdateMarketTradeExpiryTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketTradeExpiryTimeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMarketTradeExpiryTimeRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateMarketTradeExpiryTimeRequest) GetMarket() *v2.Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *UpdateMarketTradeExpiryTimeRequest) GetTradeExpiryTime() uint64 {
+	if x != nil {
+		return x.TradeExpiryTime
+	}
+	return 0
+}
+
+type UpdateMarketTradeExpiryTimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateMarketTradeExpiryTimeResponse) Reset() {
+	*x = UpdateMarketTradeExpiryTimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMarketTradeExpiryTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMarketTradeExpiryTimeResponse) ProtoMessage() {}
+
+func (x *UpdateMarketTradeExpiryTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMarketTradeExpiryTimeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMarketTradeExpiryTimeResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{33}
+}
+
 type UpdateMarketPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1660,7 +1755,7 @@ type UpdateMarketPriceRequest struct {
 func (x *UpdateMarketPriceRequest) Reset() {
 	*x = UpdateMarketPriceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[32]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1673,7 +1768,7 @@ func (x *UpdateMarketPriceRequest) String() string {
 func (*UpdateMarketPriceRequest) ProtoMessage() {}
 
 func (x *UpdateMarketPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[32]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,7 +1781,7 @@ func (x *UpdateMarketPriceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMarketPriceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMarketPriceRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{32}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdateMarketPriceRequest) GetMarket() *v2.Market {
@@ -1712,7 +1807,7 @@ type UpdateMarketPriceResponse struct {
 func (x *UpdateMarketPriceResponse) Reset() {
 	*x = UpdateMarketPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[33]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1725,7 +1820,7 @@ func (x *UpdateMarketPriceResponse) String() string {
 func (*UpdateMarketPriceResponse) ProtoMessage() {}
 
 func (x *UpdateMarketPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[33]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,7 +1833,7 @@ func (x *UpdateMarketPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMarketPriceResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMarketPriceResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{33}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{35}
 }
 
 type UpdateMarketStrategyRequest struct {
@@ -1757,7 +1852,7 @@ type UpdateMarketStrategyRequest struct {
 func (x *UpdateMarketStrategyRequest) Reset() {
 	*x = UpdateMarketStrategyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[34]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1770,7 +1865,7 @@ func (x *UpdateMarketStrategyRequest) String() string {
 func (*UpdateMarketStrategyRequest) ProtoMessage() {}
 
 func (x *UpdateMarketStrategyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[34]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +1878,7 @@ func (x *UpdateMarketStrategyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMarketStrategyRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMarketStrategyRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{34}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UpdateMarketStrategyRequest) GetMarket() *v2.Market {
@@ -1816,7 +1911,7 @@ type UpdateMarketStrategyResponse struct {
 func (x *UpdateMarketStrategyResponse) Reset() {
 	*x = UpdateMarketStrategyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[35]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1829,7 +1924,7 @@ func (x *UpdateMarketStrategyResponse) String() string {
 func (*UpdateMarketStrategyResponse) ProtoMessage() {}
 
 func (x *UpdateMarketStrategyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[35]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,7 +1937,7 @@ func (x *UpdateMarketStrategyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMarketStrategyResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMarketStrategyResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{35}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{37}
 }
 
 type DeriveFeeFragmenterAddressesRequest struct {
@@ -1856,7 +1951,7 @@ type DeriveFeeFragmenterAddressesRequest struct {
 func (x *DeriveFeeFragmenterAddressesRequest) Reset() {
 	*x = DeriveFeeFragmenterAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[36]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1869,7 +1964,7 @@ func (x *DeriveFeeFragmenterAddressesRequest) String() string {
 func (*DeriveFeeFragmenterAddressesRequest) ProtoMessage() {}
 
 func (x *DeriveFeeFragmenterAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[36]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +1977,7 @@ func (x *DeriveFeeFragmenterAddressesRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DeriveFeeFragmenterAddressesRequest.ProtoReflect.Descriptor instead.
 func (*DeriveFeeFragmenterAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{36}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DeriveFeeFragmenterAddressesRequest) GetNumOfAddresses() int64 {
@@ -1903,7 +1998,7 @@ type DeriveFeeFragmenterAddressesResponse struct {
 func (x *DeriveFeeFragmenterAddressesResponse) Reset() {
 	*x = DeriveFeeFragmenterAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[37]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1916,7 +2011,7 @@ func (x *DeriveFeeFragmenterAddressesResponse) String() string {
 func (*DeriveFeeFragmenterAddressesResponse) ProtoMessage() {}
 
 func (x *DeriveFeeFragmenterAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[37]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,7 +2024,7 @@ func (x *DeriveFeeFragmenterAddressesResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DeriveFeeFragmenterAddressesResponse.ProtoReflect.Descriptor instead.
 func (*DeriveFeeFragmenterAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{37}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *DeriveFeeFragmenterAddressesResponse) GetAddresses() []string {
@@ -1948,7 +2043,7 @@ type ListFeeFragmenterAddressesRequest struct {
 func (x *ListFeeFragmenterAddressesRequest) Reset() {
 	*x = ListFeeFragmenterAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[38]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1961,7 +2056,7 @@ func (x *ListFeeFragmenterAddressesRequest) String() string {
 func (*ListFeeFragmenterAddressesRequest) ProtoMessage() {}
 
 func (x *ListFeeFragmenterAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[38]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1974,7 +2069,7 @@ func (x *ListFeeFragmenterAddressesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListFeeFragmenterAddressesRequest.ProtoReflect.Descriptor instead.
 func (*ListFeeFragmenterAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{38}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{40}
 }
 
 type ListFeeFragmenterAddressesResponse struct {
@@ -1988,7 +2083,7 @@ type ListFeeFragmenterAddressesResponse struct {
 func (x *ListFeeFragmenterAddressesResponse) Reset() {
 	*x = ListFeeFragmenterAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[39]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2001,7 +2096,7 @@ func (x *ListFeeFragmenterAddressesResponse) String() string {
 func (*ListFeeFragmenterAddressesResponse) ProtoMessage() {}
 
 func (x *ListFeeFragmenterAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[39]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2014,7 +2109,7 @@ func (x *ListFeeFragmenterAddressesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListFeeFragmenterAddressesResponse.ProtoReflect.Descriptor instead.
 func (*ListFeeFragmenterAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{39}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListFeeFragmenterAddressesResponse) GetAddresses() []string {
@@ -2033,7 +2128,7 @@ type GetFeeFragmenterBalanceRequest struct {
 func (x *GetFeeFragmenterBalanceRequest) Reset() {
 	*x = GetFeeFragmenterBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[40]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2046,7 +2141,7 @@ func (x *GetFeeFragmenterBalanceRequest) String() string {
 func (*GetFeeFragmenterBalanceRequest) ProtoMessage() {}
 
 func (x *GetFeeFragmenterBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[40]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2059,7 +2154,7 @@ func (x *GetFeeFragmenterBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeeFragmenterBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetFeeFragmenterBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{40}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{42}
 }
 
 type GetFeeFragmenterBalanceResponse struct {
@@ -2074,7 +2169,7 @@ type GetFeeFragmenterBalanceResponse struct {
 func (x *GetFeeFragmenterBalanceResponse) Reset() {
 	*x = GetFeeFragmenterBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[41]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2087,7 +2182,7 @@ func (x *GetFeeFragmenterBalanceResponse) String() string {
 func (*GetFeeFragmenterBalanceResponse) ProtoMessage() {}
 
 func (x *GetFeeFragmenterBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[41]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +2195,7 @@ func (x *GetFeeFragmenterBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFeeFragmenterBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetFeeFragmenterBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{41}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetFeeFragmenterBalanceResponse) GetBalance() map[string]*Balance {
@@ -2124,7 +2219,7 @@ type FeeFragmenterSplitFundsRequest struct {
 func (x *FeeFragmenterSplitFundsRequest) Reset() {
 	*x = FeeFragmenterSplitFundsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[42]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2137,7 +2232,7 @@ func (x *FeeFragmenterSplitFundsRequest) String() string {
 func (*FeeFragmenterSplitFundsRequest) ProtoMessage() {}
 
 func (x *FeeFragmenterSplitFundsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[42]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2150,7 +2245,7 @@ func (x *FeeFragmenterSplitFundsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeFragmenterSplitFundsRequest.ProtoReflect.Descriptor instead.
 func (*FeeFragmenterSplitFundsRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{42}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *FeeFragmenterSplitFundsRequest) GetMaxFragments() uint32 {
@@ -2179,7 +2274,7 @@ type FeeFragmenterSplitFundsResponse struct {
 func (x *FeeFragmenterSplitFundsResponse) Reset() {
 	*x = FeeFragmenterSplitFundsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[43]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2192,7 +2287,7 @@ func (x *FeeFragmenterSplitFundsResponse) String() string {
 func (*FeeFragmenterSplitFundsResponse) ProtoMessage() {}
 
 func (x *FeeFragmenterSplitFundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[43]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2205,7 +2300,7 @@ func (x *FeeFragmenterSplitFundsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeeFragmenterSplitFundsResponse.ProtoReflect.Descriptor instead.
 func (*FeeFragmenterSplitFundsResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{43}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FeeFragmenterSplitFundsResponse) GetMessage() string {
@@ -2231,7 +2326,7 @@ type WithdrawFeeFragmenterRequest struct {
 func (x *WithdrawFeeFragmenterRequest) Reset() {
 	*x = WithdrawFeeFragmenterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[44]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2244,7 +2339,7 @@ func (x *WithdrawFeeFragmenterRequest) String() string {
 func (*WithdrawFeeFragmenterRequest) ProtoMessage() {}
 
 func (x *WithdrawFeeFragmenterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[44]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,7 +2352,7 @@ func (x *WithdrawFeeFragmenterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawFeeFragmenterRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawFeeFragmenterRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{44}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *WithdrawFeeFragmenterRequest) GetOutputs() []*TxOutput {
@@ -2292,7 +2387,7 @@ type WithdrawFeeFragmenterResponse struct {
 func (x *WithdrawFeeFragmenterResponse) Reset() {
 	*x = WithdrawFeeFragmenterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[45]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2305,7 +2400,7 @@ func (x *WithdrawFeeFragmenterResponse) String() string {
 func (*WithdrawFeeFragmenterResponse) ProtoMessage() {}
 
 func (x *WithdrawFeeFragmenterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[45]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2318,7 +2413,7 @@ func (x *WithdrawFeeFragmenterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawFeeFragmenterResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawFeeFragmenterResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{45}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *WithdrawFeeFragmenterResponse) GetTxid() string {
@@ -2339,7 +2434,7 @@ type DeriveMarketFragmenterAddressesRequest struct {
 func (x *DeriveMarketFragmenterAddressesRequest) Reset() {
 	*x = DeriveMarketFragmenterAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[46]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2352,7 +2447,7 @@ func (x *DeriveMarketFragmenterAddressesRequest) String() string {
 func (*DeriveMarketFragmenterAddressesRequest) ProtoMessage() {}
 
 func (x *DeriveMarketFragmenterAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[46]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2365,7 +2460,7 @@ func (x *DeriveMarketFragmenterAddressesRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use DeriveMarketFragmenterAddressesRequest.ProtoReflect.Descriptor instead.
 func (*DeriveMarketFragmenterAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{46}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DeriveMarketFragmenterAddressesRequest) GetNumOfAddresses() int64 {
@@ -2386,7 +2481,7 @@ type DeriveMarketFragmenterAddressesResponse struct {
 func (x *DeriveMarketFragmenterAddressesResponse) Reset() {
 	*x = DeriveMarketFragmenterAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[47]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2399,7 +2494,7 @@ func (x *DeriveMarketFragmenterAddressesResponse) String() string {
 func (*DeriveMarketFragmenterAddressesResponse) ProtoMessage() {}
 
 func (x *DeriveMarketFragmenterAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[47]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,7 +2507,7 @@ func (x *DeriveMarketFragmenterAddressesResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use DeriveMarketFragmenterAddressesResponse.ProtoReflect.Descriptor instead.
 func (*DeriveMarketFragmenterAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{47}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *DeriveMarketFragmenterAddressesResponse) GetAddresses() []string {
@@ -2431,7 +2526,7 @@ type ListMarketFragmenterAddressesRequest struct {
 func (x *ListMarketFragmenterAddressesRequest) Reset() {
 	*x = ListMarketFragmenterAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[48]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2444,7 +2539,7 @@ func (x *ListMarketFragmenterAddressesRequest) String() string {
 func (*ListMarketFragmenterAddressesRequest) ProtoMessage() {}
 
 func (x *ListMarketFragmenterAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[48]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2457,7 +2552,7 @@ func (x *ListMarketFragmenterAddressesRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ListMarketFragmenterAddressesRequest.ProtoReflect.Descriptor instead.
 func (*ListMarketFragmenterAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{48}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{50}
 }
 
 type ListMarketFragmenterAddressesResponse struct {
@@ -2471,7 +2566,7 @@ type ListMarketFragmenterAddressesResponse struct {
 func (x *ListMarketFragmenterAddressesResponse) Reset() {
 	*x = ListMarketFragmenterAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[49]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2484,7 +2579,7 @@ func (x *ListMarketFragmenterAddressesResponse) String() string {
 func (*ListMarketFragmenterAddressesResponse) ProtoMessage() {}
 
 func (x *ListMarketFragmenterAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[49]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2497,7 +2592,7 @@ func (x *ListMarketFragmenterAddressesResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ListMarketFragmenterAddressesResponse.ProtoReflect.Descriptor instead.
 func (*ListMarketFragmenterAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{49}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ListMarketFragmenterAddressesResponse) GetAddresses() []string {
@@ -2516,7 +2611,7 @@ type GetMarketFragmenterBalanceRequest struct {
 func (x *GetMarketFragmenterBalanceRequest) Reset() {
 	*x = GetMarketFragmenterBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[50]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2529,7 +2624,7 @@ func (x *GetMarketFragmenterBalanceRequest) String() string {
 func (*GetMarketFragmenterBalanceRequest) ProtoMessage() {}
 
 func (x *GetMarketFragmenterBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[50]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2542,7 +2637,7 @@ func (x *GetMarketFragmenterBalanceRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetMarketFragmenterBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetMarketFragmenterBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{50}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{52}
 }
 
 type GetMarketFragmenterBalanceResponse struct {
@@ -2557,7 +2652,7 @@ type GetMarketFragmenterBalanceResponse struct {
 func (x *GetMarketFragmenterBalanceResponse) Reset() {
 	*x = GetMarketFragmenterBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[51]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2570,7 +2665,7 @@ func (x *GetMarketFragmenterBalanceResponse) String() string {
 func (*GetMarketFragmenterBalanceResponse) ProtoMessage() {}
 
 func (x *GetMarketFragmenterBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[51]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2583,7 +2678,7 @@ func (x *GetMarketFragmenterBalanceResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetMarketFragmenterBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetMarketFragmenterBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{51}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetMarketFragmenterBalanceResponse) GetBalance() map[string]*Balance {
@@ -2607,7 +2702,7 @@ type MarketFragmenterSplitFundsRequest struct {
 func (x *MarketFragmenterSplitFundsRequest) Reset() {
 	*x = MarketFragmenterSplitFundsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[52]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2620,7 +2715,7 @@ func (x *MarketFragmenterSplitFundsRequest) String() string {
 func (*MarketFragmenterSplitFundsRequest) ProtoMessage() {}
 
 func (x *MarketFragmenterSplitFundsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[52]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2633,7 +2728,7 @@ func (x *MarketFragmenterSplitFundsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MarketFragmenterSplitFundsRequest.ProtoReflect.Descriptor instead.
 func (*MarketFragmenterSplitFundsRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{52}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *MarketFragmenterSplitFundsRequest) GetMarket() *v2.Market {
@@ -2662,7 +2757,7 @@ type MarketFragmenterSplitFundsResponse struct {
 func (x *MarketFragmenterSplitFundsResponse) Reset() {
 	*x = MarketFragmenterSplitFundsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[53]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2675,7 +2770,7 @@ func (x *MarketFragmenterSplitFundsResponse) String() string {
 func (*MarketFragmenterSplitFundsResponse) ProtoMessage() {}
 
 func (x *MarketFragmenterSplitFundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[53]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2688,7 +2783,7 @@ func (x *MarketFragmenterSplitFundsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MarketFragmenterSplitFundsResponse.ProtoReflect.Descriptor instead.
 func (*MarketFragmenterSplitFundsResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{53}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *MarketFragmenterSplitFundsResponse) GetMessage() string {
@@ -2714,7 +2809,7 @@ type WithdrawMarketFragmenterRequest struct {
 func (x *WithdrawMarketFragmenterRequest) Reset() {
 	*x = WithdrawMarketFragmenterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[54]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2727,7 +2822,7 @@ func (x *WithdrawMarketFragmenterRequest) String() string {
 func (*WithdrawMarketFragmenterRequest) ProtoMessage() {}
 
 func (x *WithdrawMarketFragmenterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[54]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2740,7 +2835,7 @@ func (x *WithdrawMarketFragmenterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawMarketFragmenterRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawMarketFragmenterRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{54}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *WithdrawMarketFragmenterRequest) GetOutputs() []*TxOutput {
@@ -2775,7 +2870,7 @@ type WithdrawMarketFragmenterResponse struct {
 func (x *WithdrawMarketFragmenterResponse) Reset() {
 	*x = WithdrawMarketFragmenterResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[55]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2788,7 +2883,7 @@ func (x *WithdrawMarketFragmenterResponse) String() string {
 func (*WithdrawMarketFragmenterResponse) ProtoMessage() {}
 
 func (x *WithdrawMarketFragmenterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[55]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2801,7 +2896,7 @@ func (x *WithdrawMarketFragmenterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawMarketFragmenterResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawMarketFragmenterResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{55}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *WithdrawMarketFragmenterResponse) GetTxid() string {
@@ -2820,7 +2915,7 @@ type ListMarketsRequest struct {
 func (x *ListMarketsRequest) Reset() {
 	*x = ListMarketsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[56]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2833,7 +2928,7 @@ func (x *ListMarketsRequest) String() string {
 func (*ListMarketsRequest) ProtoMessage() {}
 
 func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[56]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2846,7 +2941,7 @@ func (x *ListMarketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMarketsRequest.ProtoReflect.Descriptor instead.
 func (*ListMarketsRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{56}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{58}
 }
 
 type ListMarketsResponse struct {
@@ -2861,7 +2956,7 @@ type ListMarketsResponse struct {
 func (x *ListMarketsResponse) Reset() {
 	*x = ListMarketsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[57]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2874,7 +2969,7 @@ func (x *ListMarketsResponse) String() string {
 func (*ListMarketsResponse) ProtoMessage() {}
 
 func (x *ListMarketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[57]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,7 +2982,7 @@ func (x *ListMarketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMarketsResponse.ProtoReflect.Descriptor instead.
 func (*ListMarketsResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{57}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *ListMarketsResponse) GetMarkets() []*MarketInfo {
@@ -2913,7 +3008,7 @@ type ListTradesRequest struct {
 func (x *ListTradesRequest) Reset() {
 	*x = ListTradesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[58]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2926,7 +3021,7 @@ func (x *ListTradesRequest) String() string {
 func (*ListTradesRequest) ProtoMessage() {}
 
 func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[58]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2939,7 +3034,7 @@ func (x *ListTradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTradesRequest.ProtoReflect.Descriptor instead.
 func (*ListTradesRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{58}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *ListTradesRequest) GetMarket() *v2.Market {
@@ -2971,12 +3066,14 @@ type ListTradesResponse struct {
 	// The list of info about all trades or all trades for a market depending on
 	// the request.
 	Trades []*TradeInfo `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	// The token of the next page, if any.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListTradesResponse) Reset() {
 	*x = ListTradesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[59]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2989,7 +3086,7 @@ func (x *ListTradesResponse) String() string {
 func (*ListTradesResponse) ProtoMessage() {}
 
 func (x *ListTradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[59]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +3099,7 @@ func (x *ListTradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTradesResponse.ProtoReflect.Descriptor instead.
 func (*ListTradesResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{59}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListTradesResponse) GetTrades() []*TradeInfo {
@@ -3012,6 +3109,13 @@ func (x *ListTradesResponse) GetTrades() []*TradeInfo {
 	return nil
 }
 
+func (x *ListTradesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type ListUtxosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3026,7 +3130,7 @@ type ListUtxosRequest struct {
 func (x *ListUtxosRequest) Reset() {
 	*x = ListUtxosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[60]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3039,7 +3143,7 @@ func (x *ListUtxosRequest) String() string {
 func (*ListUtxosRequest) ProtoMessage() {}
 
 func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[60]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3052,7 +3156,7 @@ func (x *ListUtxosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUtxosRequest.ProtoReflect.Descriptor instead.
 func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{60}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ListUtxosRequest) GetAccountName() string {
@@ -3078,12 +3182,14 @@ type ListUtxosResponse struct {
 	SpendableUtxos []*UtxoInfo `protobuf:"bytes,1,rep,name=spendable_utxos,json=spendableUtxos,proto3" json:"spendable_utxos,omitempty"`
 	// The list of all locked utxos.
 	LockedUtxos []*UtxoInfo `protobuf:"bytes,2,rep,name=locked_utxos,json=lockedUtxos,proto3" json:"locked_utxos,omitempty"`
+	// The token of the next page, if any.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListUtxosResponse) Reset() {
 	*x = ListUtxosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[61]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3096,7 +3202,7 @@ func (x *ListUtxosResponse) String() string {
 func (*ListUtxosResponse) ProtoMessage() {}
 
 func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[61]
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,51 +3215,580 @@ func (x *ListUtxosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUtxosResponse.ProtoReflect.Descriptor instead.
 func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{61}
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListUtxosResponse) GetSpendableUtxos() []*UtxoInfo {
+	if x != nil {
+		return x.SpendableUtxos
+	}
+	return nil
+}
+
+func (x *ListUtxosResponse) GetLockedUtxos() []*UtxoInfo {
+	if x != nil {
+		return x.LockedUtxos
+	}
+	return nil
+}
+
+func (x *ListUtxosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListDepositsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the wallet account for which listing the deposits.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The page for a paginated reply. Filters can't be combined with page
+	// numbers, only with page tokens.
+	Page *Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Optional, list only the deposits moving the given asset.
+	Asset string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Optional, list only the deposits made within the given time range.
+	TimeRange *TimeRange `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Optional, list only the deposits with the given transaction id.
+	Txid string `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *ListDepositsRequest) Reset() {
+	*x = ListDepositsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDepositsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepositsRequest) ProtoMessage() {}
+
+func (x *ListDepositsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepositsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListDepositsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListDepositsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListDepositsRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ListDepositsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *ListDepositsRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type ListDepositsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the wallet account.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The list of info about the deposits.
+	Deposits []*Transaction `protobuf:"bytes,2,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// The token of the next page, if any.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total amount per asset of all the deposits matching the filters, not
+	// only of those in the page. Returned only with the first page.
+	TotalAmountPerAsset map[string]uint64 `protobuf:"bytes,4,rep,name=total_amount_per_asset,json=totalAmountPerAsset,proto3" json:"total_amount_per_asset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ListDepositsResponse) Reset() {
+	*x = ListDepositsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDepositsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepositsResponse) ProtoMessage() {}
+
+func (x *ListDepositsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepositsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepositsResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListDepositsResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListDepositsResponse) GetDeposits() []*Transaction {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *ListDepositsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDepositsResponse) GetTotalAmountPerAsset() map[string]uint64 {
+	if x != nil {
+		return x.TotalAmountPerAsset
+	}
+	return nil
+}
+
+type ListWithdrawalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the wallet account for which listing the withdrawals.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The page for a paginated reply. Filters can't be combined with page
+	// numbers, only with page tokens.
+	Page *Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Optional, list only the withdrawals moving the given asset.
+	Asset string `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	// Optional, list only the withdrawals made within the given time range.
+	TimeRange *TimeRange `protobuf:"bytes,4,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Optional, list only the withdrawals with the given transaction id.
+	Txid string `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`
+}
+
+func (x *ListWithdrawalsRequest) Reset() {
+	*x = ListWithdrawalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsRequest) ProtoMessage() {}
+
+func (x *ListWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListWithdrawalsRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListWithdrawalsRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListWithdrawalsRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ListWithdrawalsRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *ListWithdrawalsRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type ListWithdrawalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the wallet account.
+	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// The list of info about the withdrawals.
+	Withdrawals []*Transaction `protobuf:"bytes,2,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	// The token of the next page, if any.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total amount per asset of all the withdrawals matching the filters, not
+	// only of those in the page. Returned only with the first page.
+	TotalAmountPerAsset map[string]uint64 `protobuf:"bytes,4,rep,name=total_amount_per_asset,json=totalAmountPerAsset,proto3" json:"total_amount_per_asset,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ListWithdrawalsResponse) Reset() {
+	*x = ListWithdrawalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWithdrawalsResponse) ProtoMessage() {}
+
+func (x *ListWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*ListWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListWithdrawalsResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *ListWithdrawalsResponse) GetWithdrawals() []*Transaction {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+func (x *ListWithdrawalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListWithdrawalsResponse) GetTotalAmountPerAsset() map[string]uint64 {
+	if x != nil {
+		return x.TotalAmountPerAsset
+	}
+	return nil
+}
+
+type ExportLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time range for which exporting the ledger entries.
+	TimeRange *TimeRange `protobuf:"bytes,1,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// Optional, return also the raw transaction in hex format for each trade.
+	WithHex bool `protobuf:"varint,2,opt,name=with_hex,json=withHex,proto3" json:"with_hex,omitempty"`
+}
+
+func (x *ExportLedgerRequest) Reset() {
+	*x = ExportLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLedgerRequest) ProtoMessage() {}
+
+func (x *ExportLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ExportLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExportLedgerRequest) GetTimeRange() *TimeRange {
+	if x != nil {
+		return x.TimeRange
+	}
+	return nil
+}
+
+func (x *ExportLedgerRequest) GetWithHex() bool {
+	if x != nil {
+		return x.WithHex
+	}
+	return false
+}
+
+type ExportLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ledger entry.
+	Entry *LedgerEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *ExportLedgerResponse) Reset() {
+	*x = ExportLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLedgerResponse) ProtoMessage() {}
+
+func (x *ExportLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ExportLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ExportLedgerResponse) GetEntry() *LedgerEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type BackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The wallet unlocking password, used also to encrypt the backup.
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRequest) ProtoMessage() {}
+
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListUtxosResponse) GetSpendableUtxos() []*UtxoInfo {
+func (x *BackupRequest) GetPassword() string {
 	if x != nil {
-		return x.SpendableUtxos
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *ListUtxosResponse) GetLockedUtxos() []*UtxoInfo {
+type BackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A chunk of the encrypted backup, to be concatenated with the others in
+	// the order they are received.
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupResponse) ProtoMessage() {}
+
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *BackupResponse) GetChunk() []byte {
 	if x != nil {
-		return x.LockedUtxos
+		return x.Chunk
 	}
 	return nil
 }
 
-type ListDepositsRequest struct {
+type ArchiveTradesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the wallet account for which listing the deposits.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// The page for a paginated reply.
-	Page *Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// Optional, the retention period in days, defaults to the one configured.
+	RetentionDays uint64 `protobuf:"varint,1,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"`
 }
 
-func (x *ListDepositsRequest) Reset() {
-	*x = ListDepositsRequest{}
+func (x *ArchiveTradesRequest) Reset() {
+	*x = ArchiveTradesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[62]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDepositsRequest) String() string {
+func (x *ArchiveTradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepositsRequest) ProtoMessage() {}
+func (*ArchiveTradesRequest) ProtoMessage() {}
 
-func (x *ListDepositsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[62]
+func (x *ArchiveTradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3164,53 +3799,106 @@ func (x *ListDepositsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepositsRequest.ProtoReflect.Descriptor instead.
-func (*ListDepositsRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ArchiveTradesRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTradesRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ListDepositsRequest) GetAccountName() string {
+func (x *ArchiveTradesRequest) GetRetentionDays() uint64 {
 	if x != nil {
-		return x.AccountName
+		return x.RetentionDays
 	}
-	return ""
+	return 0
 }
 
-func (x *ListDepositsRequest) GetPage() *Page {
+type ArchiveTradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of archived trades.
+	NumArchivedTrades uint64 `protobuf:"varint,1,opt,name=num_archived_trades,json=numArchivedTrades,proto3" json:"num_archived_trades,omitempty"`
+	// The location of the archive.
+	ArchiveLocation string `protobuf:"bytes,2,opt,name=archive_location,json=archiveLocation,proto3" json:"archive_location,omitempty"`
+}
+
+func (x *ArchiveTradesResponse) Reset() {
+	*x = ArchiveTradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveTradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTradesResponse) ProtoMessage() {}
+
+func (x *ArchiveTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTradesResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTradesResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ArchiveTradesResponse) GetNumArchivedTrades() uint64 {
 	if x != nil {
-		return x.Page
+		return x.NumArchivedTrades
 	}
-	return nil
+	return 0
 }
 
-type ListDepositsResponse struct {
+func (x *ArchiveTradesResponse) GetArchiveLocation() string {
+	if x != nil {
+		return x.ArchiveLocation
+	}
+	return ""
+}
+
+type GetBalanceHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the wallet account.
+	// Optional, the name of the wallet account, defaults to all accounts.
 	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// The list of info about the deposits.
-	Deposits []*Transaction `protobuf:"bytes,2,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// Optional, the time range of the snapshots, defaults to the whole history.
+	TimeRange *TimeRange `protobuf:"bytes,2,opt,name=time_range,json=timeRange,proto3" json:"time_range,omitempty"`
+	// The page for a paginated reply. The history can be paginated only with
+	// page tokens, page numbers are not supported.
+	Page *Page `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 }
 
-func (x *ListDepositsResponse) Reset() {
-	*x = ListDepositsResponse{}
+func (x *GetBalanceHistoryRequest) Reset() {
+	*x = GetBalanceHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[63]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDepositsResponse) String() string {
+func (x *GetBalanceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepositsResponse) ProtoMessage() {}
+func (*GetBalanceHistoryRequest) ProtoMessage() {}
 
-func (x *ListDepositsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[63]
+func (x *GetBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3221,53 +3909,60 @@ func (x *ListDepositsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepositsResponse.ProtoReflect.Descriptor instead.
-func (*ListDepositsResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use GetBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ListDepositsResponse) GetAccountName() string {
+func (x *GetBalanceHistoryRequest) GetAccountName() string {
 	if x != nil {
 		return x.AccountName
 	}
 	return ""
 }
 
-func (x *ListDepositsResponse) GetDeposits() []*Transaction {
+func (x *GetBalanceHistoryRequest) GetTimeRange() *TimeRange {
 	if x != nil {
-		return x.Deposits
+		return x.TimeRange
 	}
 	return nil
 }
 
-type ListWithdrawalsRequest struct {
+func (x *GetBalanceHistoryRequest) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetBalanceHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the wallet account for which listing the withdrawals.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// The page for a paginated reply.
-	Page *Page `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// The list of balance snapshots, sorted from the most recent.
+	Snapshots []*BalanceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	// The token of the next page, if any.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *ListWithdrawalsRequest) Reset() {
-	*x = ListWithdrawalsRequest{}
+func (x *GetBalanceHistoryResponse) Reset() {
+	*x = GetBalanceHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[64]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListWithdrawalsRequest) String() string {
+func (x *GetBalanceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWithdrawalsRequest) ProtoMessage() {}
+func (*GetBalanceHistoryResponse) ProtoMessage() {}
 
-func (x *ListWithdrawalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[64]
+func (x *GetBalanceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3278,53 +3973,139 @@ func (x *ListWithdrawalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWithdrawalsRequest.ProtoReflect.Descriptor instead.
-func (*ListWithdrawalsRequest) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use GetBalanceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListWithdrawalsRequest) GetAccountName() string {
+func (x *GetBalanceHistoryResponse) GetSnapshots() []*BalanceSnapshot {
 	if x != nil {
-		return x.AccountName
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *GetBalanceHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *ListWithdrawalsRequest) GetPage() *Page {
+type GetDBStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDBStatsRequest) Reset() {
+	*x = GetDBStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDBStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDBStatsRequest) ProtoMessage() {}
+
+func (x *GetDBStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDBStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDBStatsRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{76}
+}
+
+type GetDBStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The stats of every store.
+	Stores []*StoreStats `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (x *GetDBStatsResponse) Reset() {
+	*x = GetDBStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDBStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDBStatsResponse) ProtoMessage() {}
+
+func (x *GetDBStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDBStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDBStatsResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetDBStatsResponse) GetStores() []*StoreStats {
 	if x != nil {
-		return x.Page
+		return x.Stores
 	}
 	return nil
 }
 
-type ListWithdrawalsResponse struct {
+type RunDBGCRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the wallet account.
-	AccountName string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	// The list of info about the withdrawals.
-	Withdrawals []*Transaction `protobuf:"bytes,2,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	// Whether to also compact the stores. Slower than the sole value-log
+	// garbage collection but frees the most space. The pubsub store is
+	// reclaimed only by compaction.
+	Compact bool `protobuf:"varint,1,opt,name=compact,proto3" json:"compact,omitempty"`
 }
 
-func (x *ListWithdrawalsResponse) Reset() {
-	*x = ListWithdrawalsResponse{}
+func (x *RunDBGCRequest) Reset() {
+	*x = RunDBGCRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[65]
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListWithdrawalsResponse) String() string {
+func (x *RunDBGCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWithdrawalsResponse) ProtoMessage() {}
+func (*RunDBGCRequest) ProtoMessage() {}
 
-func (x *ListWithdrawalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[65]
+func (x *RunDBGCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3335,21 +4116,62 @@ func (x *ListWithdrawalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWithdrawalsResponse.ProtoReflect.Descriptor instead.
-func (*ListWithdrawalsResponse) Descriptor() ([]byte, []int) {
-	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use RunDBGCRequest.ProtoReflect.Descriptor instead.
+func (*RunDBGCRequest) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListWithdrawalsResponse) GetAccountName() string {
+func (x *RunDBGCRequest) GetCompact() bool {
 	if x != nil {
-		return x.AccountName
+		return x.Compact
 	}
-	return ""
+	return false
 }
 
-func (x *ListWithdrawalsResponse) GetWithdrawals() []*Transaction {
+type RunDBGCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The stats of every store once done.
+	Stores []*StoreStats `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+}
+
+func (x *RunDBGCResponse) Reset() {
+	*x = RunDBGCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tdex_daemon_v2_operator_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunDBGCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDBGCResponse) ProtoMessage() {}
+
+func (x *RunDBGCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tdex_daemon_v2_operator_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDBGCResponse.ProtoReflect.Descriptor instead.
+func (*RunDBGCResponse) Descriptor() ([]byte, []int) {
+	return file_tdex_daemon_v2_operator_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RunDBGCResponse) GetStores() []*StoreStats {
 	if x != nil {
-		return x.Withdrawals
+		return x.Stores
 	}
 	return nil
 }
@@ -3536,108 +4358,61 @@ var file_tdex_daemon_v2_operator_proto_rawDesc = []byte{
 	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
 	0x22, 0x25, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
 	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xa5, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x23, 0x44, 0x65, 0x72, 0x69, 0x76,
-	0x65, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
-	0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x02, 0x52, 0x0e, 0x6e, 0x75,
-	0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x24,
-	0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xce, 0x01,
-	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x56, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x53, 0x0a, 0x0c, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x73,
-	0x0a, 0x1e, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x53,
-	0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61,
-	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42,
-	0x79, 0x74, 0x65, 0x22, 0x3b, 0x0a, 0x1f, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65,
-	0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61,
-	0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42,
-	0x79, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x33, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x46, 0x72,
-	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x78, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x26, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
-	0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x02, 0x52, 0x0e, 0x6e, 0x75,
-	0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x27,
-	0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a,
-	0x25, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x25, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x24,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1e, 0x0a, 0x1c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x23, 0x44, 0x65, 0x72,
+	0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x02, 0x52, 0x0e,
+	0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x44,
+	0x0a, 0x24, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d,
 	0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x22, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x59, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x46,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x22, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x20, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xce, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67,
 	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x53, 0x0a, 0x0c, 0x42,
@@ -3646,228 +4421,402 @@ var file_tdex_daemon_v2_operator_proto_rawDesc = []byte{
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
 	0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61,
 	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x7a, 0x0a, 0x21, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x2c,
-	0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c,
-	0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x3e, 0x0a, 0x22,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x01, 0x0a,
-	0x1f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46,
-	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74,
-	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79,
-	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x36,
-	0x0a, 0x20, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x22, 0x73, 0x0a, 0x1e, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x3b, 0x0a, 0x1f, 0x46, 0x65, 0x65, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x1c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46,
+	0x65, 0x65, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x33, 0x0a, 0x1d, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65,
 	0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x69, 0x74, 0x68, 0x48, 0x65, 0x78, 0x22, 0x47, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74,
-	0x78, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
-	0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73,
-	0x12, 0x3b, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x22, 0x62, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x26, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x30, 0x02, 0x52, 0x0e,
+	0x6e, 0x75, 0x6d, 0x4f, 0x66, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x47,
+	0x0a, 0x27, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x24, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x45, 0x0a, 0x25, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72,
+	0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x53, 0x0a,
+	0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x7a, 0x0a, 0x21, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76,
+	0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x22, 0x3e,
+	0x0a, 0x22, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f,
+	0x01, 0x0a, 0x1f, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x61, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72,
+	0x42, 0x79, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x36, 0x0a, 0x20, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x69, 0x74, 0x68, 0x48, 0x65, 0x78, 0x22,
+	0x6f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x5f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x22, 0x72, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xc6, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0xd6, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x72, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x1a, 0x46, 0x0a, 0x18, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xc9, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x38, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0xe2, 0x02,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x7b, 0x0a, 0x17,
-	0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x32, 0xdf, 0x24, 0x0a, 0x0f, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x86, 0x01,
-	0x0a, 0x12, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2f,
-	0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46,
-	0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x75, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x1a, 0x46, 0x0a, 0x18, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x6a, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x69, 0x74, 0x68, 0x48, 0x65, 0x78, 0x22, 0x49,
+	0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x2b, 0x0a, 0x0d, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x26, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x3d,
+	0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0x72, 0x0a,
+	0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x38, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x52, 0x75, 0x6e,
+	0x44, 0x42, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x44, 0x42, 0x47, 0x43,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x32, 0xc2, 0x2b, 0x0a,
+	0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x86, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46,
+	0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x75, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x46, 0x65, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46,
-	0x65, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
-	0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x73, 0x0a, 0x0b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x12,
-	0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
-	0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x77, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x67, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a,
-	0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x78,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x24, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x92, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x72,
-	0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x2c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x12, 0x8f, 0x01,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x32, 0x2f, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
-	0x6f, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x2e,
-	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22,
-	0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x6f, 0x70, 0x65, 0x6e,
-	0x12, 0x73, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
-	0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
-	0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x32, 0x2f, 0x66,
+	0x65, 0x65, 0x2f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x27,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x66,
+	0x65, 0x65, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x75, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x73, 0x0a, 0x0b, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46,
+	0x65, 0x65, 0x12, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x66, 0x65, 0x65, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x67, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x12, 0x78, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x92, 0x01, 0x0a, 0x15,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f,
+	0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x12, 0x8f, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76,
+	0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x6f, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x12, 0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
+	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x6f,
+	0x70, 0x65, 0x6e, 0x12, 0x73, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
 	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2f, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x7f, 0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
-	0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a,
-	0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x77,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x74, 0x64,
-	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xa7, 0x01, 0x0a, 0x19, 0x55,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2f, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x7f, 0x0a, 0x0e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26,
+	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xa7, 0x01,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x30, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x30, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65, 0x12, 0x2b, 0x2e,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x73, 0x2f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65,
+	0x12, 0x2b, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
 	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x66, 0x65, 0x65, 0x73, 0x2f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0xa7, 0x01, 0x0a, 0x1b, 0x55,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x73, 0x2f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0xa7, 0x01,
+	0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e,
+	0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x74, 0x64, 0x65,
-	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x50, 0x72,
-	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
-	0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14,
-	0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
+	0x2a, 0x22, 0x14, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x70, 0x72,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0xaa, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x32,
+	0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
 	0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x2e, 0x74, 0x64, 0x65,
 	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
@@ -4027,21 +4976,64 @@ var file_tdex_daemon_v2_operator_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68,
 	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
 	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x32, 0x2f,
-	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x42, 0xd0, 0x01, 0x0a, 0x12,
-	0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x76, 0x32, 0x42, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x74, 0x64, 0x65,
-	0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x70, 0x65,
-	0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74,
-	0x64, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x3b, 0x74, 0x64,
-	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x76, 0x32, 0xa2, 0x02, 0x03, 0x54, 0x58,
-	0x58, 0xaa, 0x02, 0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56,
-	0x32, 0xca, 0x02, 0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x5c, 0x56,
-	0x32, 0xe2, 0x02, 0x19, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x5c, 0x56,
-	0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
-	0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x79, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01,
+	0x2a, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x1d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x32, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x30, 0x01, 0x12, 0x7b, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x64, 0x65, 0x78,
+	0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01,
+	0x2a, 0x22, 0x12, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x2f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x28, 0x2e, 0x74, 0x64,
+	0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x32,
+	0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x6c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x21, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x42, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01,
+	0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x60, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x44, 0x42, 0x47, 0x43, 0x12, 0x1e, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x44,
+	0x42, 0x47, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x64, 0x65,
+	0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x75, 0x6e, 0x44,
+	0x42, 0x47, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x62, 0x2f, 0x67,
+	0x63, 0x42, 0xd0, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x42, 0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x61,
+	0x70, 0x69, 0x2d, 0x73, 0x70, 0x65, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x64, 0x65, 0x78, 0x2d, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x76, 0x32, 0x3b, 0x74, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x76,
+	0x32, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x0d, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x19, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x54, 0x64, 0x65, 0x78, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4056,7 +5048,7 @@ func file_tdex_daemon_v2_operator_proto_rawDescGZIP() []byte {
 	return file_tdex_daemon_v2_operator_proto_rawDescData
 }
 
-var file_tdex_daemon_v2_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_tdex_daemon_v2_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
 var file_tdex_daemon_v2_operator_proto_goTypes = []interface{}{
 	(*DeriveFeeAddressesRequest)(nil),               // 0: tdex_daemon.v2.DeriveFeeAddressesRequest
 	(*DeriveFeeAddressesResponse)(nil),              // 1: tdex_daemon.v2.DeriveFeeAddressesResponse
@@ -4090,178 +5082,223 @@ var file_tdex_daemon_v2_operator_proto_goTypes = []interface{}{
 	(*UpdateMarketFixedFeeResponse)(nil),            // 29: tdex_daemon.v2.UpdateMarketFixedFeeResponse
 	(*UpdateMarketAssetsPrecisionRequest)(nil),      // 30: tdex_daemon.v2.UpdateMarketAssetsPrecisionRequest
 	(*UpdateMarketAssetsPrecisionResponse)(nil),     // 31: tdex_daemon.v2.UpdateMarketAssetsPrecisionResponse
-	(*UpdateMarketPriceRequest)(nil),                // 32: tdex_daemon.v2.UpdateMarketPriceRequest
-	(*UpdateMarketPriceResponse)(nil),               // 33: tdex_daemon.v2.UpdateMarketPriceResponse
-	(*UpdateMarketStrategyRequest)(nil),             // 34: tdex_daemon.v2.UpdateMarketStrategyRequest
-	(*UpdateMarketStrategyResponse)(nil),            // 35: tdex_daemon.v2.UpdateMarketStrategyResponse
-	(*DeriveFeeFragmenterAddressesRequest)(nil),     // 36: tdex_daemon.v2.DeriveFeeFragmenterAddressesRequest
-	(*DeriveFeeFragmenterAddressesResponse)(nil),    // 37: tdex_daemon.v2.DeriveFeeFragmenterAddressesResponse
-	(*ListFeeFragmenterAddressesRequest)(nil),       // 38: tdex_daemon.v2.ListFeeFragmenterAddressesRequest
-	(*ListFeeFragmenterAddressesResponse)(nil),      // 39: tdex_daemon.v2.ListFeeFragmenterAddressesResponse
-	(*GetFeeFragmenterBalanceRequest)(nil),          // 40: tdex_daemon.v2.GetFeeFragmenterBalanceRequest
-	(*GetFeeFragmenterBalanceResponse)(nil),         // 41: tdex_daemon.v2.GetFeeFragmenterBalanceResponse
-	(*FeeFragmenterSplitFundsRequest)(nil),          // 42: tdex_daemon.v2.FeeFragmenterSplitFundsRequest
-	(*FeeFragmenterSplitFundsResponse)(nil),         // 43: tdex_daemon.v2.FeeFragmenterSplitFundsResponse
-	(*WithdrawFeeFragmenterRequest)(nil),            // 44: tdex_daemon.v2.WithdrawFeeFragmenterRequest
-	(*WithdrawFeeFragmenterResponse)(nil),           // 45: tdex_daemon.v2.WithdrawFeeFragmenterResponse
-	(*DeriveMarketFragmenterAddressesRequest)(nil),  // 46: tdex_daemon.v2.DeriveMarketFragmenterAddressesRequest
-	(*DeriveMarketFragmenterAddressesResponse)(nil), // 47: tdex_daemon.v2.DeriveMarketFragmenterAddressesResponse
-	(*ListMarketFragmenterAddressesRequest)(nil),    // 48: tdex_daemon.v2.ListMarketFragmenterAddressesRequest
-	(*ListMarketFragmenterAddressesResponse)(nil),   // 49: tdex_daemon.v2.ListMarketFragmenterAddressesResponse
-	(*GetMarketFragmenterBalanceRequest)(nil),       // 50: tdex_daemon.v2.GetMarketFragmenterBalanceRequest
-	(*GetMarketFragmenterBalanceResponse)(nil),      // 51: tdex_daemon.v2.GetMarketFragmenterBalanceResponse
-	(*MarketFragmenterSplitFundsRequest)(nil),       // 52: tdex_daemon.v2.MarketFragmenterSplitFundsRequest
-	(*MarketFragmenterSplitFundsResponse)(nil),      // 53: tdex_daemon.v2.MarketFragmenterSplitFundsResponse
-	(*WithdrawMarketFragmenterRequest)(nil),         // 54: tdex_daemon.v2.WithdrawMarketFragmenterRequest
-	(*WithdrawMarketFragmenterResponse)(nil),        // 55: tdex_daemon.v2.WithdrawMarketFragmenterResponse
-	(*ListMarketsRequest)(nil),                      // 56: tdex_daemon.v2.ListMarketsRequest
-	(*ListMarketsResponse)(nil),                     // 57: tdex_daemon.v2.ListMarketsResponse
-	(*ListTradesRequest)(nil),                       // 58: tdex_daemon.v2.ListTradesRequest
-	(*ListTradesResponse)(nil),                      // 59: tdex_daemon.v2.ListTradesResponse
-	(*ListUtxosRequest)(nil),                        // 60: tdex_daemon.v2.ListUtxosRequest
-	(*ListUtxosResponse)(nil),                       // 61: tdex_daemon.v2.ListUtxosResponse
-	(*ListDepositsRequest)(nil),                     // 62: tdex_daemon.v2.ListDepositsRequest
-	(*ListDepositsResponse)(nil),                    // 63: tdex_daemon.v2.ListDepositsResponse
-	(*ListWithdrawalsRequest)(nil),                  // 64: tdex_daemon.v2.ListWithdrawalsRequest
-	(*ListWithdrawalsResponse)(nil),                 // 65: tdex_daemon.v2.ListWithdrawalsResponse
-	nil,                                             // 66: tdex_daemon.v2.GetFeeFragmenterBalanceResponse.BalanceEntry
-	nil,                                             // 67: tdex_daemon.v2.GetMarketFragmenterBalanceResponse.BalanceEntry
-	(*Balance)(nil),                                 // 68: tdex_daemon.v2.Balance
-	(*TxOutput)(nil),                                // 69: tdex_daemon.v2.TxOutput
-	(*v2.Market)(nil),                               // 70: tdex.v2.Market
-	(*v2.MarketFee)(nil),                            // 71: tdex.v2.MarketFee
-	(StrategyType)(0),                               // 72: tdex_daemon.v2.StrategyType
-	(*MarketInfo)(nil),                              // 73: tdex_daemon.v2.MarketInfo
-	(*TimeRange)(nil),                               // 74: tdex_daemon.v2.TimeRange
-	(TimeFrame)(0),                                  // 75: tdex_daemon.v2.TimeFrame
-	(*MarketReport)(nil),                            // 76: tdex_daemon.v2.MarketReport
-	(*v2.MarketWithFee)(nil),                        // 77: tdex.v2.MarketWithFee
-	(*v2.Price)(nil),                                // 78: tdex.v2.Price
-	(*Page)(nil),                                    // 79: tdex_daemon.v2.Page
-	(*TradeInfo)(nil),                               // 80: tdex_daemon.v2.TradeInfo
-	(*UtxoInfo)(nil),                                // 81: tdex_daemon.v2.UtxoInfo
-	(*Transaction)(nil),                             // 82: tdex_daemon.v2.Transaction
+	(*UpdateMarketTradeExpiryTimeRequest)(nil),      // 32: tdex_daemon.v2.UpdateMarketTradeExpiryTimeRequest
+	(*UpdateMarketTradeExpiryTimeResponse)(nil),     // 33: tdex_daemon.v2.UpdateMarketTradeExpiryTimeResponse
+	(*UpdateMarketPriceRequest)(nil),                // 34: tdex_daemon.v2.UpdateMarketPriceRequest
+	(*UpdateMarketPriceResponse)(nil),               // 35: tdex_daemon.v2.UpdateMarketPriceResponse
+	(*UpdateMarketStrategyRequest)(nil),             // 36: tdex_daemon.v2.UpdateMarketStrategyRequest
+	(*UpdateMarketStrategyResponse)(nil),            // 37: tdex_daemon.v2.UpdateMarketStrategyResponse
+	(*DeriveFeeFragmenterAddressesRequest)(nil),     // 38: tdex_daemon.v2.DeriveFeeFragmenterAddressesRequest
+	(*DeriveFeeFragmenterAddressesResponse)(nil),    // 39: tdex_daemon.v2.DeriveFeeFragmenterAddressesResponse
+	(*ListFeeFragmenterAddressesRequest)(nil),       // 40: tdex_daemon.v2.ListFeeFragmenterAddressesRequest
+	(*ListFeeFragmenterAddressesResponse)(nil),      // 41: tdex_daemon.v2.ListFeeFragmenterAddressesResponse
+	(*GetFeeFragmenterBalanceRequest)(nil),          // 42: tdex_daemon.v2.GetFeeFragmenterBalanceRequest
+	(*GetFeeFragmenterBalanceResponse)(nil),         // 43: tdex_daemon.v2.GetFeeFragmenterBalanceResponse
+	(*FeeFragmenterSplitFundsRequest)(nil),          // 44: tdex_daemon.v2.FeeFragmenterSplitFundsRequest
+	(*FeeFragmenterSplitFundsResponse)(nil),         // 45: tdex_daemon.v2.FeeFragmenterSplitFundsResponse
+	(*WithdrawFeeFragmenterRequest)(nil),            // 46: tdex_daemon.v2.WithdrawFeeFragmenterRequest
+	(*WithdrawFeeFragmenterResponse)(nil),           // 47: tdex_daemon.v2.WithdrawFeeFragmenterResponse
+	(*DeriveMarketFragmenterAddressesRequest)(nil),  // 48: tdex_daemon.v2.DeriveMarketFragmenterAddressesRequest
+	(*DeriveMarketFragmenterAddressesResponse)(nil), // 49: tdex_daemon.v2.DeriveMarketFragmenterAddressesResponse
+	(*ListMarketFragmenterAddressesRequest)(nil),    // 50: tdex_daemon.v2.ListMarketFragmenterAddressesRequest
+	(*ListMarketFragmenterAddressesResponse)(nil),   // 51: tdex_daemon.v2.ListMarketFragmenterAddressesResponse
+	(*GetMarketFragmenterBalanceRequest)(nil),       // 52: tdex_daemon.v2.GetMarketFragmenterBalanceRequest
+	(*GetMarketFragmenterBalanceResponse)(nil),      // 53: tdex_daemon.v2.GetMarketFragmenterBalanceResponse
+	(*MarketFragmenterSplitFundsRequest)(nil),       // 54: tdex_daemon.v2.MarketFragmenterSplitFundsRequest
+	(*MarketFragmenterSplitFundsResponse)(nil),      // 55: tdex_daemon.v2.MarketFragmenterSplitFundsResponse
+	(*WithdrawMarketFragmenterRequest)(nil),         // 56: tdex_daemon.v2.WithdrawMarketFragmenterRequest
+	(*WithdrawMarketFragmenterResponse)(nil),        // 57: tdex_daemon.v2.WithdrawMarketFragmenterResponse
+	(*ListMarketsRequest)(nil),                      // 58: tdex_daemon.v2.ListMarketsRequest
+	(*ListMarketsResponse)(nil),                     // 59: tdex_daemon.v2.ListMarketsResponse
+	(*ListTradesRequest)(nil),                       // 60: tdex_daemon.v2.ListTradesRequest
+	(*ListTradesResponse)(nil),                      // 61: tdex_daemon.v2.ListTradesResponse
+	(*ListUtxosRequest)(nil),                        // 62: tdex_daemon.v2.ListUtxosRequest
+	(*ListUtxosResponse)(nil),                       // 63: tdex_daemon.v2.ListUtxosResponse
+	(*ListDepositsRequest)(nil),                     // 64: tdex_daemon.v2.ListDepositsRequest
+	(*ListDepositsResponse)(nil),                    // 65: tdex_daemon.v2.ListDepositsResponse
+	(*ListWithdrawalsRequest)(nil),                  // 66: tdex_daemon.v2.ListWithdrawalsRequest
+	(*ListWithdrawalsResponse)(nil),                 // 67: tdex_daemon.v2.ListWithdrawalsResponse
+	(*ExportLedgerRequest)(nil),                     // 68: tdex_daemon.v2.ExportLedgerRequest
+	(*ExportLedgerResponse)(nil),                    // 69: tdex_daemon.v2.ExportLedgerResponse
+	(*BackupRequest)(nil),                           // 70: tdex_daemon.v2.BackupRequest
+	(*BackupResponse)(nil),                          // 71: tdex_daemon.v2.BackupResponse
+	(*ArchiveTradesRequest)(nil),                    // 72: tdex_daemon.v2.ArchiveTradesRequest
+	(*ArchiveTradesResponse)(nil),                   // 73: tdex_daemon.v2.ArchiveTradesResponse
+	(*GetBalanceHistoryRequest)(nil),                // 74: tdex_daemon.v2.GetBalanceHistoryRequest
+	(*GetBalanceHistoryResponse)(nil),               // 75: tdex_daemon.v2.GetBalanceHistoryResponse
+	(*GetDBStatsRequest)(nil),                       // 76: tdex_daemon.v2.GetDBStatsRequest
+	(*GetDBStatsResponse)(nil),                      // 77: tdex_daemon.v2.GetDBStatsResponse
+	(*RunDBGCRequest)(nil),                          // 78: tdex_daemon.v2.RunDBGCRequest
+	(*RunDBGCResponse)(nil),                         // 79: tdex_daemon.v2.RunDBGCResponse
+	nil,                                             // 80: tdex_daemon.v2.GetFeeFragmenterBalanceResponse.BalanceEntry
+	nil,                                             // 81: tdex_daemon.v2.GetMarketFragmenterBalanceResponse.BalanceEntry
+	nil,                                             // 82: tdex_daemon.v2.ListDepositsResponse.TotalAmountPerAssetEntry
+	nil,                                             // 83: tdex_daemon.v2.ListWithdrawalsResponse.TotalAmountPerAssetEntry
+	(*Balance)(nil),                                 // 84: tdex_daemon.v2.Balance
+	(*TxOutput)(nil),                                // 85: tdex_daemon.v2.TxOutput
+	(*v2.Market)(nil),                               // 86: tdex.v2.Market
+	(*v2.MarketFee)(nil),                            // 87: tdex.v2.MarketFee
+	(StrategyType)(0),                               // 88: tdex_daemon.v2.StrategyType
+	(*MarketInfo)(nil),                              // 89: tdex_daemon.v2.MarketInfo
+	(*TimeRange)(nil),                               // 90: tdex_daemon.v2.TimeRange
+	(TimeFrame)(0),                                  // 91: tdex_daemon.v2.TimeFrame
+	(*MarketReport)(nil),                            // 92: tdex_daemon.v2.MarketReport
+	(*v2.MarketWithFee)(nil),                        // 93: tdex.v2.MarketWithFee
+	(*v2.Price)(nil),                                // 94: tdex.v2.Price
+	(*Page)(nil),                                    // 95: tdex_daemon.v2.Page
+	(*TradeInfo)(nil),                               // 96: tdex_daemon.v2.TradeInfo
+	(*UtxoInfo)(nil),                                // 97: tdex_daemon.v2.UtxoInfo
+	(*Transaction)(nil),                             // 98: tdex_daemon.v2.Transaction
+	(*LedgerEntry)(nil),                             // 99: tdex_daemon.v2.LedgerEntry
+	(*BalanceSnapshot)(nil),                         // 100: tdex_daemon.v2.BalanceSnapshot
+	(*StoreStats)(nil),                              // 101: tdex_daemon.v2.StoreStats
 }
 var file_tdex_daemon_v2_operator_proto_depIdxs = []int32{
-	68, // 0: tdex_daemon.v2.GetFeeBalanceResponse.balance:type_name -> tdex_daemon.v2.Balance
-	69, // 1: tdex_daemon.v2.WithdrawFeeRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
-	70, // 2: tdex_daemon.v2.NewMarketRequest.market:type_name -> tdex.v2.Market
-	71, // 3: tdex_daemon.v2.NewMarketRequest.percentage_fee:type_name -> tdex.v2.MarketFee
-	71, // 4: tdex_daemon.v2.NewMarketRequest.fixed_fee:type_name -> tdex.v2.MarketFee
-	72, // 5: tdex_daemon.v2.NewMarketRequest.strategy_type:type_name -> tdex_daemon.v2.StrategyType
-	70, // 6: tdex_daemon.v2.GetMarketInfoRequest.market:type_name -> tdex.v2.Market
-	73, // 7: tdex_daemon.v2.GetMarketInfoResponse.info:type_name -> tdex_daemon.v2.MarketInfo
-	70, // 8: tdex_daemon.v2.DeriveMarketAddressesRequest.market:type_name -> tdex.v2.Market
-	70, // 9: tdex_daemon.v2.ListMarketAddressesRequest.market:type_name -> tdex.v2.Market
-	70, // 10: tdex_daemon.v2.OpenMarketRequest.market:type_name -> tdex.v2.Market
-	70, // 11: tdex_daemon.v2.CloseMarketRequest.market:type_name -> tdex.v2.Market
-	70, // 12: tdex_daemon.v2.DropMarketRequest.market:type_name -> tdex.v2.Market
-	70, // 13: tdex_daemon.v2.WithdrawMarketRequest.market:type_name -> tdex.v2.Market
-	69, // 14: tdex_daemon.v2.WithdrawMarketRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
-	70, // 15: tdex_daemon.v2.GetMarketReportRequest.market:type_name -> tdex.v2.Market
-	74, // 16: tdex_daemon.v2.GetMarketReportRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
-	75, // 17: tdex_daemon.v2.GetMarketReportRequest.time_frame:type_name -> tdex_daemon.v2.TimeFrame
-	76, // 18: tdex_daemon.v2.GetMarketReportResponse.report:type_name -> tdex_daemon.v2.MarketReport
-	70, // 19: tdex_daemon.v2.UpdateMarketPercentageFeeRequest.market:type_name -> tdex.v2.Market
-	71, // 20: tdex_daemon.v2.UpdateMarketPercentageFeeRequest.fee:type_name -> tdex.v2.MarketFee
-	77, // 21: tdex_daemon.v2.UpdateMarketPercentageFeeResponse.market_with_fee:type_name -> tdex.v2.MarketWithFee
-	70, // 22: tdex_daemon.v2.UpdateMarketFixedFeeRequest.market:type_name -> tdex.v2.Market
-	71, // 23: tdex_daemon.v2.UpdateMarketFixedFeeRequest.fee:type_name -> tdex.v2.MarketFee
-	77, // 24: tdex_daemon.v2.UpdateMarketFixedFeeResponse.market_with_fee:type_name -> tdex.v2.MarketWithFee
-	70, // 25: tdex_daemon.v2.UpdateMarketAssetsPrecisionRequest.market:type_name -> tdex.v2.Market
-	70, // 26: tdex_daemon.v2.UpdateMarketPriceRequest.market:type_name -> tdex.v2.Market
-	78, // 27: tdex_daemon.v2.UpdateMarketPriceRequest.price:type_name -> tdex.v2.Price
-	70, // 28: tdex_daemon.v2.UpdateMarketStrategyRequest.market:type_name -> tdex.v2.Market
-	72, // 29: tdex_daemon.v2.UpdateMarketStrategyRequest.strategy_type:type_name -> tdex_daemon.v2.StrategyType
-	66, // 30: tdex_daemon.v2.GetFeeFragmenterBalanceResponse.balance:type_name -> tdex_daemon.v2.GetFeeFragmenterBalanceResponse.BalanceEntry
-	69, // 31: tdex_daemon.v2.WithdrawFeeFragmenterRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
-	67, // 32: tdex_daemon.v2.GetMarketFragmenterBalanceResponse.balance:type_name -> tdex_daemon.v2.GetMarketFragmenterBalanceResponse.BalanceEntry
-	70, // 33: tdex_daemon.v2.MarketFragmenterSplitFundsRequest.market:type_name -> tdex.v2.Market
-	69, // 34: tdex_daemon.v2.WithdrawMarketFragmenterRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
-	73, // 35: tdex_daemon.v2.ListMarketsResponse.markets:type_name -> tdex_daemon.v2.MarketInfo
-	70, // 36: tdex_daemon.v2.ListTradesRequest.market:type_name -> tdex.v2.Market
-	79, // 37: tdex_daemon.v2.ListTradesRequest.page:type_name -> tdex_daemon.v2.Page
-	80, // 38: tdex_daemon.v2.ListTradesResponse.trades:type_name -> tdex_daemon.v2.TradeInfo
-	79, // 39: tdex_daemon.v2.ListUtxosRequest.page:type_name -> tdex_daemon.v2.Page
-	81, // 40: tdex_daemon.v2.ListUtxosResponse.spendable_utxos:type_name -> tdex_daemon.v2.UtxoInfo
-	81, // 41: tdex_daemon.v2.ListUtxosResponse.locked_utxos:type_name -> tdex_daemon.v2.UtxoInfo
-	79, // 42: tdex_daemon.v2.ListDepositsRequest.page:type_name -> tdex_daemon.v2.Page
-	82, // 43: tdex_daemon.v2.ListDepositsResponse.deposits:type_name -> tdex_daemon.v2.Transaction
-	79, // 44: tdex_daemon.v2.ListWithdrawalsRequest.page:type_name -> tdex_daemon.v2.Page
-	82, // 45: tdex_daemon.v2.ListWithdrawalsResponse.withdrawals:type_name -> tdex_daemon.v2.Transaction
-	68, // 46: tdex_daemon.v2.GetFeeFragmenterBalanceResponse.BalanceEntry.value:type_name -> tdex_daemon.v2.Balance
-	68, // 47: tdex_daemon.v2.GetMarketFragmenterBalanceResponse.BalanceEntry.value:type_name -> tdex_daemon.v2.Balance
-	0,  // 48: tdex_daemon.v2.OperatorService.DeriveFeeAddresses:input_type -> tdex_daemon.v2.DeriveFeeAddressesRequest
-	2,  // 49: tdex_daemon.v2.OperatorService.ListFeeAddresses:input_type -> tdex_daemon.v2.ListFeeAddressesRequest
-	4,  // 50: tdex_daemon.v2.OperatorService.GetFeeBalance:input_type -> tdex_daemon.v2.GetFeeBalanceRequest
-	6,  // 51: tdex_daemon.v2.OperatorService.WithdrawFee:input_type -> tdex_daemon.v2.WithdrawFeeRequest
-	8,  // 52: tdex_daemon.v2.OperatorService.NewMarket:input_type -> tdex_daemon.v2.NewMarketRequest
-	10, // 53: tdex_daemon.v2.OperatorService.GetMarketInfo:input_type -> tdex_daemon.v2.GetMarketInfoRequest
-	12, // 54: tdex_daemon.v2.OperatorService.DeriveMarketAddresses:input_type -> tdex_daemon.v2.DeriveMarketAddressesRequest
-	14, // 55: tdex_daemon.v2.OperatorService.ListMarketAddresses:input_type -> tdex_daemon.v2.ListMarketAddressesRequest
-	16, // 56: tdex_daemon.v2.OperatorService.OpenMarket:input_type -> tdex_daemon.v2.OpenMarketRequest
-	18, // 57: tdex_daemon.v2.OperatorService.CloseMarket:input_type -> tdex_daemon.v2.CloseMarketRequest
-	20, // 58: tdex_daemon.v2.OperatorService.DropMarket:input_type -> tdex_daemon.v2.DropMarketRequest
-	22, // 59: tdex_daemon.v2.OperatorService.WithdrawMarket:input_type -> tdex_daemon.v2.WithdrawMarketRequest
-	24, // 60: tdex_daemon.v2.OperatorService.GetMarketReport:input_type -> tdex_daemon.v2.GetMarketReportRequest
-	26, // 61: tdex_daemon.v2.OperatorService.UpdateMarketPercentageFee:input_type -> tdex_daemon.v2.UpdateMarketPercentageFeeRequest
-	28, // 62: tdex_daemon.v2.OperatorService.UpdateMarketFixedFee:input_type -> tdex_daemon.v2.UpdateMarketFixedFeeRequest
-	30, // 63: tdex_daemon.v2.OperatorService.UpdateMarketAssetsPrecision:input_type -> tdex_daemon.v2.UpdateMarketAssetsPrecisionRequest
-	32, // 64: tdex_daemon.v2.OperatorService.UpdateMarketPrice:input_type -> tdex_daemon.v2.UpdateMarketPriceRequest
-	34, // 65: tdex_daemon.v2.OperatorService.UpdateMarketStrategy:input_type -> tdex_daemon.v2.UpdateMarketStrategyRequest
-	36, // 66: tdex_daemon.v2.OperatorService.DeriveFeeFragmenterAddresses:input_type -> tdex_daemon.v2.DeriveFeeFragmenterAddressesRequest
-	38, // 67: tdex_daemon.v2.OperatorService.ListFeeFragmenterAddresses:input_type -> tdex_daemon.v2.ListFeeFragmenterAddressesRequest
-	40, // 68: tdex_daemon.v2.OperatorService.GetFeeFragmenterBalance:input_type -> tdex_daemon.v2.GetFeeFragmenterBalanceRequest
-	42, // 69: tdex_daemon.v2.OperatorService.FeeFragmenterSplitFunds:input_type -> tdex_daemon.v2.FeeFragmenterSplitFundsRequest
-	44, // 70: tdex_daemon.v2.OperatorService.WithdrawFeeFragmenter:input_type -> tdex_daemon.v2.WithdrawFeeFragmenterRequest
-	46, // 71: tdex_daemon.v2.OperatorService.DeriveMarketFragmenterAddresses:input_type -> tdex_daemon.v2.DeriveMarketFragmenterAddressesRequest
-	48, // 72: tdex_daemon.v2.OperatorService.ListMarketFragmenterAddresses:input_type -> tdex_daemon.v2.ListMarketFragmenterAddressesRequest
-	50, // 73: tdex_daemon.v2.OperatorService.GetMarketFragmenterBalance:input_type -> tdex_daemon.v2.GetMarketFragmenterBalanceRequest
-	52, // 74: tdex_daemon.v2.OperatorService.MarketFragmenterSplitFunds:input_type -> tdex_daemon.v2.MarketFragmenterSplitFundsRequest
-	54, // 75: tdex_daemon.v2.OperatorService.WithdrawMarketFragmenter:input_type -> tdex_daemon.v2.WithdrawMarketFragmenterRequest
-	56, // 76: tdex_daemon.v2.OperatorService.ListMarkets:input_type -> tdex_daemon.v2.ListMarketsRequest
-	58, // 77: tdex_daemon.v2.OperatorService.ListTrades:input_type -> tdex_daemon.v2.ListTradesRequest
-	60, // 78: tdex_daemon.v2.OperatorService.ListUtxos:input_type -> tdex_daemon.v2.ListUtxosRequest
-	62, // 79: tdex_daemon.v2.OperatorService.ListDeposits:input_type -> tdex_daemon.v2.ListDepositsRequest
-	64, // 80: tdex_daemon.v2.OperatorService.ListWithdrawals:input_type -> tdex_daemon.v2.ListWithdrawalsRequest
-	1,  // 81: tdex_daemon.v2.OperatorService.DeriveFeeAddresses:output_type -> tdex_daemon.v2.DeriveFeeAddressesResponse
-	3,  // 82: tdex_daemon.v2.OperatorService.ListFeeAddresses:output_type -> tdex_daemon.v2.ListFeeAddressesResponse
-	5,  // 83: tdex_daemon.v2.OperatorService.GetFeeBalance:output_type -> tdex_daemon.v2.GetFeeBalanceResponse
-	7,  // 84: tdex_daemon.v2.OperatorService.WithdrawFee:output_type -> tdex_daemon.v2.WithdrawFeeResponse
-	9,  // 85: tdex_daemon.v2.OperatorService.NewMarket:output_type -> tdex_daemon.v2.NewMarketResponse
-	11, // 86: tdex_daemon.v2.OperatorService.GetMarketInfo:output_type -> tdex_daemon.v2.GetMarketInfoResponse
-	13, // 87: tdex_daemon.v2.OperatorService.DeriveMarketAddresses:output_type -> tdex_daemon.v2.DeriveMarketAddressesResponse
-	15, // 88: tdex_daemon.v2.OperatorService.ListMarketAddresses:output_type -> tdex_daemon.v2.ListMarketAddressesResponse
-	17, // 89: tdex_daemon.v2.OperatorService.OpenMarket:output_type -> tdex_daemon.v2.OpenMarketResponse
-	19, // 90: tdex_daemon.v2.OperatorService.CloseMarket:output_type -> tdex_daemon.v2.CloseMarketResponse
-	21, // 91: tdex_daemon.v2.OperatorService.DropMarket:output_type -> tdex_daemon.v2.DropMarketResponse
-	23, // 92: tdex_daemon.v2.OperatorService.WithdrawMarket:output_type -> tdex_daemon.v2.WithdrawMarketResponse
-	25, // 93: tdex_daemon.v2.OperatorService.GetMarketReport:output_type -> tdex_daemon.v2.GetMarketReportResponse
-	27, // 94: tdex_daemon.v2.OperatorService.UpdateMarketPercentageFee:output_type -> tdex_daemon.v2.UpdateMarketPercentageFeeResponse
-	29, // 95: tdex_daemon.v2.OperatorService.UpdateMarketFixedFee:output_type -> tdex_daemon.v2.UpdateMarketFixedFeeResponse
-	31, // 96: tdex_daemon.v2.OperatorService.UpdateMarketAssetsPrecision:output_type -> tdex_daemon.v2.UpdateMarketAssetsPrecisionResponse
-	33, // 97: tdex_daemon.v2.OperatorService.UpdateMarketPrice:output_type -> tdex_daemon.v2.UpdateMarketPriceResponse
-	35, // 98: tdex_daemon.v2.OperatorService.UpdateMarketStrategy:output_type -> tdex_daemon.v2.UpdateMarketStrategyResponse
-	37, // 99: tdex_daemon.v2.OperatorService.DeriveFeeFragmenterAddresses:output_type -> tdex_daemon.v2.DeriveFeeFragmenterAddressesResponse
-	39, // 100: tdex_daemon.v2.OperatorService.ListFeeFragmenterAddresses:output_type -> tdex_daemon.v2.ListFeeFragmenterAddressesResponse
-	41, // 101: tdex_daemon.v2.OperatorService.GetFeeFragmenterBalance:output_type -> tdex_daemon.v2.GetFeeFragmenterBalanceResponse
-	43, // 102: tdex_daemon.v2.OperatorService.FeeFragmenterSplitFunds:output_type -> tdex_daemon.v2.FeeFragmenterSplitFundsResponse
-	45, // 103: tdex_daemon.v2.OperatorService.WithdrawFeeFragmenter:output_type -> tdex_daemon.v2.WithdrawFeeFragmenterResponse
-	47, // 104: tdex_daemon.v2.OperatorService.DeriveMarketFragmenterAddresses:output_type -> tdex_daemon.v2.DeriveMarketFragmenterAddressesResponse
-	49, // 105: tdex_daemon.v2.OperatorService.ListMarketFragmenterAddresses:output_type -> tdex_daemon.v2.ListMarketFragmenterAddressesResponse
-	51, // 106: tdex_daemon.v2.OperatorService.GetMarketFragmenterBalance:output_type -> tdex_daemon.v2.GetMarketFragmenterBalanceResponse
-	53, // 107: tdex_daemon.v2.OperatorService.MarketFragmenterSplitFunds:output_type -> tdex_daemon.v2.MarketFragmenterSplitFundsResponse
-	55, // 108: tdex_daemon.v2.OperatorService.WithdrawMarketFragmenter:output_type -> tdex_daemon.v2.WithdrawMarketFragmenterResponse
-	57, // 109: tdex_daemon.v2.OperatorService.ListMarkets:output_type -> tdex_daemon.v2.ListMarketsResponse
-	59, // 110: tdex_daemon.v2.OperatorService.ListTrades:output_type -> tdex_daemon.v2.ListTradesResponse
-	61, // 111: tdex_daemon.v2.OperatorService.ListUtxos:output_type -> tdex_daemon.v2.ListUtxosResponse
-	63, // 112: tdex_daemon.v2.OperatorService.ListDeposits:output_type -> tdex_daemon.v2.ListDepositsResponse
-	65, // 113: tdex_daemon.v2.OperatorService.ListWithdrawals:output_type -> tdex_daemon.v2.ListWithdrawalsResponse
-	81, // [81:114] is the sub-list for method output_type
-	48, // [48:81] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	84,  // 0: tdex_daemon.v2.GetFeeBalanceResponse.balance:type_name -> tdex_daemon.v2.Balance
+	85,  // 1: tdex_daemon.v2.WithdrawFeeRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
+	86,  // 2: tdex_daemon.v2.NewMarketRequest.market:type_name -> tdex.v2.Market
+	87,  // 3: tdex_daemon.v2.NewMarketRequest.percentage_fee:type_name -> tdex.v2.MarketFee
+	87,  // 4: tdex_daemon.v2.NewMarketRequest.fixed_fee:type_name -> tdex.v2.MarketFee
+	88,  // 5: tdex_daemon.v2.NewMarketRequest.strategy_type:type_name -> tdex_daemon.v2.StrategyType
+	86,  // 6: tdex_daemon.v2.GetMarketInfoRequest.market:type_name -> tdex.v2.Market
+	89,  // 7: tdex_daemon.v2.GetMarketInfoResponse.info:type_name -> tdex_daemon.v2.MarketInfo
+	86,  // 8: tdex_daemon.v2.DeriveMarketAddressesRequest.market:type_name -> tdex.v2.Market
+	86,  // 9: tdex_daemon.v2.ListMarketAddressesRequest.market:type_name -> tdex.v2.Market
+	86,  // 10: tdex_daemon.v2.OpenMarketRequest.market:type_name -> tdex.v2.Market
+	86,  // 11: tdex_daemon.v2.CloseMarketRequest.market:type_name -> tdex.v2.Market
+	86,  // 12: tdex_daemon.v2.DropMarketRequest.market:type_name -> tdex.v2.Market
+	86,  // 13: tdex_daemon.v2.WithdrawMarketRequest.market:type_name -> tdex.v2.Market
+	85,  // 14: tdex_daemon.v2.WithdrawMarketRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
+	86,  // 15: tdex_daemon.v2.GetMarketReportRequest.market:type_name -> tdex.v2.Market
+	90,  // 16: tdex_daemon.v2.GetMarketReportRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
+	91,  // 17: tdex_daemon.v2.GetMarketReportRequest.time_frame:type_name -> tdex_daemon.v2.TimeFrame
+	92,  // 18: tdex_daemon.v2.GetMarketReportResponse.report:type_name -> tdex_daemon.v2.MarketReport
+	86,  // 19: tdex_daemon.v2.UpdateMarketPercentageFeeRequest.market:type_name -> tdex.v2.Market
+	87,  // 20: tdex_daemon.v2.UpdateMarketPercentageFeeRequest.fee:type_name -> tdex.v2.MarketFee
+	93,  // 21: tdex_daemon.v2.UpdateMarketPercentageFeeResponse.market_with_fee:type_name -> tdex.v2.MarketWithFee
+	86,  // 22: tdex_daemon.v2.UpdateMarketFixedFeeRequest.market:type_name -> tdex.v2.Market
+	87,  // 23: tdex_daemon.v2.UpdateMarketFixedFeeRequest.fee:type_name -> tdex.v2.MarketFee
+	93,  // 24: tdex_daemon.v2.UpdateMarketFixedFeeResponse.market_with_fee:type_name -> tdex.v2.MarketWithFee
+	86,  // 25: tdex_daemon.v2.UpdateMarketAssetsPrecisionRequest.market:type_name -> tdex.v2.Market
+	86,  // 26: tdex_daemon.v2.UpdateMarketTradeExpiryTimeRequest.market:type_name -> tdex.v2.Market
+	86,  // 27: tdex_daemon.v2.UpdateMarketPriceRequest.market:type_name -> tdex.v2.Market
+	94,  // 28: tdex_daemon.v2.UpdateMarketPriceRequest.price:type_name -> tdex.v2.Price
+	86,  // 29: tdex_daemon.v2.UpdateMarketStrategyRequest.market:type_name -> tdex.v2.Market
+	88,  // 30: tdex_daemon.v2.UpdateMarketStrategyRequest.strategy_type:type_name -> tdex_daemon.v2.StrategyType
+	80,  // 31: tdex_daemon.v2.GetFeeFragmenterBalanceResponse.balance:type_name -> tdex_daemon.v2.GetFeeFragmenterBalanceResponse.BalanceEntry
+	85,  // 32: tdex_daemon.v2.WithdrawFeeFragmenterRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
+	81,  // 33: tdex_daemon.v2.GetMarketFragmenterBalanceResponse.balance:type_name -> tdex_daemon.v2.GetMarketFragmenterBalanceResponse.BalanceEntry
+	86,  // 34: tdex_daemon.v2.MarketFragmenterSplitFundsRequest.market:type_name -> tdex.v2.Market
+	85,  // 35: tdex_daemon.v2.WithdrawMarketFragmenterRequest.outputs:type_name -> tdex_daemon.v2.TxOutput
+	89,  // 36: tdex_daemon.v2.ListMarketsResponse.markets:type_name -> tdex_daemon.v2.MarketInfo
+	86,  // 37: tdex_daemon.v2.ListTradesRequest.market:type_name -> tdex.v2.Market
+	95,  // 38: tdex_daemon.v2.ListTradesRequest.page:type_name -> tdex_daemon.v2.Page
+	96,  // 39: tdex_daemon.v2.ListTradesResponse.trades:type_name -> tdex_daemon.v2.TradeInfo
+	95,  // 40: tdex_daemon.v2.ListUtxosRequest.page:type_name -> tdex_daemon.v2.Page
+	97,  // 41: tdex_daemon.v2.ListUtxosResponse.spendable_utxos:type_name -> tdex_daemon.v2.UtxoInfo
+	97,  // 42: tdex_daemon.v2.ListUtxosResponse.locked_utxos:type_name -> tdex_daemon.v2.UtxoInfo
+	95,  // 43: tdex_daemon.v2.ListDepositsRequest.page:type_name -> tdex_daemon.v2.Page
+	90,  // 44: tdex_daemon.v2.ListDepositsRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
+	98,  // 45: tdex_daemon.v2.ListDepositsResponse.deposits:type_name -> tdex_daemon.v2.Transaction
+	82,  // 46: tdex_daemon.v2.ListDepositsResponse.total_amount_per_asset:type_name -> tdex_daemon.v2.ListDepositsResponse.TotalAmountPerAssetEntry
+	95,  // 47: tdex_daemon.v2.ListWithdrawalsRequest.page:type_name -> tdex_daemon.v2.Page
+	90,  // 48: tdex_daemon.v2.ListWithdrawalsRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
+	98,  // 49: tdex_daemon.v2.ListWithdrawalsResponse.withdrawals:type_name -> tdex_daemon.v2.Transaction
+	83,  // 50: tdex_daemon.v2.ListWithdrawalsResponse.total_amount_per_asset:type_name -> tdex_daemon.v2.ListWithdrawalsResponse.TotalAmountPerAssetEntry
+	90,  // 51: tdex_daemon.v2.ExportLedgerRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
+	99,  // 52: tdex_daemon.v2.ExportLedgerResponse.entry:type_name -> tdex_daemon.v2.LedgerEntry
+	90,  // 53: tdex_daemon.v2.GetBalanceHistoryRequest.time_range:type_name -> tdex_daemon.v2.TimeRange
+	95,  // 54: tdex_daemon.v2.GetBalanceHistoryRequest.page:type_name -> tdex_daemon.v2.Page
+	100, // 55: tdex_daemon.v2.GetBalanceHistoryResponse.snapshots:type_name -> tdex_daemon.v2.BalanceSnapshot
+	101, // 56: tdex_daemon.v2.GetDBStatsResponse.stores:type_name -> tdex_daemon.v2.StoreStats
+	101, // 57: tdex_daemon.v2.RunDBGCResponse.stores:type_name -> tdex_daemon.v2.StoreStats
+	84,  // 58: tdex_daemon.v2.GetFeeFragmenterBalanceResponse.BalanceEntry.value:type_name -> tdex_daemon.v2.Balance
+	84,  // 59: tdex_daemon.v2.GetMarketFragmenterBalanceResponse.BalanceEntry.value:type_name -> tdex_daemon.v2.Balance
+	0,   // 60: tdex_daemon.v2.OperatorService.DeriveFeeAddresses:input_type -> tdex_daemon.v2.DeriveFeeAddressesRequest
+	2,   // 61: tdex_daemon.v2.OperatorService.ListFeeAddresses:input_type -> tdex_daemon.v2.ListFeeAddressesRequest
+	4,   // 62: tdex_daemon.v2.OperatorService.GetFeeBalance:input_type -> tdex_daemon.v2.GetFeeBalanceRequest
+	6,   // 63: tdex_daemon.v2.OperatorService.WithdrawFee:input_type -> tdex_daemon.v2.WithdrawFeeRequest
+	8,   // 64: tdex_daemon.v2.OperatorService.NewMarket:input_type -> tdex_daemon.v2.NewMarketRequest
+	10,  // 65: tdex_daemon.v2.OperatorService.GetMarketInfo:input_type -> tdex_daemon.v2.GetMarketInfoRequest
+	12,  // 66: tdex_daemon.v2.OperatorService.DeriveMarketAddresses:input_type -> tdex_daemon.v2.DeriveMarketAddressesRequest
+	14,  // 67: tdex_daemon.v2.OperatorService.ListMarketAddresses:input_type -> tdex_daemon.v2.ListMarketAddressesRequest
+	16,  // 68: tdex_daemon.v2.OperatorService.OpenMarket:input_type -> tdex_daemon.v2.OpenMarketRequest
+	18,  // 69: tdex_daemon.v2.OperatorService.CloseMarket:input_type -> tdex_daemon.v2.CloseMarketRequest
+	20,  // 70: tdex_daemon.v2.OperatorService.DropMarket:input_type -> tdex_daemon.v2.DropMarketRequest
+	22,  // 71: tdex_daemon.v2.OperatorService.WithdrawMarket:input_type -> tdex_daemon.v2.WithdrawMarketRequest
+	24,  // 72: tdex_daemon.v2.OperatorService.GetMarketReport:input_type -> tdex_daemon.v2.GetMarketReportRequest
+	26,  // 73: tdex_daemon.v2.OperatorService.UpdateMarketPercentageFee:input_type -> tdex_daemon.v2.UpdateMarketPercentageFeeRequest
+	28,  // 74: tdex_daemon.v2.OperatorService.UpdateMarketFixedFee:input_type -> tdex_daemon.v2.UpdateMarketFixedFeeRequest
+	30,  // 75: tdex_daemon.v2.OperatorService.UpdateMarketAssetsPrecision:input_type -> tdex_daemon.v2.UpdateMarketAssetsPrecisionRequest
+	32,  // 76: tdex_daemon.v2.OperatorService.UpdateMarketTradeExpiryTime:input_type -> tdex_daemon.v2.UpdateMarketTradeExpiryTimeRequest
+	34,  // 77: tdex_daemon.v2.OperatorService.UpdateMarketPrice:input_type -> tdex_daemon.v2.UpdateMarketPriceRequest
+	36,  // 78: tdex_daemon.v2.OperatorService.UpdateMarketStrategy:input_type -> tdex_daemon.v2.UpdateMarketStrategyRequest
+	38,  // 79: tdex_daemon.v2.OperatorService.DeriveFeeFragmenterAddresses:input_type -> tdex_daemon.v2.DeriveFeeFragmenterAddressesRequest
+	40,  // 80: tdex_daemon.v2.OperatorService.ListFeeFragmenterAddresses:input_type -> tdex_daemon.v2.ListFeeFragmenterAddressesRequest
+	42,  // 81: tdex_daemon.v2.OperatorService.GetFeeFragmenterBalance:input_type -> tdex_daemon.v2.GetFeeFragmenterBalanceRequest
+	44,  // 82: tdex_daemon.v2.OperatorService.FeeFragmenterSplitFunds:input_type -> tdex_daemon.v2.FeeFragmenterSplitFundsRequest
+	46,  // 83: tdex_daemon.v2.OperatorService.WithdrawFeeFragmenter:input_type -> tdex_daemon.v2.WithdrawFeeFragmenterRequest
+	48,  // 84: tdex_daemon.v2.OperatorService.DeriveMarketFragmenterAddresses:input_type -> tdex_daemon.v2.DeriveMarketFragmenterAddressesRequest
+	50,  // 85: tdex_daemon.v2.OperatorService.ListMarketFragmenterAddresses:input_type -> tdex_daemon.v2.ListMarketFragmenterAddressesRequest
+	52,  // 86: tdex_daemon.v2.OperatorService.GetMarketFragmenterBalance:input_type -> tdex_daemon.v2.GetMarketFragmenterBalanceRequest
+	54,  // 87: tdex_daemon.v2.OperatorService.MarketFragmenterSplitFunds:input_type -> tdex_daemon.v2.MarketFragmenterSplitFundsRequest
+	56,  // 88: tdex_daemon.v2.OperatorService.WithdrawMarketFragmenter:input_type -> tdex_daemon.v2.WithdrawMarketFragmenterRequest
+	58,  // 89: tdex_daemon.v2.OperatorService.ListMarkets:input_type -> tdex_daemon.v2.ListMarketsRequest
+	60,  // 90: tdex_daemon.v2.OperatorService.ListTrades:input_type -> tdex_daemon.v2.ListTradesRequest
+	62,  // 91: tdex_daemon.v2.OperatorService.ListUtxos:input_type -> tdex_daemon.v2.ListUtxosRequest
+	64,  // 92: tdex_daemon.v2.OperatorService.ListDeposits:input_type -> tdex_daemon.v2.ListDepositsRequest
+	66,  // 93: tdex_daemon.v2.OperatorService.ListWithdrawals:input_type -> tdex_daemon.v2.ListWithdrawalsRequest
+	68,  // 94: tdex_daemon.v2.OperatorService.ExportLedger:input_type -> tdex_daemon.v2.ExportLedgerRequest
+	70,  // 95: tdex_daemon.v2.OperatorService.Backup:input_type -> tdex_daemon.v2.BackupRequest
+	72,  // 96: tdex_daemon.v2.OperatorService.ArchiveTrades:input_type -> tdex_daemon.v2.ArchiveTradesRequest
+	74,  // 97: tdex_daemon.v2.OperatorService.GetBalanceHistory:input_type -> tdex_daemon.v2.GetBalanceHistoryRequest
+	76,  // 98: tdex_daemon.v2.OperatorService.GetDBStats:input_type -> tdex_daemon.v2.GetDBStatsRequest
+	78,  // 99: tdex_daemon.v2.OperatorService.RunDBGC:input_type -> tdex_daemon.v2.RunDBGCRequest
+	1,   // 100: tdex_daemon.v2.OperatorService.DeriveFeeAddresses:output_type -> tdex_daemon.v2.DeriveFeeAddressesResponse
+	3,   // 101: tdex_daemon.v2.OperatorService.ListFeeAddresses:output_type -> tdex_daemon.v2.ListFeeAddressesResponse
+	5,   // 102: tdex_daemon.v2.OperatorService.GetFeeBalance:output_type -> tdex_daemon.v2.GetFeeBalanceResponse
+	7,   // 103: tdex_daemon.v2.OperatorService.WithdrawFee:output_type -> tdex_daemon.v2.WithdrawFeeResponse
+	9,   // 104: tdex_daemon.v2.OperatorService.NewMarket:output_type -> tdex_daemon.v2.NewMarketResponse
+	11,  // 105: tdex_daemon.v2.OperatorService.GetMarketInfo:output_type -> tdex_daemon.v2.GetMarketInfoResponse
+	13,  // 106: tdex_daemon.v2.OperatorService.DeriveMarketAddresses:output_type -> tdex_daemon.v2.DeriveMarketAddressesResponse
+	15,  // 107: tdex_daemon.v2.OperatorService.ListMarketAddresses:output_type -> tdex_daemon.v2.ListMarketAddressesResponse
+	17,  // 108: tdex_daemon.v2.OperatorService.OpenMarket:output_type -> tdex_daemon.v2.OpenMarketResponse
+	19,  // 109: tdex_daemon.v2.OperatorService.CloseMarket:output_type -> tdex_daemon.v2.CloseMarketResponse
+	21,  // 110: tdex_daemon.v2.OperatorService.DropMarket:output_type -> tdex_daemon.v2.DropMarketResponse
+	23,  // 111: tdex_daemon.v2.OperatorService.WithdrawMarket:output_type -> tdex_daemon.v2.WithdrawMarketResponse
+	25,  // 112: tdex_daemon.v2.OperatorService.GetMarketReport:output_type -> tdex_daemon.v2.GetMarketReportResponse
+	27,  // 113: tdex_daemon.v2.OperatorService.UpdateMarketPercentageFee:output_type -> tdex_daemon.v2.UpdateMarketPercentageFeeResponse
+	29,  // 114: tdex_daemon.v2.OperatorService.UpdateMarketFixedFee:output_type -> tdex_daemon.v2.UpdateMarketFixedFeeResponse
+	31,  // 115: tdex_daemon.v2.OperatorService.UpdateMarketAssetsPrecision:output_type -> tdex_daemon.v2.UpdateMarketAssetsPrecisionResponse
+	33,  // 116: tdex_daemon.v2.OperatorService.UpdateMarketTradeExpiryTime:output_type -> tdex_daemon.v2.UpdateMarketTradeExpiryTimeResponse
+	35,  // 117: tdex_daemon.v2.OperatorService.UpdateMarketPrice:output_type -> tdex_daemon.v2.UpdateMarketPriceResponse
+	37,  // 118: tdex_daemon.v2.OperatorService.UpdateMarketStrategy:output_type -> tdex_daemon.v2.UpdateMarketStrategyResponse
+	39,  // 119: tdex_daemon.v2.OperatorService.DeriveFeeFragmenterAddresses:output_type -> tdex_daemon.v2.DeriveFeeFragmenterAddressesResponse
+	41,  // 120: tdex_daemon.v2.OperatorService.ListFeeFragmenterAddresses:output_type -> tdex_daemon.v2.ListFeeFragmenterAddressesResponse
+	43,  // 121: tdex_daemon.v2.OperatorService.GetFeeFragmenterBalance:output_type -> tdex_daemon.v2.GetFeeFragmenterBalanceResponse
+	45,  // 122: tdex_daemon.v2.OperatorService.FeeFragmenterSplitFunds:output_type -> tdex_daemon.v2.FeeFragmenterSplitFundsResponse
+	47,  // 123: tdex_daemon.v2.OperatorService.WithdrawFeeFragmenter:output_type -> tdex_daemon.v2.WithdrawFeeFragmenterResponse
+	49,  // 124: tdex_daemon.v2.OperatorService.DeriveMarketFragmenterAddresses:output_type -> tdex_daemon.v2.DeriveMarketFragmenterAddressesResponse
+	51,  // 125: tdex_daemon.v2.OperatorService.ListMarketFragmenterAddresses:output_type -> tdex_daemon.v2.ListMarketFragmenterAddressesResponse
+	53,  // 126: tdex_daemon.v2.OperatorService.GetMarketFragmenterBalance:output_type -> tdex_daemon.v2.GetMarketFragmenterBalanceResponse
+	55,  // 127: tdex_daemon.v2.OperatorService.MarketFragmenterSplitFunds:output_type -> tdex_daemon.v2.MarketFragmenterSplitFundsResponse
+	57,  // 128: tdex_daemon.v2.OperatorService.WithdrawMarketFragmenter:output_type -> tdex_daemon.v2.WithdrawMarketFragmenterResponse
+	59,  // 129: tdex_daemon.v2.OperatorService.ListMarkets:output_type -> tdex_daemon.v2.ListMarketsResponse
+	61,  // 130: tdex_daemon.v2.OperatorService.ListTrades:output_type -> tdex_daemon.v2.ListTradesResponse
+	63,  // 131: tdex_daemon.v2.OperatorService.ListUtxos:output_type -> tdex_daemon.v2.ListUtxosResponse
+	65,  // 132: tdex_daemon.v2.OperatorService.ListDeposits:output_type -> tdex_daemon.v2.ListDepositsResponse
+	67,  // 133: tdex_daemon.v2.OperatorService.ListWithdrawals:output_type -> tdex_daemon.v2.ListWithdrawalsResponse
+	69,  // 134: tdex_daemon.v2.OperatorService.ExportLedger:output_type -> tdex_daemon.v2.ExportLedgerResponse
+	71,  // 135: tdex_daemon.v2.OperatorService.Backup:output_type -> tdex_daemon.v2.BackupResponse
+	73,  // 136: tdex_daemon.v2.OperatorService.ArchiveTrades:output_type -> tdex_daemon.v2.ArchiveTradesResponse
+	75,  // 137: tdex_daemon.v2.OperatorService.GetBalanceHistory:output_type -> tdex_daemon.v2.GetBalanceHistoryResponse
+	77,  // 138: tdex_daemon.v2.OperatorService.GetDBStats:output_type -> tdex_daemon.v2.GetDBStatsResponse
+	79,  // 139: tdex_daemon.v2.OperatorService.RunDBGC:output_type -> tdex_daemon.v2.RunDBGCResponse
+	100, // [100:140] is the sub-list for method output_type
+	60,  // [60:100] is the sub-list for method input_type
+	60,  // [60:60] is the sub-list for extension type_name
+	60,  // [60:60] is the sub-list for extension extendee
+	0,   // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_tdex_daemon_v2_operator_proto_init() }
@@ -4656,7 +5693,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketPriceRequest); i {
+			switch v := v.(*UpdateMarketTradeExpiryTimeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4668,7 +5705,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketPriceResponse); i {
+			switch v := v.(*UpdateMarketTradeExpiryTimeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4680,7 +5717,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketStrategyRequest); i {
+			switch v := v.(*UpdateMarketPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4692,7 +5729,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMarketStrategyResponse); i {
+			switch v := v.(*UpdateMarketPriceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4704,7 +5741,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeriveFeeFragmenterAddressesRequest); i {
+			switch v := v.(*UpdateMarketStrategyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4716,7 +5753,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeriveFeeFragmenterAddressesResponse); i {
+			switch v := v.(*UpdateMarketStrategyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4728,7 +5765,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFeeFragmenterAddressesRequest); i {
+			switch v := v.(*DeriveFeeFragmenterAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4740,7 +5777,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFeeFragmenterAddressesResponse); i {
+			switch v := v.(*DeriveFeeFragmenterAddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4752,7 +5789,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFeeFragmenterBalanceRequest); i {
+			switch v := v.(*ListFeeFragmenterAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4764,7 +5801,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFeeFragmenterBalanceResponse); i {
+			switch v := v.(*ListFeeFragmenterAddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4776,7 +5813,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeFragmenterSplitFundsRequest); i {
+			switch v := v.(*GetFeeFragmenterBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4788,7 +5825,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FeeFragmenterSplitFundsResponse); i {
+			switch v := v.(*GetFeeFragmenterBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4800,7 +5837,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawFeeFragmenterRequest); i {
+			switch v := v.(*FeeFragmenterSplitFundsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4812,7 +5849,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawFeeFragmenterResponse); i {
+			switch v := v.(*FeeFragmenterSplitFundsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4824,7 +5861,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeriveMarketFragmenterAddressesRequest); i {
+			switch v := v.(*WithdrawFeeFragmenterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4836,7 +5873,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeriveMarketFragmenterAddressesResponse); i {
+			switch v := v.(*WithdrawFeeFragmenterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4848,7 +5885,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketFragmenterAddressesRequest); i {
+			switch v := v.(*DeriveMarketFragmenterAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4860,7 +5897,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketFragmenterAddressesResponse); i {
+			switch v := v.(*DeriveMarketFragmenterAddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4872,7 +5909,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketFragmenterBalanceRequest); i {
+			switch v := v.(*ListMarketFragmenterAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4884,7 +5921,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMarketFragmenterBalanceResponse); i {
+			switch v := v.(*ListMarketFragmenterAddressesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4896,7 +5933,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketFragmenterSplitFundsRequest); i {
+			switch v := v.(*GetMarketFragmenterBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4908,7 +5945,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketFragmenterSplitFundsResponse); i {
+			switch v := v.(*GetMarketFragmenterBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4920,7 +5957,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawMarketFragmenterRequest); i {
+			switch v := v.(*MarketFragmenterSplitFundsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4932,7 +5969,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithdrawMarketFragmenterResponse); i {
+			switch v := v.(*MarketFragmenterSplitFundsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4944,7 +5981,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketsRequest); i {
+			switch v := v.(*WithdrawMarketFragmenterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4956,7 +5993,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMarketsResponse); i {
+			switch v := v.(*WithdrawMarketFragmenterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4968,7 +6005,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTradesRequest); i {
+			switch v := v.(*ListMarketsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4980,7 +6017,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTradesResponse); i {
+			switch v := v.(*ListMarketsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4992,7 +6029,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUtxosRequest); i {
+			switch v := v.(*ListTradesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5004,7 +6041,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUtxosResponse); i {
+			switch v := v.(*ListTradesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5016,7 +6053,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDepositsRequest); i {
+			switch v := v.(*ListUtxosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5028,7 +6065,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDepositsResponse); i {
+			switch v := v.(*ListUtxosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5040,7 +6077,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWithdrawalsRequest); i {
+			switch v := v.(*ListDepositsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5052,6 +6089,30 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			}
 		}
 		file_tdex_daemon_v2_operator_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDepositsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWithdrawalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListWithdrawalsResponse); i {
 			case 0:
 				return &v.state
@@ -5063,6 +6124,150 @@ func file_tdex_daemon_v2_operator_proto_init() {
 				return nil
 			}
 		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveTradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveTradesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDBStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunDBGCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tdex_daemon_v2_operator_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunDBGCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5070,7 +6275,7 @@ func file_tdex_daemon_v2_operator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tdex_daemon_v2_operator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   68,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -52,6 +52,8 @@ type OperatorServiceClient interface {
 	UpdateMarketFixedFee(ctx context.Context, in *UpdateMarketFixedFeeRequest, opts ...grpc.CallOption) (*UpdateMarketFixedFeeResponse, error)
 	// Changes the Liquidity provider asset(s) precision for the given market.
 	UpdateMarketAssetsPrecision(ctx context.Context, in *UpdateMarketAssetsPrecisionRequest, opts ...grpc.CallOption) (*UpdateMarketAssetsPrecisionResponse, error)
+	// Changes the expiry time of the trades accepted for the given market.
+	UpdateMarketTradeExpiryTime(ctx context.Context, in *UpdateMarketTradeExpiryTimeRequest, opts ...grpc.CallOption) (*UpdateMarketTradeExpiryTimeResponse, error)
 	// Updates the price for the given market.
 	UpdateMarketPrice(ctx context.Context, in *UpdateMarketPriceRequest, opts ...grpc.CallOption) (*UpdateMarketPriceResponse, error)
 	// Updates the current market making strategy, either using an automated
@@ -90,6 +92,30 @@ type OperatorServiceClient interface {
 	ListDeposits(ctx context.Context, in *ListDepositsRequest, opts ...grpc.CallOption) (*ListDepositsResponse, error)
 	// Returns the list of all withdrawals made for the given account.
 	ListWithdrawals(ctx context.Context, in *ListWithdrawalsRequest, opts ...grpc.CallOption) (*ListWithdrawalsResponse, error)
+	// Streams all trades, deposits and withdrawals (fee account included) in the
+	// given time range, from the most recent, all read from the same snapshot
+	// of the daemon's stores. Useful for accounting purposes.
+	ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (OperatorService_ExportLedgerClient, error)
+	// Streams an encrypted snapshot of the daemon's stores (markets, trades,
+	// transactions, webhooks and price feeds) and of the trade archive, that
+	// can be restored by starting tdexd with TDEX_RESTORE set to the backup
+	// path. The snapshot is encrypted with the wallet password.
+	// Every store is consistent on its own, but the stores are snapshotted one
+	// after the other, so data written meanwhile may be missing from some.
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (OperatorService_BackupClient, error)
+	// Moves the trades that failed or expired longer than the retention period
+	// ago from the trade store to the archive. The same is done periodically if
+	// the daemon is configured with a retention period.
+	ArchiveTrades(ctx context.Context, in *ArchiveTradesRequest, opts ...grpc.CallOption) (*ArchiveTradesResponse, error)
+	// Returns the balances of the fee account and of the markets periodically
+	// recorded by the daemon in the given time range.
+	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
+	// Returns the disk usage, the number of keys and the time of the last
+	// garbage collection of every store of the daemon.
+	GetDBStats(ctx context.Context, in *GetDBStatsRequest, opts ...grpc.CallOption) (*GetDBStatsResponse, error)
+	// Reclaims the disk space left unused by the stores of the daemon,
+	// optionally compacting them.
+	RunDBGC(ctx context.Context, in *RunDBGCRequest, opts ...grpc.CallOption) (*RunDBGCResponse, error)
 }
 
 type operatorServiceClient struct {
@@ -244,6 +270,15 @@ func (c *operatorServiceClient) UpdateMarketAssetsPrecision(ctx context.Context,
 	return out, nil
 }
 
+func (c *operatorServiceClient) UpdateMarketTradeExpiryTime(ctx context.Context, in *UpdateMarketTradeExpiryTimeRequest, opts ...grpc.CallOption) (*UpdateMarketTradeExpiryTimeResponse, error) {
+	out := new(UpdateMarketTradeExpiryTimeResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.OperatorService/UpdateMarketTradeExpiryTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *operatorServiceClient) UpdateMarketPrice(ctx context.Context, in *UpdateMarketPriceRequest, opts ...grpc.CallOption) (*UpdateMarketPriceResponse, error) {
 	out := new(UpdateMarketPriceResponse)
 	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.OperatorService/UpdateMarketPrice", in, out, opts...)
@@ -443,6 +478,106 @@ func (c *operatorServiceClient) ListWithdrawals(ctx context.Context, in *ListWit
 	return out, nil
 }
 
+func (c *operatorServiceClient) ExportLedger(ctx context.Context, in *ExportLedgerRequest, opts ...grpc.CallOption) (OperatorService_ExportLedgerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OperatorService_ServiceDesc.Streams[2], "/tdex_daemon.v2.OperatorService/ExportLedger", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorServiceExportLedgerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OperatorService_ExportLedgerClient interface {
+	Recv() (*ExportLedgerResponse, error)
+	grpc.ClientStream
+}
+
+type operatorServiceExportLedgerClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorServiceExportLedgerClient) Recv() (*ExportLedgerResponse, error) {
+	m := new(ExportLedgerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operatorServiceClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (OperatorService_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OperatorService_ServiceDesc.Streams[3], "/tdex_daemon.v2.OperatorService/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &operatorServiceBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OperatorService_BackupClient interface {
+	Recv() (*BackupResponse, error)
+	grpc.ClientStream
+}
+
+type operatorServiceBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *operatorServiceBackupClient) Recv() (*BackupResponse, error) {
+	m := new(BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *operatorServiceClient) ArchiveTrades(ctx context.Context, in *ArchiveTradesRequest, opts ...grpc.CallOption) (*ArchiveTradesResponse, error) {
+	out := new(ArchiveTradesResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.OperatorService/ArchiveTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorServiceClient) GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error) {
+	out := new(GetBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.OperatorService/GetBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorServiceClient) GetDBStats(ctx context.Context, in *GetDBStatsRequest, opts ...grpc.CallOption) (*GetDBStatsResponse, error) {
+	out := new(GetDBStatsResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.OperatorService/GetDBStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operatorServiceClient) RunDBGC(ctx context.Context, in *RunDBGCRequest, opts ...grpc.CallOption) (*RunDBGCResponse, error) {
+	out := new(RunDBGCResponse)
+	err := c.cc.Invoke(ctx, "/tdex_daemon.v2.OperatorService/RunDBGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OperatorServiceServer is the server API for OperatorService service.
 // All implementations should embed UnimplementedOperatorServiceServer
 // for forward compatibility
@@ -481,6 +616,8 @@ type OperatorServiceServer interface {
 	UpdateMarketFixedFee(context.Context, *UpdateMarketFixedFeeRequest) (*UpdateMarketFixedFeeResponse, error)
 	// Changes the Liquidity provider asset(s) precision for the given market.
 	UpdateMarketAssetsPrecision(context.Context, *UpdateMarketAssetsPrecisionRequest) (*UpdateMarketAssetsPrecisionResponse, error)
+	// Changes the expiry time of the trades accepted for the given market.
+	UpdateMarketTradeExpiryTime(context.Context, *UpdateMarketTradeExpiryTimeRequest) (*UpdateMarketTradeExpiryTimeResponse, error)
 	// Updates the price for the given market.
 	UpdateMarketPrice(context.Context, *UpdateMarketPriceRequest) (*UpdateMarketPriceResponse, error)
 	// Updates the current market making strategy, either using an automated
@@ -519,6 +656,30 @@ type OperatorServiceServer interface {
 	ListDeposits(context.Context, *ListDepositsRequest) (*ListDepositsResponse, error)
 	// Returns the list of all withdrawals made for the given account.
 	ListWithdrawals(context.Context, *ListWithdrawalsRequest) (*ListWithdrawalsResponse, error)
+	// Streams all trades, deposits and withdrawals (fee account included) in the
+	// given time range, from the most recent, all read from the same snapshot
+	// of the daemon's stores. Useful for accounting purposes.
+	ExportLedger(*ExportLedgerRequest, OperatorService_ExportLedgerServer) error
+	// Streams an encrypted snapshot of the daemon's stores (markets, trades,
+	// transactions, webhooks and price feeds) and of the trade archive, that
+	// can be restored by starting tdexd with TDEX_RESTORE set to the backup
+	// path. The snapshot is encrypted with the wallet password.
+	// Every store is consistent on its own, but the stores are snapshotted one
+	// after the other, so data written meanwhile may be missing from some.
+	Backup(*BackupRequest, OperatorService_BackupServer) error
+	// Moves the trades that failed or expired longer than the retention period
+	// ago from the trade store to the archive. The same is done periodically if
+	// the daemon is configured with a retention period.
+	ArchiveTrades(context.Context, *ArchiveTradesRequest) (*ArchiveTradesResponse, error)
+	// Returns the balances of the fee account and of the markets periodically
+	// recorded by the daemon in the given time range.
+	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
+	// Returns the disk usage, the number of keys and the time of the last
+	// garbage collection of every store of the daemon.
+	GetDBStats(context.Context, *GetDBStatsRequest) (*GetDBStatsResponse, error)
+	// Reclaims the disk space left unused by the stores of the daemon,
+	// optionally compacting them.
+	RunDBGC(context.Context, *RunDBGCRequest) (*RunDBGCResponse, error)
 }
 
 // UnimplementedOperatorServiceServer should be embedded to have forward compatible implementations.
@@ -573,6 +734,9 @@ func (UnimplementedOperatorServiceServer) UpdateMarketFixedFee(context.Context,
 func (UnimplementedOperatorServiceServer) UpdateMarketAssetsPrecision(context.Context, *UpdateMarketAssetsPrecisionRequest) (*UpdateMarketAssetsPrecisionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketAssetsPrecision not implemented")
 }
+func (UnimplementedOperatorServiceServer) UpdateMarketTradeExpiryTime(context.Context, *UpdateMarketTradeExpiryTimeRequest) (*UpdateMarketTradeExpiryTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketTradeExpiryTime not implemented")
+}
 func (UnimplementedOperatorServiceServer) UpdateMarketPrice(context.Context, *UpdateMarketPriceRequest) (*UpdateMarketPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarketPrice not implemented")
 }
@@ -624,6 +788,24 @@ func (UnimplementedOperatorServiceServer) ListDeposits(context.Context, *ListDep
 func (UnimplementedOperatorServiceServer) ListWithdrawals(context.Context, *ListWithdrawalsRequest) (*ListWithdrawalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWithdrawals not implemented")
 }
+func (UnimplementedOperatorServiceServer) ExportLedger(*ExportLedgerRequest, OperatorService_ExportLedgerServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLedger not implemented")
+}
+func (UnimplementedOperatorServiceServer) Backup(*BackupRequest, OperatorService_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedOperatorServiceServer) ArchiveTrades(context.Context, *ArchiveTradesRequest) (*ArchiveTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTrades not implemented")
+}
+func (UnimplementedOperatorServiceServer) GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceHistory not implemented")
+}
+func (UnimplementedOperatorServiceServer) GetDBStats(context.Context, *GetDBStatsRequest) (*GetDBStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDBStats not implemented")
+}
+func (UnimplementedOperatorServiceServer) RunDBGC(context.Context, *RunDBGCRequest) (*RunDBGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunDBGC not implemented")
+}
 
 // UnsafeOperatorServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OperatorServiceServer will
@@ -924,6 +1106,24 @@ func _OperatorService_UpdateMarketAssetsPrecision_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperatorService_UpdateMarketTradeExpiryTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMarketTradeExpiryTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServiceServer).UpdateMarketTradeExpiryTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.OperatorService/UpdateMarketTradeExpiryTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServiceServer).UpdateMarketTradeExpiryTime(ctx, req.(*UpdateMarketTradeExpiryTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OperatorService_UpdateMarketPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateMarketPriceRequest)
 	if err := dec(in); err != nil {
@@ -1236,6 +1436,120 @@ func _OperatorService_ListWithdrawals_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OperatorService_ExportLedger_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLedgerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServiceServer).ExportLedger(m, &operatorServiceExportLedgerServer{stream})
+}
+
+type OperatorService_ExportLedgerServer interface {
+	Send(*ExportLedgerResponse) error
+	grpc.ServerStream
+}
+
+type operatorServiceExportLedgerServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorServiceExportLedgerServer) Send(m *ExportLedgerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OperatorService_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OperatorServiceServer).Backup(m, &operatorServiceBackupServer{stream})
+}
+
+type OperatorService_BackupServer interface {
+	Send(*BackupResponse) error
+	grpc.ServerStream
+}
+
+type operatorServiceBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *operatorServiceBackupServer) Send(m *BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OperatorService_ArchiveTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServiceServer).ArchiveTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.OperatorService/ArchiveTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServiceServer).ArchiveTrades(ctx, req.(*ArchiveTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperatorService_GetBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServiceServer).GetBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.OperatorService/GetBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServiceServer).GetBalanceHistory(ctx, req.(*GetBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperatorService_GetDBStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDBStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServiceServer).GetDBStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.OperatorService/GetDBStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServiceServer).GetDBStats(ctx, req.(*GetDBStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperatorService_RunDBGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDBGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperatorServiceServer).RunDBGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tdex_daemon.v2.OperatorService/RunDBGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperatorServiceServer).RunDBGC(ctx, req.(*RunDBGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OperatorService_ServiceDesc is the grpc.ServiceDesc for OperatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1307,6 +1621,10 @@ var OperatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMarketAssetsPrecision",
 			Handler:    _OperatorService_UpdateMarketAssetsPrecision_Handler,
 		},
+		{
+			MethodName: "UpdateMarketTradeExpiryTime",
+			Handler:    _OperatorService_UpdateMarketTradeExpiryTime_Handler,
+		},
 		{
 			MethodName: "UpdateMarketPrice",
 			Handler:    _OperatorService_UpdateMarketPrice_Handler,
@@ -1367,6 +1685,22 @@ var OperatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWithdrawals",
 			Handler:    _OperatorService_ListWithdrawals_Handler,
 		},
+		{
+			MethodName: "ArchiveTrades",
+			Handler:    _OperatorService_ArchiveTrades_Handler,
+		},
+		{
+			MethodName: "GetBalanceHistory",
+			Handler:    _OperatorService_GetBalanceHistory_Handler,
+		},
+		{
+			MethodName: "GetDBStats",
+			Handler:    _OperatorService_GetDBStats_Handler,
+		},
+		{
+			MethodName: "RunDBGC",
+			Handler:    _OperatorService_RunDBGC_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1379,6 +1713,16 @@ var OperatorService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _OperatorService_MarketFragmenterSplitFunds_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportLedger",
+			Handler:       _OperatorService_ExportLedger_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _OperatorService_Backup_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "tdex-daemon/v2/operator.proto",
 }
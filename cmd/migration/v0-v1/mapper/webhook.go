@@ -58,6 +58,15 @@ func (e webhookEvent) IsAccountWithdraw() bool {
 func (e webhookEvent) IsAccountDeposit() bool {
 	return false
 }
+func (e webhookEvent) IsTradeUpdated() bool {
+	return false
+}
+func (e webhookEvent) IsMarketUpdated() bool {
+	return false
+}
+func (e webhookEvent) IsPriceFeedDeviation() bool {
+	return false
+}
 func (e webhookEvent) IsAny() bool {
 	return int(e) == int(v0webhook.AllActions)
 }
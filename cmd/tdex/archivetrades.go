@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v2"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+)
+
+var archivetrades = cli.Command{
+	Name: "archivetrades",
+	Usage: "move the trades that failed or expired longer than the retention " +
+		"period ago from the trade store to the archive",
+	Flags: []cli.Flag{
+		&cli.Uint64Flag{
+			Name:  "retention-days",
+			Usage: "the retention period in days. If omitted, the one configured for the daemon is used",
+		},
+	},
+	Action: archiveTradesAction,
+}
+
+func archiveTradesAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ArchiveTrades(
+		context.Background(), &daemonv2.ArchiveTradesRequest{
+			RetentionDays: ctx.Uint64("retention-days"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/urfave/cli/v2"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+)
+
+var backup = cli.Command{
+	Name: "backup",
+	Usage: "make an encrypted backup of the daemon's stores while running, " +
+		"to be restored by starting tdexd with TDEX_RESTORE=<backup path>",
+	Action: backupAction,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "out",
+			Usage:    "the path of the backup file",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     "password",
+			Usage:    "the wallet unlocking password, used also to encrypt the backup",
+			Required: true,
+		},
+	},
+}
+
+func backupAction(ctx *cli.Context) error {
+	path := cleanAndExpandPath(ctx.String("out"))
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("file %s already exists", path)
+	}
+
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.Backup(
+		context.Background(), &daemonv2.BackupRequest{
+			Password: ctx.String("password"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := func() error {
+		for {
+			reply, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			if _, err := f.Write(reply.GetChunk()); err != nil {
+				return err
+			}
+		}
+	}(); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+
+	fmt.Printf("backup saved to %s\n", path)
+	return nil
+}
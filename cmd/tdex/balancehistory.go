@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v2"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+)
+
+var balancehistory = cli.Command{
+	Name: "balancehistory",
+	Usage: "get the balances of the fee account and of the markets " +
+		"periodically recorded by the daemon",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "account-name",
+			Usage: "the name of the wallet account. If omitted, the history of all accounts is returned",
+		},
+		&cli.Int64Flag{
+			Name:  "page-size",
+			Usage: "the size of the page. If omitted together with --page-token, the entire history is returned",
+			Value: 10,
+		},
+		&cli.StringFlag{
+			Name:  "page-token",
+			Usage: "the token of the page to be listed, as returned by the previous one. If omitted and --page-size is set, the first page is listed",
+		},
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "custom start date expressed in RFC3339 format",
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "custom end date expressed in RFC3339 format",
+		},
+		&cli.BoolFlag{
+			Name:  "last-hour",
+			Usage: "get the balances of the last hour",
+		},
+		&cli.BoolFlag{
+			Name:  "last-day",
+			Usage: "get the balances of the last 24 hours",
+		},
+		&cli.BoolFlag{
+			Name:  "last-month",
+			Usage: "get the balances of the last month",
+		},
+		&cli.BoolFlag{
+			Name:  "last-three-months",
+			Usage: "get the balances of the last 3 months",
+		},
+		&cli.BoolFlag{
+			Name:  "last-year",
+			Usage: "get the balances of the last year",
+		},
+		&cli.BoolFlag{
+			Name:  "year-to-date",
+			Usage: "get the balances from the beginning of the year until now",
+		},
+	},
+	Action: balanceHistoryAction,
+}
+
+func balanceHistoryAction(ctx *cli.Context) error {
+	timeRange, err := getTxTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetBalanceHistory(
+		context.Background(), &daemonv2.GetBalanceHistoryRequest{
+			AccountName: ctx.String("account-name"),
+			TimeRange:   timeRange,
+			Page:        getPage(ctx),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v2"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+)
+
+var (
+	db = cli.Command{
+		Name:  "db",
+		Usage: "inspect and maintain the stores of the daemon",
+		Subcommands: []*cli.Command{
+			dbStatsCmd, dbGCCmd,
+		},
+	}
+
+	dbStatsCmd = &cli.Command{
+		Name:   "stats",
+		Usage:  "get the disk usage, the number of keys and the time of the last garbage collection of every store",
+		Action: dbStatsAction,
+	}
+
+	dbGCCmd = &cli.Command{
+		Name:  "gc",
+		Usage: "reclaim the disk space left unused by the stores",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "compact",
+				Usage: "also compact the stores, slower but frees the most space",
+				Value: false,
+			},
+		},
+		Action: dbGCAction,
+	}
+)
+
+func dbStatsAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetDBStats(
+		context.Background(), &daemonv2.GetDBStatsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+func dbGCAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RunDBGC(
+		context.Background(), &daemonv2.RunDBGCRequest{
+			Compact: ctx.Bool("compact"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/urfave/cli/v2"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+)
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+var (
+	exportCSVHeader = []string{
+		"type", "account_name", "date", "timestamp", "txid", "trade_id",
+		"trade_type", "trade_status", "asset_in", "amount_in", "asset_out",
+		"amount_out", "fee_asset", "fee_amount", "base_price", "quote_price",
+	}
+
+	export = cli.Command{
+		Name: "export",
+		Usage: "export all trades, deposits and withdrawals for a specified " +
+			"time range in CSV or JSON lines format",
+		Action: exportAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "the output format, either csv or json",
+				Value: exportFormatCSV,
+			},
+			&cli.StringFlag{
+				Name:  "out",
+				Usage: "the path of the output file. If omitted, entries are printed to stdout",
+			},
+			&cli.BoolFlag{
+				Name:  "with-hex",
+				Usage: "include the raw transaction in hex format of every trade (json format only)",
+				Value: false,
+			},
+			&cli.StringFlag{
+				Name:  "start",
+				Usage: "custom start date expressed in RFC3339 format",
+			},
+			&cli.StringFlag{
+				Name:  "end",
+				Usage: "custom end date expressed in RFC3339 format",
+			},
+			&cli.BoolFlag{
+				Name:  "last-hour",
+				Usage: "export entries of the last hour",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "last-day",
+				Usage: "export entries of the last 24 hours",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "last-month",
+				Usage: "export entries of the last month",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "last-three-months",
+				Usage: "export entries of the last 3 months",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "last-year",
+				Usage: "export entries of the last year",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "year-to-date",
+				Usage: "export entries from the beginning of the year until now",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "all",
+				Usage: "export all entries",
+				Value: false,
+			},
+		},
+	}
+)
+
+func exportAction(ctx *cli.Context) error {
+	format := strings.ToLower(ctx.String("format"))
+	if format != exportFormatCSV && format != exportFormatJSON {
+		return fmt.Errorf("format must be either %s or %s", exportFormatCSV, exportFormatJSON)
+	}
+
+	timeRange, err := getTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	stream, err := client.ExportLedger(
+		context.Background(), &daemonv2.ExportLedgerRequest{
+			TimeRange: timeRange,
+			WithHex:   ctx.Bool("with-hex"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if path := ctx.String("out"); path != "" {
+		f, err := os.Create(cleanAndExpandPath(path))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	var writeEntry func(entry *daemonv2.LedgerEntry) error
+	if format == exportFormatJSON {
+		jsonMarshaler := &jsonpb.Marshaler{
+			EmitDefaults: true,
+			OrigName:     true,
+		}
+		writeEntry = func(entry *daemonv2.LedgerEntry) error {
+			jsonStr, err := jsonMarshaler.MarshalToString(entry)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(out, jsonStr)
+			return err
+		}
+	} else {
+		w := csv.NewWriter(out)
+		defer w.Flush()
+		if err := w.Write(exportCSVHeader); err != nil {
+			return err
+		}
+		writeEntry = func(entry *daemonv2.LedgerEntry) error {
+			return w.WriteAll(ledgerEntryToCSVRecords(entry))
+		}
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		if err := writeEntry(reply.GetEntry()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ledgerEntryToCSVRecords converts the given entry into a list of CSV records.
+// Amounts are expressed from the daemon perspective: a trade has both an
+// incoming (proposer's) and an outgoing (responder's) asset, while a deposit or
+// a withdrawal results in one incoming or outgoing record per asset.
+func ledgerEntryToCSVRecords(entry *daemonv2.LedgerEntry) [][]string {
+	timestamp := strconv.FormatInt(entry.GetTimestamp(), 10)
+
+	switch entry.GetType() {
+	case daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_TRADE:
+		trade := entry.GetTrade()
+		swap := trade.GetSwapInfo()
+		price := trade.GetPrice()
+		tradeType := strings.ToLower(
+			strings.TrimPrefix(trade.GetTradeType().String(), "TRADE_TYPE_"),
+		)
+		tradeStatus := strings.ToLower(
+			strings.TrimPrefix(trade.GetStatus().GetStatus().String(), "TRADE_STATUS_"),
+		)
+		if trade.GetStatus().GetFailed() {
+			tradeStatus = fmt.Sprintf("%s_failed", tradeStatus)
+		}
+		return [][]string{{
+			"trade", entry.GetAccountName(), entry.GetDate(), timestamp,
+			trade.GetTxid(), trade.GetTradeId(), tradeType, tradeStatus,
+			swap.GetAssetP(), strconv.FormatUint(swap.GetAmountP(), 10),
+			swap.GetAssetR(), strconv.FormatUint(swap.GetAmountR(), 10),
+			trade.GetFeeAsset(), strconv.FormatUint(trade.GetFeeAmount(), 10),
+			strconv.FormatFloat(price.GetBasePrice(), 'f', -1, 64),
+			strconv.FormatFloat(price.GetQuotePrice(), 'f', -1, 64),
+		}}
+	case daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT,
+		daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAWAL:
+		tx := entry.GetTransaction()
+		isDeposit :=
+			entry.GetType() == daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT
+		entryType := "withdrawal"
+		if isDeposit {
+			entryType = "deposit"
+		}
+
+		// Sort assets to make the output deterministic.
+		assets := make([]string, 0, len(tx.GetTotalAmountPerAsset()))
+		for asset := range tx.GetTotalAmountPerAsset() {
+			assets = append(assets, asset)
+		}
+		sort.Strings(assets)
+
+		records := make([][]string, 0, len(assets))
+		for _, asset := range assets {
+			amount := strconv.FormatUint(tx.GetTotalAmountPerAsset()[asset], 10)
+			assetIn, amountIn, assetOut, amountOut := asset, amount, "", ""
+			if !isDeposit {
+				assetIn, amountIn, assetOut, amountOut = "", "", asset, amount
+			}
+			records = append(records, []string{
+				entryType, entry.GetAccountName(), entry.GetDate(), timestamp,
+				tx.GetTxid(), "", "", "", assetIn, amountIn, assetOut, amountOut,
+				"", "", "", "",
+			})
+		}
+		return records
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
+)
+
+func TestLedgerEntryToCSVRecords(t *testing.T) {
+	date := "2023-01-01T00:00:00Z"
+	tests := []struct {
+		name     string
+		entry    *daemonv2.LedgerEntry
+		expected [][]string
+	}{
+		{
+			name: "trade",
+			entry: &daemonv2.LedgerEntry{
+				Type:        daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_TRADE,
+				AccountName: "mkt",
+				Timestamp:   1672531200,
+				Date:        date,
+				Trade: &daemonv2.TradeInfo{
+					TradeId: "tradeid",
+					Status: &daemonv2.TradeStatusInfo{
+						Status: daemonv2.TradeStatus_TRADE_STATUS_SETTLED,
+					},
+					SwapInfo: &daemonv2.SwapInfo{
+						AssetP: "quote", AmountP: 2000, AssetR: "base", AmountR: 1000,
+					},
+					Price:     &tdexv2.Price{BasePrice: 0.5, QuotePrice: 2},
+					TradeType: tdexv2.TradeType_TRADE_TYPE_BUY,
+					Txid:      "txid",
+					FeeAsset:  "quote",
+					FeeAmount: 5,
+				},
+			},
+			expected: [][]string{{
+				"trade", "mkt", date, "1672531200", "txid", "tradeid", "buy",
+				"settled", "quote", "2000", "base", "1000", "quote", "5", "0.5", "2",
+			}},
+		},
+		{
+			name: "failed trade",
+			entry: &daemonv2.LedgerEntry{
+				Type:        daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_TRADE,
+				AccountName: "mkt",
+				Timestamp:   1672531200,
+				Date:        date,
+				Trade: &daemonv2.TradeInfo{
+					TradeId: "tradeid",
+					Status: &daemonv2.TradeStatusInfo{
+						Status: daemonv2.TradeStatus_TRADE_STATUS_ACCEPT,
+						Failed: true,
+					},
+					TradeType: tdexv2.TradeType_TRADE_TYPE_SELL,
+				},
+			},
+			expected: [][]string{{
+				"trade", "mkt", date, "1672531200", "", "tradeid", "sell",
+				"accept_failed", "", "0", "", "0", "", "0", "0", "0",
+			}},
+		},
+		{
+			name: "deposit",
+			entry: &daemonv2.LedgerEntry{
+				Type:        daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT,
+				AccountName: "mkt",
+				Timestamp:   1672531200,
+				Date:        date,
+				Transaction: &daemonv2.Transaction{
+					Txid: "txid",
+					TotalAmountPerAsset: map[string]uint64{
+						"quote": 2000, "base": 1000,
+					},
+				},
+			},
+			expected: [][]string{
+				{
+					"deposit", "mkt", date, "1672531200", "txid", "", "", "",
+					"base", "1000", "", "", "", "", "", "",
+				},
+				{
+					"deposit", "mkt", date, "1672531200", "txid", "", "", "",
+					"quote", "2000", "", "", "", "", "", "",
+				},
+			},
+		},
+		{
+			name: "withdrawal",
+			entry: &daemonv2.LedgerEntry{
+				Type:        daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAWAL,
+				AccountName: "fee",
+				Timestamp:   1672531200,
+				Date:        date,
+				Transaction: &daemonv2.Transaction{
+					Txid:                "txid",
+					TotalAmountPerAsset: map[string]uint64{"lbtc": 3000},
+				},
+			},
+			expected: [][]string{{
+				"withdrawal", "fee", date, "1672531200", "txid", "", "", "",
+				"", "", "lbtc", "3000", "", "", "", "",
+			}},
+		},
+		{
+			name:     "unspecified",
+			entry:    &daemonv2.LedgerEntry{},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := ledgerEntryToCSVRecords(tt.entry)
+			require.Equal(t, tt.expected, records)
+			for _, record := range records {
+				require.Len(t, record, len(exportCSVHeader))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
 	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
@@ -16,23 +17,72 @@ var (
 		Subcommands: []*cli.Command{
 			addPriceFeed, startPriceFeed, stopPriceFeed, updatePriceFeed,
 			removePriceFeed, infoPriceFeed, listPriceFeeds, listSources,
+			pushPrice, listPriceTicks,
 		},
 	}
 	addPriceFeed = &cli.Command{
 		Name:   "add",
 		Usage:  "add a new price feed",
 		Action: addPriceFeedAction,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
-				Name:     "source",
-				Usage:    "price source to use, check 'sources' command more info",
-				Required: true,
+				Name:  "source",
+				Usage: "price source to use, check 'sources' command more info",
 			},
 			&cli.StringFlag{
-				Name:     "ticker",
-				Usage:    "ticker of the market for the selected price source",
-				Required: true,
+				Name:  "ticker",
+				Usage: "ticker of the market for the selected price source, like 'BTCUSDT' for 'binance' and 'btcusd' for 'bitstamp', for the 'rest' source it's the JSON config of the endpoint to poll, like '{\"url\": \"https://...\", \"quote_price_path\": \"$.data.price\", \"headers\": {}, \"timeout\": 5, \"interval\": 10}' whose headers are stored apart and never shown, for the 'static' source it's either a name for prices pushed with the 'push' command, or a JSON config like '{\"price\": \"20000\"}', '{\"file\": \"/path/to/prices.json\", \"key\": \"BTC/USDT\"}' or '{\"path\": [\"20000\", \"21000\"], \"interval\": 10, \"loop\": true}'",
 			},
+		}, priceFeedSettingsFlags()...),
+	}
+	compositePriceFeedFlags = []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "sources",
+			Usage: "list of <source>:<ticker> pairs of a composite price feed, in alternative to source and ticker",
+		},
+		&cli.StringFlag{
+			Name:  "aggregation",
+			Usage: "how to combine the prices of the sources of a composite price feed, either 'median' or 'vwap', the latter ignores sources with no volume like 'bitstamp', whose prices are those of its live trades",
+		},
+		&cli.UintFlag{
+			Name:  "max-deviation-bps",
+			Usage: "max distance in basis points of a source price from the median one, above which it's discarded",
+		},
+		&cli.UintFlag{
+			Name:  "quorum",
+			Usage: "min number of fresh and consistent sources to update the price, defaults to the majority",
+		},
+		&cli.UintFlag{
+			Name:  "max-age",
+			Usage: "number of seconds after which the price of a source is considered stale",
+		},
+	}
+	derivedPriceFeedFlags = []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "legs",
+			Usage: "list of <source>:<ticker> pairs whose prices are multiplied to compute the price of a derived price feed",
+		},
+		&cli.StringSliceFlag{
+			Name:  "inverse-legs",
+			Usage: "list of <source>:<ticker> pairs whose inverse prices are multiplied to compute the price of a derived price feed",
+		},
+		&cli.UintFlag{
+			Name:  "legs-max-age",
+			Usage: "number of seconds after which the price of a leg of a derived price feed is considered stale, defaults to 60",
+		},
+	}
+	priceTransformationFlags = []cli.Flag{
+		&cli.IntFlag{
+			Name:  "markup-bps",
+			Usage: "markup in basis points applied to the price before updating the market, a negative value is a markdown",
+		},
+		&cli.UintFlag{
+			Name:  "min-update-interval",
+			Usage: "min number of seconds between two market price updates, prices received in between are debounced",
+		},
+		&cli.BoolFlag{
+			Name:  "round-to-precision",
+			Usage: "round the price to the precision of the market assets",
 		},
 	}
 	startPriceFeed = &cli.Command{
@@ -61,9 +111,9 @@ var (
 	}
 	updatePriceFeed = &cli.Command{
 		Name:   "update",
-		Usage:  "updates a price feed source and/or ticker",
+		Usage:  "updates a price feed source and/or ticker, the sources and aggregation of a composite one, the legs of a derived one, or its transformation",
 		Action: updatePriceFeedAction,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:     "id",
 				Usage:    "id of the price feed to update",
@@ -77,6 +127,26 @@ var (
 				Name:  "ticker",
 				Usage: "ticker of the market to be updated",
 			},
+		}, priceFeedSettingsFlags()...),
+	}
+	pushPrice = &cli.Command{
+		Name:   "push",
+		Usage:  "pushes a price to a started price feed, if supported by its source like the 'static' one",
+		Action: pushPriceAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "id",
+				Usage:    "id of the price feed",
+				Required: true,
+			},
+			&cli.Float64Flag{
+				Name:  "base-price",
+				Usage: "the base price, defaults to the inverse of the quote price",
+			},
+			&cli.Float64Flag{
+				Name:  "quote-price",
+				Usage: "the quote price, defaults to the inverse of the base price",
+			},
 		},
 	}
 	removePriceFeed = &cli.Command{
@@ -93,7 +163,7 @@ var (
 	}
 	infoPriceFeed = &cli.Command{
 		Name:   "info",
-		Usage:  "get info about a price feed",
+		Usage:  "get info about a price feed, including the health of a started one",
 		Action: getPriceFeedInfoAction,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
@@ -107,6 +177,47 @@ var (
 		Usage:  "lists all price feeds",
 		Action: listPriceFeedsAction,
 	}
+	listPriceTicks = &cli.Command{
+		Name:   "ticks",
+		Usage:  "lists the prices received and applied by a price feed, most recent first",
+		Action: listPriceTicksAction,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "id",
+				Usage:    "id of the price feed",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "type",
+				Usage: "list only the ticks of the given type, either 'received' or 'applied'",
+			},
+			&cli.Int64Flag{
+				Name:  "page",
+				Usage: "the number of the page to be listed. If omitted, the entire list is returned",
+			},
+			&cli.Int64Flag{
+				Name:  "page-size",
+				Usage: "the size of the page",
+				Value: 10,
+			},
+			&cli.StringFlag{
+				Name:  "start",
+				Usage: "list only the ticks from the given date, expressed in RFC3339 format",
+			},
+			&cli.StringFlag{
+				Name:  "end",
+				Usage: "list only the ticks until the given date, expressed in RFC3339 format",
+			},
+			&cli.BoolFlag{
+				Name:  "last-hour",
+				Usage: "list only the ticks of the last hour",
+			},
+			&cli.BoolFlag{
+				Name:  "last-day",
+				Usage: "list only the ticks of the last 24 hours",
+			},
+		},
+	}
 	listSources = &cli.Command{
 		Name:   "sources",
 		Usage:  "lists supported price feed sources",
@@ -123,6 +234,26 @@ func addPriceFeedAction(ctx *cli.Context) error {
 
 	source := ctx.String("source")
 	ticker := ctx.String("ticker")
+	sources, err := parsePriceSources(ctx.StringSlice("sources"))
+	if err != nil {
+		return err
+	}
+	aggregation, err := parsePriceAggregation(ctx)
+	if err != nil {
+		return err
+	}
+	legs, err := parsePriceLegs(
+		ctx.StringSlice("legs"), ctx.StringSlice("inverse-legs"),
+	)
+	if err != nil {
+		return err
+	}
+	if sources == nil && legs == nil && (source == "" || ticker == "") {
+		return fmt.Errorf("either source and ticker, sources or legs are required")
+	}
+	if ctx.IsSet("legs-max-age") && legs == nil {
+		return fmt.Errorf("legs max age is supported only along with legs")
+	}
 
 	baseAsset, quoteAsset, err := getMarketFromState()
 	if err != nil {
@@ -134,8 +265,13 @@ func addPriceFeedAction(ctx *cli.Context) error {
 			BaseAsset:  baseAsset,
 			QuoteAsset: quoteAsset,
 		},
-		Source: source,
-		Ticker: ticker,
+		Source:         source,
+		Ticker:         ticker,
+		Sources:        sources,
+		Aggregation:    aggregation,
+		Legs:           legs,
+		Transformation: parsePriceTransformation(ctx),
+		LegsMaxAge:     uint32(ctx.Uint("legs-max-age")),
 	})
 	if err != nil {
 		return err
@@ -196,12 +332,31 @@ func updatePriceFeedAction(ctx *cli.Context) error {
 	id := ctx.String("id")
 	source := ctx.String("source")
 	ticker := ctx.String("ticker")
+	sources, err := parsePriceSources(ctx.StringSlice("sources"))
+	if err != nil {
+		return err
+	}
+	aggregation, err := parsePriceAggregation(ctx)
+	if err != nil {
+		return err
+	}
+	legs, err := parsePriceLegs(
+		ctx.StringSlice("legs"), ctx.StringSlice("inverse-legs"),
+	)
+	if err != nil {
+		return err
+	}
 
 	if _, err := client.UpdatePriceFeed(
 		ctx.Context, &daemonv2.UpdatePriceFeedRequest{
-			Id:     id,
-			Source: source,
-			Ticker: ticker,
+			Id:             id,
+			Source:         source,
+			Ticker:         ticker,
+			Sources:        sources,
+			Aggregation:    aggregation,
+			Legs:           legs,
+			Transformation: parsePriceTransformation(ctx),
+			LegsMaxAge:     uint32(ctx.Uint("legs-max-age")),
 		},
 	); err != nil {
 		return err
@@ -212,6 +367,34 @@ func updatePriceFeedAction(ctx *cli.Context) error {
 	return nil
 }
 
+func pushPriceAction(ctx *cli.Context) error {
+	client, cleanup, err := getFeederClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if !ctx.IsSet("base-price") && !ctx.IsSet("quote-price") {
+		return fmt.Errorf("either base or quote price is required")
+	}
+
+	if _, err := client.PushPrice(
+		ctx.Context, &daemonv2.PushPriceRequest{
+			Id: ctx.String("id"),
+			Price: &tdexv2.Price{
+				BasePrice:  ctx.Float64("base-price"),
+				QuotePrice: ctx.Float64("quote-price"),
+			},
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println("")
+	fmt.Println("price pushed")
+	return nil
+}
+
 func removePriceFeedAction(ctx *cli.Context) error {
 	client, cleanup, err := getFeederClient(ctx)
 	if err != nil {
@@ -270,6 +453,43 @@ func listPriceFeedsAction(ctx *cli.Context) error {
 	return nil
 }
 
+func listPriceTicksAction(ctx *cli.Context) error {
+	tickTypes := map[string]daemonv2.PriceTickType{
+		"":         daemonv2.PriceTickType_PRICE_TICK_TYPE_UNSPECIFIED,
+		"received": daemonv2.PriceTickType_PRICE_TICK_TYPE_RECEIVED,
+		"applied":  daemonv2.PriceTickType_PRICE_TICK_TYPE_APPLIED,
+	}
+	tickType, ok := tickTypes[ctx.String("type")]
+	if !ok {
+		return fmt.Errorf("unknown tick type, must be either received or applied")
+	}
+	timeRange, err := getTxTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup, err := getFeederClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	reply, err := client.ListPriceTicks(
+		ctx.Context, &daemonv2.ListPriceTicksRequest{
+			Id:        ctx.String("id"),
+			Type:      tickType,
+			TimeRange: timeRange,
+			Page:      getPage(ctx),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(reply)
+	return nil
+}
+
 func listSourcesAction(ctx *cli.Context) error {
 	client, cleanup, err := getFeederClient(ctx)
 	if err != nil {
@@ -286,3 +506,109 @@ func listSourcesAction(ctx *cli.Context) error {
 	printRespJSON(reply)
 	return nil
 }
+
+// parsePriceSources parses the given list of <source>:<ticker> pairs.
+func parsePriceSources(list []string) ([]*daemonv2.PriceSource, error) {
+	if len(list) <= 0 {
+		return nil, nil
+	}
+
+	sources := make([]*daemonv2.PriceSource, 0, len(list))
+	for _, s := range list {
+		split := strings.SplitN(s, ":", 2)
+		if len(split) != 2 || split[0] == "" || split[1] == "" {
+			return nil, fmt.Errorf(
+				"invalid price source %s, must be in the form <source>:<ticker>", s,
+			)
+		}
+		sources = append(sources, &daemonv2.PriceSource{
+			Source: split[0],
+			Ticker: split[1],
+		})
+	}
+	return sources, nil
+}
+
+// parsePriceLegs parses the given lists of <source>:<ticker> pairs into the
+// legs of a derived price feed.
+func parsePriceLegs(legs, inverseLegs []string) ([]*daemonv2.PriceLeg, error) {
+	if len(legs) <= 0 && len(inverseLegs) <= 0 {
+		return nil, nil
+	}
+
+	sources, err := parsePriceSources(legs)
+	if err != nil {
+		return nil, err
+	}
+	inverseSources, err := parsePriceSources(inverseLegs)
+	if err != nil {
+		return nil, err
+	}
+
+	priceLegs := make([]*daemonv2.PriceLeg, 0, len(legs)+len(inverseLegs))
+	for _, src := range sources {
+		priceLegs = append(priceLegs, &daemonv2.PriceLeg{
+			Source: src.GetSource(),
+			Ticker: src.GetTicker(),
+		})
+	}
+	for _, src := range inverseSources {
+		priceLegs = append(priceLegs, &daemonv2.PriceLeg{
+			Source:  src.GetSource(),
+			Ticker:  src.GetTicker(),
+			Inverse: true,
+		})
+	}
+	return priceLegs, nil
+}
+
+// priceFeedSettingsFlags returns the flags shared by the add and update
+// commands to configure a composite or derived price feed and its
+// transformation.
+func priceFeedSettingsFlags() []cli.Flag {
+	flags := make([]cli.Flag, 0)
+	flags = append(flags, compositePriceFeedFlags...)
+	flags = append(flags, derivedPriceFeedFlags...)
+	return append(flags, priceTransformationFlags...)
+}
+
+// parsePriceTransformation returns the transformation of a price feed if any
+// of the related flags is set. Unset settings are disabled.
+func parsePriceTransformation(ctx *cli.Context) *daemonv2.PriceTransformation {
+	if !ctx.IsSet("markup-bps") && !ctx.IsSet("min-update-interval") &&
+		!ctx.IsSet("round-to-precision") {
+		return nil
+	}
+
+	return &daemonv2.PriceTransformation{
+		MarkupBps:         int32(ctx.Int("markup-bps")),
+		MinUpdateInterval: uint32(ctx.Uint("min-update-interval")),
+		RoundToPrecision:  ctx.Bool("round-to-precision"),
+	}
+}
+
+// parsePriceAggregation returns the aggregation of a composite price feed if
+// any of the related flags is set.
+func parsePriceAggregation(ctx *cli.Context) (*daemonv2.PriceAggregation, error) {
+	if !ctx.IsSet("aggregation") && !ctx.IsSet("max-deviation-bps") &&
+		!ctx.IsSet("quorum") && !ctx.IsSet("max-age") {
+		return nil, nil
+	}
+
+	var method daemonv2.PriceAggregationMethod
+	switch ctx.String("aggregation") {
+	case "", "median":
+		method = daemonv2.PriceAggregationMethod_PRICE_AGGREGATION_METHOD_MEDIAN
+	case "vwap":
+		method = daemonv2.PriceAggregationMethod_PRICE_AGGREGATION_METHOD_VOLUME_WEIGHTED
+	default:
+		return nil, fmt.Errorf("unknown aggregation, must be either median or vwap")
+	}
+
+	return &daemonv2.PriceAggregation{
+		Method:          method,
+		MaxDeviationBps: uint32(ctx.Uint("max-deviation-bps")),
+		Quorum:          uint32(ctx.Uint("quorum")),
+		MaxAge:          uint32(ctx.Uint("max-age")),
+	}, nil
+}
@@ -10,7 +10,7 @@ import (
 var listdeposits = cli.Command{
 	Name:  "deposits",
 	Usage: "get a list of all deposits for a wallet account",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		&cli.StringFlag{
 			Name:     "account-name",
 			Usage:    "the name of the account for which listing deposits",
@@ -25,7 +25,11 @@ var listdeposits = cli.Command{
 			Usage: "the size of the page",
 			Value: 10,
 		},
-	},
+		&cli.StringFlag{
+			Name:  "page-token",
+			Usage: "the token of the page to be listed, as returned by the previous one. If --page is omitted and --page-size is set, the first page is listed",
+		},
+	}, txFilterFlags...),
 	Action: listDepositsAction,
 }
 
@@ -37,20 +41,19 @@ func listDepositsAction(ctx *cli.Context) error {
 	defer cleanup()
 
 	accountName := ctx.String("account-name")
-	pageNumber := ctx.Int64("page")
-	pageSize := ctx.Int64("page-size")
-	var page *daemonv2.Page
-	if pageNumber > 0 {
-		page = &daemonv2.Page{
-			Number: pageNumber,
-			Size:   pageSize,
-		}
+	page := getPage(ctx)
+	timeRange, err := getTxTimeRange(ctx)
+	if err != nil {
+		return err
 	}
 
 	resp, err := client.ListDeposits(
 		context.Background(), &daemonv2.ListDepositsRequest{
 			AccountName: accountName,
 			Page:        page,
+			Asset:       ctx.String("asset"),
+			Txid:        ctx.String("txid"),
+			TimeRange:   timeRange,
 		},
 	)
 	if err != nil {
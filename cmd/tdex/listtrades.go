@@ -13,15 +13,19 @@ var listtrades = cli.Command{
 	Name:  "trades",
 	Usage: "get a list of all trades for a market",
 	Flags: []cli.Flag{
-		&cli.Uint64Flag{
+		&cli.Int64Flag{
 			Name:  "page",
 			Usage: "the number of the page to be listed. If omitted, the entire list is returned",
 		},
-		&cli.Uint64Flag{
+		&cli.Int64Flag{
 			Name:  "page-size",
 			Usage: "the size of the page",
 			Value: 10,
 		},
+		&cli.StringFlag{
+			Name:  "page-token",
+			Usage: "the token of the page to be listed, as returned by the previous one. If --page is omitted and --page-size is set, the first page is listed",
+		},
 	},
 	Action: listTradesAction,
 }
@@ -33,15 +37,7 @@ func listTradesAction(ctx *cli.Context) error {
 	}
 	defer cleanup()
 
-	pageNumber := ctx.Int64("page")
-	pageSize := ctx.Int64("page-size")
-	var page *daemonv2.Page
-	if pageNumber > 0 {
-		page = &daemonv2.Page{
-			Number: pageNumber,
-			Size:   pageSize,
-		}
-	}
+	page := getPage(ctx)
 
 	baseAsset, quoteAsset, err := getMarketFromState()
 	if err != nil {
@@ -25,6 +25,10 @@ var listutxos = cli.Command{
 			Usage: "the size of the page",
 			Value: 10,
 		},
+		&cli.StringFlag{
+			Name:  "page-token",
+			Usage: "the token of the page to be listed, as returned by the previous one. If --page is omitted and --page-size is set, the first page is listed",
+		},
 	},
 	Action: listUtxosAction,
 }
@@ -37,15 +41,7 @@ func listUtxosAction(ctx *cli.Context) error {
 	defer cleanup()
 
 	accountName := ctx.String("account-name")
-	pageNumber := ctx.Int64("page")
-	pageSize := ctx.Int64("page-size")
-	var page *daemonv2.Page
-	if pageNumber > 0 {
-		page = &daemonv2.Page{
-			Number: pageNumber,
-			Size:   pageSize,
-		}
-	}
+	page := getPage(ctx)
 
 	resp, err := client.ListUtxos(
 		context.Background(), &daemonv2.ListUtxosRequest{
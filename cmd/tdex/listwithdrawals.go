@@ -10,22 +10,26 @@ import (
 var listwithdrawals = cli.Command{
 	Name:  "withdrawals",
 	Usage: "get a list of all withdrawals for a wallet account",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		&cli.StringFlag{
 			Name:     "account-name",
 			Usage:    "the name of the wallet account for which listing withdrawals",
 			Required: true,
 		},
-		&cli.Uint64Flag{
+		&cli.Int64Flag{
 			Name:  "page",
 			Usage: "the number of the page to be listed. If omitted, the entire list is returned",
 		},
-		&cli.Uint64Flag{
+		&cli.Int64Flag{
 			Name:  "page-size",
 			Usage: "the size of the page",
 			Value: 10,
 		},
-	},
+		&cli.StringFlag{
+			Name:  "page-token",
+			Usage: "the token of the page to be listed, as returned by the previous one. If --page is omitted and --page-size is set, the first page is listed",
+		},
+	}, txFilterFlags...),
 	Action: listWithdrawalsAction,
 }
 
@@ -37,20 +41,19 @@ func listWithdrawalsAction(ctx *cli.Context) error {
 	defer cleanup()
 
 	accountName := ctx.String("account-name")
-	pageNumber := ctx.Int64("page")
-	pageSize := ctx.Int64("page-size")
-	var page *daemonv2.Page
-	if pageNumber > 0 {
-		page = &daemonv2.Page{
-			Number: pageNumber,
-			Size:   pageSize,
-		}
+	page := getPage(ctx)
+	timeRange, err := getTxTimeRange(ctx)
+	if err != nil {
+		return err
 	}
 
 	resp, err := client.ListWithdrawals(
 		context.Background(), &daemonv2.ListWithdrawalsRequest{
 			AccountName: accountName,
 			Page:        page,
+			Asset:       ctx.String("asset"),
+			Txid:        ctx.String("txid"),
+			TimeRange:   timeRange,
 		},
 	)
 	if err != nil {
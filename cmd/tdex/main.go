@@ -86,6 +86,11 @@ func main() {
 		&listwebhooks,
 		&listdeposits,
 		&listwithdrawals,
+		&export,
+		&backup,
+		&archivetrades,
+		&balancehistory,
+		&db,
 		&contentType,
 		&feeder,
 	)
@@ -193,6 +198,28 @@ func formatVersion() string {
 Modified from https://github.com/lightninglabs/pool/blob/master/cmd/pool/main.go
 Original Copyright 2017 Oliver Gugger. All Rights Reserved.
 */
+// getPage returns the page to be listed from the --page, --page-size and
+// --page-token flags. Pages are selected by token unless --page is set. If
+// none of them is set, nil is returned to list the entire list.
+func getPage(ctx *cli.Context) *daemonv2.Page {
+	pageNumber := ctx.Int64("page")
+	pageSize := ctx.Int64("page-size")
+	pageToken := ctx.String("page-token")
+	if pageNumber > 0 {
+		return &daemonv2.Page{
+			Number: pageNumber,
+			Size:   pageSize,
+		}
+	}
+	if pageToken != "" || ctx.IsSet("page-size") {
+		return &daemonv2.Page{
+			Size:  pageSize,
+			Token: pageToken,
+		}
+	}
+	return nil
+}
+
 func printRespJSON(resp interface{}) {
 	jsonMarshaler := &jsonpb.Marshaler{
 		EmitDefaults: true,
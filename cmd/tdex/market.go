@@ -20,7 +20,7 @@ var (
 			marketOpenCmd, marketCloseCmd, marketDropCmd,
 			marketUpdateFixedFeeCmd, marketUpdatePercentageFeeCmd,
 			marketUpdateStrategyCmd, marketUpdatePriceCmd, marketReportCmd,
-			marketUpdateAssetsPrecisionCmd,
+			marketUpdateAssetsPrecisionCmd, marketUpdateTradeExpiryTimeCmd,
 		},
 	}
 
@@ -206,6 +206,18 @@ var (
 		},
 		Action: marketUpdateAssetsPrecision,
 	}
+	marketUpdateTradeExpiryTimeCmd = &cli.Command{
+		Name:  "tradeexpiry",
+		Usage: "updates the expiry time of the trades accepted for a market",
+		Flags: []cli.Flag{
+			&cli.Uint64Flag{
+				Name:     "seconds",
+				Usage:    "the trade expiry time in seconds, 0 to use the daemon's default",
+				Required: true,
+			},
+		},
+		Action: marketUpdateTradeExpiryTimeAction,
+	}
 	marketUpdatePriceCmd = &cli.Command{
 		Name:  "price",
 		Usage: "updates the price of a market",
@@ -705,12 +717,36 @@ func marketReportAction(ctx *cli.Context) error {
 		return err
 	}
 
+	timeRange, err := getTimeRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	reply, err := client.GetMarketReport(
+		context.Background(),
+		&daemonv2.GetMarketReportRequest{
+			Market: &tdexv2.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+			TimeRange: timeRange,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(reply)
+	return nil
+}
+
+func getTimeRange(ctx *cli.Context) (*daemonv2.TimeRange, error) {
 	var customPeriod *daemonv2.CustomPeriod
 	var predefinedPeriod daemonv2.PredefinedPeriod
 	start := ctx.String("start")
 	end := ctx.String("end")
 	if ctx.IsSet("start") != ctx.IsSet("end") {
-		return fmt.Errorf("both start and end dates must defined for a custom period")
+		return nil, fmt.Errorf("both start and end dates must defined for a custom period")
 	}
 	if start != "" && end != "" {
 		customPeriod = &daemonv2.CustomPeriod{
@@ -735,34 +771,19 @@ func marketReportAction(ctx *cli.Context) error {
 			}
 		}
 		if count == 0 {
-			return fmt.Errorf(
+			return nil, fmt.Errorf(
 				"missing time range, specifiy either a predefined or a custom one",
 			)
 		}
 		if count > 1 {
-			return fmt.Errorf("only one predefined period must be specified")
+			return nil, fmt.Errorf("only one predefined period must be specified")
 		}
 	}
 
-	reply, err := client.GetMarketReport(
-		context.Background(),
-		&daemonv2.GetMarketReportRequest{
-			Market: &tdexv2.Market{
-				BaseAsset:  baseAsset,
-				QuoteAsset: quoteAsset,
-			},
-			TimeRange: &daemonv2.TimeRange{
-				PredefinedPeriod: predefinedPeriod,
-				CustomPeriod:     customPeriod,
-			},
-		},
-	)
-	if err != nil {
-		return err
-	}
-
-	printRespJSON(reply)
-	return nil
+	return &daemonv2.TimeRange{
+		PredefinedPeriod: predefinedPeriod,
+		CustomPeriod:     customPeriod,
+	}, nil
 }
 
 func marketUpdateAssetsPrecision(ctx *cli.Context) error {
@@ -797,3 +818,32 @@ func marketUpdateAssetsPrecision(ctx *cli.Context) error {
 	fmt.Println("precisions have been updated")
 	return nil
 }
+
+func marketUpdateTradeExpiryTimeAction(ctx *cli.Context) error {
+	client, cleanup, err := getOperatorClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	baseAsset, quoteAsset, err := getMarketFromState()
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.UpdateMarketTradeExpiryTime(
+		context.Background(), &daemonv2.UpdateMarketTradeExpiryTimeRequest{
+			Market: &tdexv2.Market{
+				BaseAsset:  baseAsset,
+				QuoteAsset: quoteAsset,
+			},
+			TradeExpiryTime: ctx.Uint64("seconds"),
+		},
+	); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("trade expiry time has been updated")
+	return nil
+}
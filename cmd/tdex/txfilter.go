@@ -0,0 +1,65 @@
+package main
+
+import (
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+	"github.com/urfave/cli/v2"
+)
+
+// txFilterFlags are the flags shared by the deposits and withdrawals commands
+// to filter the listed transactions.
+var txFilterFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "list only the transactions moving the given asset",
+	},
+	&cli.StringFlag{
+		Name:  "txid",
+		Usage: "list only the transaction with the given id",
+	},
+	&cli.StringFlag{
+		Name:  "start",
+		Usage: "list only the transactions made from the given date, expressed in RFC3339 format",
+	},
+	&cli.StringFlag{
+		Name:  "end",
+		Usage: "list only the transactions made until the given date, expressed in RFC3339 format",
+	},
+	&cli.BoolFlag{
+		Name:  "last-hour",
+		Usage: "list only the transactions of the last hour",
+	},
+	&cli.BoolFlag{
+		Name:  "last-day",
+		Usage: "list only the transactions of the last 24 hours",
+	},
+	&cli.BoolFlag{
+		Name:  "last-month",
+		Usage: "list only the transactions of the last month",
+	},
+	&cli.BoolFlag{
+		Name:  "last-three-months",
+		Usage: "list only the transactions of the last 3 months",
+	},
+	&cli.BoolFlag{
+		Name:  "last-year",
+		Usage: "list only the transactions of the last year",
+	},
+	&cli.BoolFlag{
+		Name:  "year-to-date",
+		Usage: "list only the transactions from the beginning of the year until now",
+	},
+}
+
+// getTxTimeRange returns the time range for filtering the listed
+// transactions, or nil if none of the time range flags is set.
+func getTxTimeRange(ctx *cli.Context) (*daemonv2.TimeRange, error) {
+	for _, flag := range []string{
+		"start", "end", "last-hour", "last-day", "last-month",
+		"last-three-months", "last-year", "year-to-date",
+	} {
+		if ctx.IsSet(flag) {
+			return getTimeRange(ctx)
+		}
+	}
+	return nil, nil
+}
@@ -41,6 +41,21 @@ var (
 				Usage: "triggers the webhook endpoint whenever a deposit to a wallet account is made",
 				Value: false,
 			},
+			&cli.BoolFlag{
+				Name:  "trade-updated-event",
+				Usage: "triggers the webhook endpoint whenever a trade is created, updated or deleted",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "market-updated-event",
+				Usage: "triggers the webhook endpoint whenever a market is created, updated or deleted",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "price-feed-deviation-event",
+				Usage: "triggers the webhook endpoint whenever a price feed update is rejected for deviating too much from the last price",
+				Value: false,
+			},
 			&cli.BoolFlag{
 				Name:  "any-event",
 				Usage: "triggers the webhook endpoint whenever any event occurs",
@@ -85,6 +100,21 @@ var (
 				Usage: "triggers the webhook endpoint whenever a deposit to a wallet account is made",
 				Value: false,
 			},
+			&cli.BoolFlag{
+				Name:  "trade-updated-event",
+				Usage: "triggers the webhook endpoint whenever a trade is created, updated or deleted",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "market-updated-event",
+				Usage: "triggers the webhook endpoint whenever a market is created, updated or deleted",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "price-feed-deviation-event",
+				Usage: "triggers the webhook endpoint whenever a price feed update is rejected for deviating too much from the last price",
+				Value: false,
+			},
 			&cli.BoolFlag{
 				Name:  "any-event",
 				Usage: "triggers the webhook endpoint whenever any event occurs",
@@ -196,6 +226,9 @@ func parseEvent(ctx *cli.Context) (daemonv2.WebhookEvent, error) {
 		ctx.Bool("account-low-balance-event"),
 		ctx.Bool("account-withdraw-event"),
 		ctx.Bool("account-deposit-event"),
+		ctx.Bool("trade-updated-event"),
+		ctx.Bool("market-updated-event"),
+		ctx.Bool("price-feed-deviation-event"),
 		ctx.Bool("any-event"),
 	}
 	trues := 0
@@ -216,6 +249,12 @@ func parseEvent(ctx *cli.Context) (daemonv2.WebhookEvent, error) {
 		event = daemonv2.WebhookEvent_WEBHOOK_EVENT_ACCOUNT_WITHDRAW
 	case ctx.Bool("account-deposit-event"):
 		event = daemonv2.WebhookEvent_WEBHOOK_EVENT_ACCOUNT_DEPOSIT
+	case ctx.Bool("trade-updated-event"):
+		event = daemonv2.WebhookEvent_WEBHOOK_EVENT_TRADE_UPDATED
+	case ctx.Bool("market-updated-event"):
+		event = daemonv2.WebhookEvent_WEBHOOK_EVENT_MARKET_UPDATED
+	case ctx.Bool("price-feed-deviation-event"):
+		event = daemonv2.WebhookEvent_WEBHOOK_EVENT_PRICE_FEED_DEVIATION
 	case ctx.Bool("any-event"):
 		event = daemonv2.WebhookEvent_WEBHOOK_EVENT_ANY
 	}
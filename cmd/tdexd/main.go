@@ -21,8 +21,11 @@ import (
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	oceanwallet "github.com/tdex-network/tdex-daemon/internal/infrastructure/ocean-wallet"
+	offlinewallet "github.com/tdex-network/tdex-daemon/internal/infrastructure/offline-wallet"
 	pubsub "github.com/tdex-network/tdex-daemon/internal/infrastructure/pubsub"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/migration"
 	swap_parser "github.com/tdex-network/tdex-daemon/internal/infrastructure/swap-parser"
+	tradearchive "github.com/tdex-network/tdex-daemon/internal/infrastructure/trade-archive"
 	"github.com/tdex-network/tdex-daemon/internal/interfaces"
 	grpcinterface "github.com/tdex-network/tdex-daemon/internal/interfaces/grpc"
 	boltsecurestore "github.com/tdex-network/tdex-daemon/pkg/securestore/bolt"
@@ -37,11 +40,25 @@ var (
 	noMacaroons, noOperatorTls, profilerEnabled            bool
 	datadir, dbDir, profilerDir, tradeTLSKey, tradeTLSCert string
 	walletUnlockPasswordFile, dbType, oceanWalletAddr      string
-	connectAddr, connectProto                              string
+	connectAddr, connectProto, dbConfig                    string
 	operatorTLSExtraIPs, operatorTLSExtraDomains           []string
 	// App services config
-	feeBalanceThreshold                   uint64
+	feeBalanceThreshold, tradeExpiryTime  uint64
 	pricesSlippagePercentage, satsPerByte decimal.Decimal
+	tradeRateLimitInterval                time.Duration
+	previewTradeRateLimit                 int
+	proposeTradeRateLimit                 int
+	completeTradeRateLimit                int
+	maxPendingTradesPerMarket             int
+	tradeRetention, tradeArchiveInterval  time.Duration
+	priceTickRetention                    time.Duration
+	balanceSnapshotInterval               time.Duration
+	priceFeedMaxDeviation                 decimal.Decimal
+	priceFeedDeviationCloseMarket         bool
+	readOnly                              bool
+	// Maintenance config
+	restorePath   string
+	migrateDryRun bool
 
 	version = "dev"
 	commit  = "none"
@@ -54,44 +71,63 @@ func main() {
 	}
 
 	log.SetLevel(log.Level(logLevel))
-	domain.SwapParserManager = swap_parser.NewService()
 
-	// Profiler is enabled at url http://localhost:8024/debug/pprof/
-	if profilerEnabled {
-		runtime.SetBlockProfileRate(1)
-		//nolint
-		go http.ListenAndServe(":8024", nil)
+	if restorePath != "" {
+		if err := restoreBackup(restorePath); err != nil {
+			log.WithError(err).Fatal("failed to restore backup")
+		}
+		log.Infof(
+			"backup restored, restart the daemon without TDEX_%s",
+			config.RestoreKey,
+		)
+		return
 	}
 
-	// Init services to be used by those of the application layer.
-	wallet, err := oceanwallet.NewService(oceanWalletAddr)
-	if err != nil {
-		log.WithError(err).Fatal("failed to connect to ocean wallet")
-	}
+	domain.SwapParserManager = swap_parser.NewService()
 
-	pubsub, err := newPubSubService(dbDir)
+	// Migrations must be applied before opening any store.
+	migrator, err := migration.NewMigrator(
+		datadir, dbDir, migration.Migrations,
+	)
 	if err != nil {
-		log.WithError(err).Fatal("failed to initialize pubsub service")
+		log.WithError(err).Fatal("failed to init datadir migrator")
 	}
-
-	priceFeederSvc, err := newPriceFeederService()
-	if err != nil {
-		log.WithError(err).Fatal("failed to initialize price feeder service")
+	if readOnly {
+		// A replica never writes the datadir, it must be already migrated.
+		pending, err := migrator.Pending()
+		if err != nil {
+			log.WithError(err).Fatal("failed to check datadir migrations")
+		}
+		if len(pending) > 0 {
+			log.Fatalf(
+				"datadir has %d pending migrations, start the daemon in normal "+
+					"mode to apply them before serving it in read-only mode",
+				len(pending),
+			)
+		}
+	} else if err := migrator.Run(migrateDryRun); err != nil {
+		log.WithError(err).Fatal("failed to migrate datadir")
+	}
+	if migrateDryRun {
+		return
 	}
 
-	appConfig := &application.Config{
-		OceanWallet:         wallet,
-		SecurePubSub:        pubsub,
-		PriceFeederSvc:      priceFeederSvc,
-		FeeBalanceThreshold: feeBalanceThreshold,
-		TradePriceSlippage:  pricesSlippagePercentage,
-		TxSatsPerByte:       satsPerByte,
-		DBType:              dbType,
-		DBConfig:            dbDir,
+	// Profiler is enabled at url http://localhost:8024/debug/pprof/
+	if profilerEnabled {
+		runtime.SetBlockProfileRate(1)
+		//nolint
+		go http.ListenAndServe(":8024", nil)
 	}
 
-	runOnOnePort := operatorSvcPort == tradeSvcPort
-	svc, err := NewGrpcService(runOnOnePort, appConfig)
+	var svc interfaces.Service
+	if readOnly {
+		appConfig := newReadOnlyAppConfig(migrator)
+		svc, err = NewReadOnlyGrpcService(appConfig)
+	} else {
+		appConfig := newAppConfig(migrator)
+		runOnOnePort := operatorSvcPort == tradeSvcPort
+		svc, err = NewGrpcService(runOnOnePort, appConfig)
+	}
 	if err != nil {
 		log.WithError(err).Fatal("failed to initialize grpc service")
 	}
@@ -120,6 +156,73 @@ func main() {
 	log.Exit(0)
 }
 
+// newAppConfig inits the services used by those of the application layer and
+// returns the config of the latter.
+func newAppConfig(migrator *migration.Migrator) *application.Config {
+	wallet, err := oceanwallet.NewService(oceanWalletAddr)
+	if err != nil {
+		log.WithError(err).Fatal("failed to connect to ocean wallet")
+	}
+
+	pubsub, err := newPubSubService(dbDir)
+	if err != nil {
+		log.WithError(err).Fatal("failed to initialize pubsub service")
+	}
+
+	priceFeederSvc, err := newPriceFeederService()
+	if err != nil {
+		log.WithError(err).Fatal("failed to initialize price feeder service")
+	}
+
+	tradeArchive, err := tradearchive.NewFileArchive(
+		filepath.Join(datadir, config.ArchiveLocation),
+	)
+	if err != nil {
+		log.WithError(err).Fatal("failed to initialize trade archive")
+	}
+
+	return &application.Config{
+		OceanWallet:                   wallet,
+		SecurePubSub:                  pubsub,
+		PriceFeederSvc:                priceFeederSvc,
+		Migrator:                      migrator,
+		FeeBalanceThreshold:           feeBalanceThreshold,
+		TradePriceSlippage:            pricesSlippagePercentage,
+		TradeExpiryTime:               tradeExpiryTime,
+		TradeRateLimitInterval:        tradeRateLimitInterval,
+		PreviewTradeRateLimit:         previewTradeRateLimit,
+		ProposeTradeRateLimit:         proposeTradeRateLimit,
+		CompleteTradeRateLimit:        completeTradeRateLimit,
+		MaxPendingTradesPerMarket:     maxPendingTradesPerMarket,
+		TradeArchive:                  tradeArchive,
+		TradeRetention:                tradeRetention,
+		TradeArchiveInterval:          tradeArchiveInterval,
+		BalanceSnapshotInterval:       balanceSnapshotInterval,
+		PriceFeedMaxDeviation:         priceFeedMaxDeviation,
+		PriceFeedDeviationCloseMarket: priceFeedDeviationCloseMarket,
+		TxSatsPerByte:                 satsPerByte,
+		DBType:                        dbType,
+		DBConfig:                      dbConfig,
+	}
+}
+
+// newReadOnlyAppConfig returns the config of the application layer for a
+// read-only replica, that doesn't connect to the wallet and doesn't open
+// any store other than the repositories.
+func newReadOnlyAppConfig(migrator *migration.Migrator) *application.Config {
+	return &application.Config{
+		OceanWallet:         offlinewallet.NewService(),
+		Migrator:            migrator,
+		FeeBalanceThreshold: feeBalanceThreshold,
+		TradePriceSlippage:  pricesSlippagePercentage,
+		TradeExpiryTime:     tradeExpiryTime,
+		TxSatsPerByte:       satsPerByte,
+		DBType:              dbType,
+		DBConfig:            dbConfig,
+		ReadOnly:            true,
+	}
+}
+
 func loadConfig() error {
 	if err := config.InitConfig(); err != nil {
 		return err
@@ -140,13 +243,46 @@ func loadConfig() error {
 	connectAddr = config.GetString(config.ConnectAddrKey)
 	connectProto = config.GetString(config.ConnectProtoKey)
 	dbType = config.GetString(config.DBTypeKey)
+	dbConfig = dbDir
+	if dbType == application.DBPostgres {
+		dbConfig = config.GetString(config.DBDSNKey)
+	}
 	// App services config
 	pricesSlippagePercentage = decimal.NewFromFloat(config.GetFloat(config.PriceSlippageKey))
+	tradeExpiryTime = uint64(config.GetInt(config.TradeExpiryTimeKey))
+	tradeRateLimitInterval = time.Duration(
+		config.GetInt(config.TradeRateLimitIntervalKey),
+	) * time.Second
+	previewTradeRateLimit = config.GetInt(config.PreviewTradeRateLimitKey)
+	proposeTradeRateLimit = config.GetInt(config.ProposeTradeRateLimitKey)
+	completeTradeRateLimit = config.GetInt(config.CompleteTradeRateLimitKey)
+	maxPendingTradesPerMarket = config.GetInt(config.MaxPendingTradesPerMarketKey)
+	tradeRetention = time.Duration(
+		config.GetInt(config.TradeRetentionDaysKey),
+	) * 24 * time.Hour
+	tradeArchiveInterval = time.Duration(
+		config.GetInt(config.TradeArchiveIntervalKey),
+	) * time.Second
+	priceTickRetention = time.Duration(
+		config.GetInt(config.PriceTickRetentionDaysKey),
+	) * 24 * time.Hour
+	balanceSnapshotInterval = time.Duration(
+		config.GetInt(config.BalanceSnapshotIntervalKey),
+	) * time.Second
+	priceFeedMaxDeviation = decimal.NewFromFloat(
+		config.GetFloat(config.PriceFeedMaxDeviationKey),
+	)
+	priceFeedDeviationCloseMarket = config.GetBool(
+		config.PriceFeedDeviationCloseMarketKey,
+	)
 	satsPerByte = decimal.NewFromFloat(config.GetFloat(config.TxSatsPerByteKey))
 	feeBalanceThreshold = uint64(config.GetInt(config.FeeAccountBalanceThresholdKey))
 	tradeSvcPort = config.GetInt(config.TradeListeningPortKey)
 	operatorSvcPort = config.GetInt(config.OperatorListeningPortKey)
 	oceanWalletAddr = config.GetString(config.OceanWalletAddrKey)
+	readOnly = config.GetBool(config.ReadOnlyKey)
+	restorePath = config.GetString(config.RestoreKey)
+	migrateDryRun = config.GetBool(config.MigrateDryRunKey)
 
 	return nil
 }
@@ -164,7 +300,7 @@ func (bd buildData) GetDate() string {
 }
 
 func newPubSubService(datadir string) (ports.SecurePubSub, error) {
-	secureStore, err := boltsecurestore.NewSecureStorage(datadir, "pubsub.db")
+	secureStore, err := boltsecurestore.NewSecureStorage(datadir, pubsub.DBFile)
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +314,7 @@ func newPriceFeederService() (ports.PriceFeeder, error) {
 		return nil, err
 	}
 
-	return pricefeeder.NewService(store), nil
+	return pricefeeder.NewService(store, priceTickRetention), nil
 }
 
 func NewGrpcService(
@@ -230,3 +366,23 @@ func NewGrpcService(
 
 	return grpcinterface.NewService(opts)
 }
+
+func NewReadOnlyGrpcService(
+	appConfig *application.Config,
+) (interfaces.Service, error) {
+	opts := grpcinterface.ReadOnlyServiceOpts{
+		NoMacaroons:           noMacaroons,
+		Datadir:               datadir,
+		DBLocation:            config.DbLocation,
+		TLSLocation:           config.TLSLocation,
+		MacaroonsLocation:     config.MacaroonsLocation,
+		OperatorExtraIPs:      operatorTLSExtraIPs,
+		OperatorExtraDomains:  operatorTLSExtraDomains,
+		MacaroonsPasswordFile: walletUnlockPasswordFile,
+		OperatorPort:          operatorSvcPort,
+		NoOperatorTls:         noOperatorTls,
+		AppConfig:             appConfig,
+	}
+
+	return grpcinterface.NewReadOnlyService(opts)
+}
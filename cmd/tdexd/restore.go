@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/badger/v3/options"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/config"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/backup"
+)
+
+// restoreBackup rebuilds the db datadir from the backup at the given path,
+// made with the Backup RPC. The password is read from the wallet unlock
+// password file, if any, or from stdin otherwise.
+// The entries are first restored into a staging directory and then moved
+// into the db datadir, or into the archive dir for the trade archive, that
+// must not contain any of them.
+func restoreBackup(backupPath string) error {
+	password, err := readRestorePassword()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(backupPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := backup.NewReader(bufio.NewReader(f), []byte(password))
+	if err != nil {
+		return err
+	}
+
+	archiveDir := filepath.Join(datadir, config.ArchiveLocation)
+	for _, dir := range []string{dbDir, archiveDir} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return err
+		}
+	}
+	stagingDir, err := os.MkdirTemp(datadir, "restore-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	// Staged entries mapped to their destination path.
+	entries := make(map[string]string)
+	for {
+		name, kind, err := r.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		if name == "" || filepath.IsAbs(name) || strings.Contains(name, "..") {
+			return fmt.Errorf("invalid backup entry name %s", name)
+		}
+		dir, path := dbDir, filepath.Join(stagingDir, name)
+		if kind == ports.BackupEntryArchive {
+			dir, path = archiveDir, filepath.Join(stagingDir, kind, name)
+		}
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return fmt.Errorf(
+				"%s already exists in %s, restore requires an empty datadir",
+				name, dir,
+			)
+		}
+		if _, ok := entries[path]; ok {
+			return fmt.Errorf("duplicated backup entry %s", name)
+		}
+
+		switch kind {
+		case ports.BackupEntryBadger:
+			err = restoreBadgerEntry(path, r)
+		case ports.BackupEntryFile:
+			err = restoreFileEntry(path, r)
+		case ports.BackupEntryArchive:
+			err = restoreArchiveEntry(path, r)
+		default:
+			err = fmt.Errorf("unknown kind %s", kind)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to restore %s: %w", name, err)
+		}
+
+		log.Infof("restored %s", name)
+		entries[path] = filepath.Join(dir, name)
+	}
+
+	for staged, dest := range entries {
+		if err := os.Rename(staged, dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreBadgerEntry(dir string, r io.Reader) error {
+	opts := badger.DefaultOptions(dir)
+	opts.Logger = nil
+	opts.Compression = options.ZSTD
+
+	db, err := badger.Open(opts)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Load(r, 256)
+}
+
+func restoreFileEntry(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func restoreArchiveEntry(path string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return restoreFileEntry(path, r)
+}
+
+func readRestorePassword() (string, error) {
+	if walletUnlockPasswordFile != "" {
+		buf, err := os.ReadFile(walletUnlockPasswordFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(buf)), nil
+	}
+
+	fmt.Print("backup password: ")
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimRight(password, "\r\n"), nil
+}
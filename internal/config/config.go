@@ -23,7 +23,8 @@ const (
 	LogLevelKey = "LOG_LEVEL"
 	// FeeAccountBalanceThresholdKey is the treshold of LBTC balance (in satoshis) for the fee account, after which we alert the operator that it cannot subsidize anymore swaps
 	FeeAccountBalanceThresholdKey = "FEE_ACCOUNT_BALANCE_THRESHOLD"
-	// TradeExpiryTimeKey is the duration in seconds of lock on unspents we reserve for accepted trades, before eventually double spending it
+	// TradeExpiryTimeKey is the duration in seconds of lock on unspents we reserve for accepted trades, before eventually double spending it.
+	// It applies to the markets that don't define their own and must be in range [10, 3600], otherwise the default 120 is used
 	TradeExpiryTimeKey = "TRADE_EXPIRY_TIME"
 	// TxSatsPerByteKey is the sats per byte ratio used to pay for txs' newtwork fees
 	TxSatsPerByteKey = "TX_SATS_PER_BYTE"
@@ -63,11 +64,66 @@ const (
 	OceanWalletAddrKey = "WALLET_ADDR"
 	// DBTypeKey is used to switch database type between those supported
 	DBTypeKey = "DB_TYPE"
+	// DBDSNKey is the connection string of the database, required if DB_TYPE
+	// is postgres
+	DBDSNKey = "DB_DSN"
+	// TradeRateLimitIntervalKey is the duration in seconds of the time window
+	// used to rate limit requests to the Trade interface
+	TradeRateLimitIntervalKey = "TRADE_RATE_LIMIT_INTERVAL"
+	// PreviewTradeRateLimitKey is the max number of PreviewTrade requests
+	// allowed per client IP within the rate limit interval, 0 means unlimited
+	PreviewTradeRateLimitKey = "PREVIEW_TRADE_RATE_LIMIT"
+	// ProposeTradeRateLimitKey is the max number of ProposeTrade requests
+	// allowed per client IP and per trader within the rate limit interval,
+	// 0 means unlimited
+	ProposeTradeRateLimitKey = "PROPOSE_TRADE_RATE_LIMIT"
+	// CompleteTradeRateLimitKey is the max number of CompleteTrade requests
+	// allowed per client IP and per trader within the rate limit interval,
+	// 0 means unlimited
+	CompleteTradeRateLimitKey = "COMPLETE_TRADE_RATE_LIMIT"
+	// MaxPendingTradesPerMarketKey is the max number of trades accepted but not
+	// yet completed for every market, 0 means unlimited
+	MaxPendingTradesPerMarketKey = "MAX_PENDING_TRADES_PER_MARKET"
+	// TradeRetentionDaysKey is the number of days after which failed and
+	// expired trades are moved from the trade store to the archive, 0 disables
+	// the periodic archival
+	TradeRetentionDaysKey = "TRADE_RETENTION_DAYS"
+	// TradeArchiveIntervalKey is the duration in seconds between periodic
+	// archivals of failed and expired trades
+	TradeArchiveIntervalKey = "TRADE_ARCHIVE_INTERVAL"
+	// PriceTickRetentionDaysKey is the number of days after which the prices
+	// received and applied by the price feeds are deleted, 0 keeps them forever
+	PriceTickRetentionDaysKey = "PRICE_TICK_RETENTION_DAYS"
+	// BalanceSnapshotIntervalKey is the duration in seconds between periodic
+	// snapshots of the balances of the fee account and of the markets, 0
+	// disables the balance history
+	BalanceSnapshotIntervalKey = "BALANCE_SNAPSHOT_INTERVAL"
+	// PriceFeedMaxDeviationKey is the max relative change, ie. 0.1 for 10%,
+	// between a price feed update and the last accepted price, above which the
+	// update is rejected, 0 disables the guard. Updates keep being rejected
+	// until the operator updates the market price and restarts the feed
+	PriceFeedMaxDeviationKey = "PRICE_FEED_MAX_DEVIATION"
+	// PriceFeedDeviationCloseMarketKey makes a market close when an update of
+	// its price feed is rejected, otherwise the market is kept open at the last
+	// accepted price
+	PriceFeedDeviationCloseMarketKey = "PRICE_FEED_DEVIATION_CLOSE_MARKET"
+	// ReadOnlyKey starts the daemon as a read-only replica of a copy or
+	// snapshot of the datadir, serving only the read RPCs of the Operator
+	// interface, without connecting to the wallet nor opening the Trade
+	// interface. Not supported for the postgres db type
+	ReadOnlyKey = "READ_ONLY"
+	// RestoreKey is the path of a backup made with the Backup RPC from which
+	// the daemon rebuilds the db datadir and exits, without serving
+	RestoreKey = "RESTORE"
+	// MigrateDryRunKey makes the daemon list the pending datadir migrations
+	// without applying them and exit, without serving
+	MigrateDryRunKey = "MIGRATE_DRY_RUN"
 
 	DbLocation        = "db"
 	TLSLocation       = "tls"
 	MacaroonsLocation = "macaroons"
 	ProfilerLocation  = "stats"
+	ArchiveLocation   = "archive"
 
 	httpsProtocol = "https"
 )
@@ -94,6 +150,19 @@ func InitConfig() error {
 	vip.SetDefault(NoOperatorTlsKey, false)
 	vip.SetDefault(ConnectProtoKey, httpsProtocol)
 	vip.SetDefault(DBTypeKey, application.DBBadger)
+	vip.SetDefault(TradeRateLimitIntervalKey, 60)
+	vip.SetDefault(PreviewTradeRateLimitKey, 120)
+	vip.SetDefault(ProposeTradeRateLimitKey, 20)
+	vip.SetDefault(CompleteTradeRateLimitKey, 20)
+	vip.SetDefault(MaxPendingTradesPerMarketKey, 50)
+	vip.SetDefault(TradeRetentionDaysKey, 0)
+	vip.SetDefault(TradeArchiveIntervalKey, 3600)
+	vip.SetDefault(PriceTickRetentionDaysKey, 30)
+	vip.SetDefault(BalanceSnapshotIntervalKey, 3600)
+	vip.SetDefault(PriceFeedMaxDeviationKey, 0)
+	vip.SetDefault(PriceFeedDeviationCloseMarketKey, false)
+	vip.SetDefault(ReadOnlyKey, false)
+	vip.SetDefault(MigrateDryRunKey, false)
 
 	if err := validate(); err != nil {
 		return fmt.Errorf("error while validating config: %s", err)
@@ -152,7 +221,54 @@ func validate() error {
 		return fmt.Errorf("%s must be equal or greater than 0.1", TxSatsPerByteKey)
 	}
 
-	if !vip.IsSet(OceanWalletAddrKey) {
+	if GetInt(TradeRateLimitIntervalKey) <= 0 {
+		return fmt.Errorf("%s must be a positive number", TradeRateLimitIntervalKey)
+	}
+	for _, key := range []string{
+		PreviewTradeRateLimitKey, ProposeTradeRateLimitKey,
+		CompleteTradeRateLimitKey, MaxPendingTradesPerMarketKey,
+		TradeRetentionDaysKey, PriceTickRetentionDaysKey,
+		BalanceSnapshotIntervalKey,
+	} {
+		if GetInt(key) < 0 {
+			return fmt.Errorf("%s must not be a negative number", key)
+		}
+	}
+
+	if GetInt(TradeArchiveIntervalKey) <= 0 {
+		return fmt.Errorf("%s must be a positive number", TradeArchiveIntervalKey)
+	}
+
+	if GetFloat(PriceFeedMaxDeviationKey) < 0 {
+		return fmt.Errorf("%s must not be a negative number", PriceFeedMaxDeviationKey)
+	}
+
+	dbType := GetString(DBTypeKey)
+	if _, ok := application.SupportedDBType[dbType]; !ok {
+		return fmt.Errorf("unsupported db type %s", dbType)
+	}
+	if dbType == application.DBPostgres && GetString(DBDSNKey) == "" {
+		return fmt.Errorf("%s is required for db type %s", DBDSNKey, dbType)
+	}
+	// A replica of a postgres db is expected to be made with the tools of the
+	// db server, like a hot standby, rather than with a copy of the datadir.
+	if GetBool(ReadOnlyKey) && dbType == application.DBPostgres {
+		return fmt.Errorf(
+			"%s is not supported for db type %s", ReadOnlyKey, dbType,
+		)
+	}
+
+	if GetString(RestoreKey) != "" && GetBool(MigrateDryRunKey) {
+		return fmt.Errorf(
+			"%s and %s are mutually exclusive", RestoreKey, MigrateDryRunKey,
+		)
+	}
+
+	// Restoring a backup or listing the pending migrations doesn't require
+	// connecting to the wallet.
+	needsWallet := !GetBool(ReadOnlyKey) && GetString(RestoreKey) == "" &&
+		!GetBool(MigrateDryRunKey)
+	if needsWallet && !vip.IsSet(OceanWalletAddrKey) {
 		return fmt.Errorf("missing wallet address")
 	}
 
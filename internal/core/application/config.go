@@ -1,40 +1,85 @@
 package application
 
 import (
+	"fmt"
+	"path/filepath"
+	"time"
+
 	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/changefeed"
 	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
 )
 
 const (
-	DBBadger = "badger"
+	DBBadger   = "badger"
+	DBSQLite   = "sqlite"
+	DBPostgres = "postgres"
 )
 
 var (
 	SupportedDBType = map[string]struct{}{
-		DBBadger: {},
+		DBBadger:   {},
+		DBSQLite:   {},
+		DBPostgres: {},
 	}
 )
 
 type Config struct {
-	DBType   string
+	DBType string
+	// DBConfig is the datadir for badger and sqlite, or the connection string
+	// for postgres.
 	DBConfig interface{}
 
-	OceanWallet         ports.WalletService
-	SecurePubSub        ports.SecurePubSub
-	PriceFeederSvc      ports.PriceFeeder
+	OceanWallet    ports.WalletService
+	SecurePubSub   ports.SecurePubSub
+	PriceFeederSvc ports.PriceFeeder
+	// Migrator, if defined, is included in backups to record the schema
+	// version of the datadir.
+	Migrator            ports.Backupable
 	FeeBalanceThreshold uint64
 	TradePriceSlippage  decimal.Decimal
+	TradeExpiryTime     uint64
 	TxSatsPerByte       decimal.Decimal
+	// Rate limits for the trade interface, a zero value means unlimited.
+	// The preview limit applies per client IP, the propose and complete ones
+	// apply both per client IP and per trader.
+	TradeRateLimitInterval    time.Duration
+	PreviewTradeRateLimit     int
+	ProposeTradeRateLimit     int
+	CompleteTradeRateLimit    int
+	MaxPendingTradesPerMarket int
+	// Failed and expired trades are moved to the archive once older than the
+	// retention period. The archival job runs every archive interval if the
+	// retention period is not zero.
+	TradeArchive         ports.TradeArchive
+	TradeRetention       time.Duration
+	TradeArchiveInterval time.Duration
+	// The balances of the fee account and of the markets are recorded every
+	// snapshot interval, a zero value disables the history.
+	BalanceSnapshotInterval time.Duration
+	// Price feed updates deviating more than the max deviation from the last
+	// accepted price are rejected, a zero max deviation disables the guard.
+	// Rejected updates are notified to webhooks and, if required, make the
+	// market close.
+	PriceFeedMaxDeviation         decimal.Decimal
+	PriceFeedDeviationCloseMarket bool
+	// ReadOnly is set when the daemon serves only the read RPCs on a copy of
+	// the datadir. Badger stores are opened in read-only mode, background jobs
+	// writing to the stores are not started and no event is published to
+	// webhooks.
+	ReadOnly bool
 
-	repo     ports.RepoManager
-	pubsub   PubSubService
-	wallet   WalletService
-	unlocker UnlockerService
-	operator OperatorService
-	trade    TradeService
-	feeder   FeederService
+	changeFeedBus ports.ChangeFeed
+	repo          ports.RepoManager
+	pubsub        PubSubService
+	wallet        WalletService
+	unlocker      UnlockerService
+	operator      OperatorService
+	trade         TradeService
+	feeder        FeederService
 }
 
 func (c *Config) Validate() error {
@@ -52,6 +97,12 @@ func (c *Config) RepoManager() ports.RepoManager {
 	return svc
 }
 
+// ChangeFeed returns the feed notifying the changes committed to markets,
+// trades, deposits and withdrawals.
+func (c *Config) ChangeFeed() ports.ChangeFeed {
+	return c.changeFeed()
+}
+
 func (c *Config) PubSubService() PubSubService {
 	svc, _ := c.pubsubService()
 	return svc
@@ -84,21 +135,63 @@ func (c *Config) FeederService() FeederService {
 
 func (c *Config) repoManager() (ports.RepoManager, error) {
 	if c.repo == nil {
-		if c.DBType == DBBadger {
+		var repoManager ports.RepoManager
+		var err error
+		switch c.DBType {
+		case DBBadger:
 			datadir := c.DBConfig.(string)
-			repoManager, err := dbbadger.NewRepoManager(datadir, log.New())
-			if err != nil {
-				return nil, err
+			if c.ReadOnly {
+				repoManager, err = dbbadger.NewReadOnlyRepoManager(
+					datadir, log.New(),
+				)
+			} else {
+				repoManager, err = dbbadger.NewRepoManager(datadir, log.New())
 			}
+		case DBSQLite:
+			dbFile := filepath.Join(c.DBConfig.(string), dbsql.SQLiteFile)
+			if c.ReadOnly {
+				repoManager, err = dbsql.NewReadOnlySQLiteRepoManager(dbFile)
+			} else {
+				repoManager, err = dbsql.NewSQLiteRepoManager(dbFile)
+			}
+		case DBPostgres:
+			if c.ReadOnly {
+				err = fmt.Errorf(
+					"read-only mode is not supported for db type %s", c.DBType,
+				)
+				break
+			}
+			dsn := c.DBConfig.(string)
+			repoManager, err = dbsql.NewPostgresRepoManager(dsn)
+		default:
+			err = fmt.Errorf("unsupported db type %s", c.DBType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// A replica never writes the stores, there's no change to notify.
+		if c.ReadOnly {
 			c.repo = repoManager
+			return c.repo, nil
 		}
+		c.repo = changefeed.NewRepoManager(repoManager, c.changeFeed())
 	}
 	return c.repo, nil
 }
 
+func (c *Config) changeFeed() ports.ChangeFeed {
+	if c.changeFeedBus == nil {
+		c.changeFeedBus = changefeed.NewBus()
+	}
+	return c.changeFeedBus
+}
+
 func (c *Config) pubsubService() (PubSubService, error) {
 	if c.pubsub == nil {
 		c.pubsub = NewPubSubService(c.SecurePubSub)
+		if !c.ReadOnly {
+			c.pubsub.SubscribeToChangeFeed(c.changeFeed())
+		}
 	}
 	return c.pubsub, nil
 }
@@ -132,8 +225,17 @@ func (c *Config) operatorService() (OperatorService, error) {
 		wallet, _ := c.walletService()
 		pubsub, _ := c.pubsubService()
 		repo, _ := c.repoManager()
+		tradeArchive := c.TradeArchive
+		tradeArchiveInterval := c.TradeArchiveInterval
+		balanceSnapshotInterval := c.BalanceSnapshotInterval
+		if c.ReadOnly {
+			tradeArchive = nil
+			tradeArchiveInterval, balanceSnapshotInterval = 0, 0
+		}
 		operator, err := NewOperatorService(
 			wallet, pubsub, repo, c.FeeBalanceThreshold, c.TxSatsPerByte,
+			c.backupStores(), c.maintainableStores(), tradeArchive,
+			c.TradeRetention, tradeArchiveInterval, balanceSnapshotInterval,
 		)
 		if err != nil {
 			return nil, err
@@ -143,6 +245,33 @@ func (c *Config) operatorService() (OperatorService, error) {
 	return c.operator, nil
 }
 
+// backupStores returns the services whose stores are included in backups.
+func (c *Config) backupStores() []ports.Backupable {
+	repo, _ := c.repoManager()
+	stores := make([]ports.Backupable, 0)
+	for _, svc := range []interface{}{
+		repo, c.SecurePubSub, c.PriceFeederSvc, c.Migrator, c.TradeArchive,
+	} {
+		if store, ok := svc.(ports.Backupable); ok {
+			stores = append(stores, store)
+		}
+	}
+	return stores
+}
+
+// maintainableStores returns the services whose stores can be inspected and
+// garbage collected on demand.
+func (c *Config) maintainableStores() []ports.Maintainable {
+	repo, _ := c.repoManager()
+	stores := make([]ports.Maintainable, 0)
+	for _, svc := range []interface{}{repo, c.SecurePubSub} {
+		if store, ok := svc.(ports.Maintainable); ok {
+			stores = append(stores, store)
+		}
+	}
+	return stores
+}
+
 func (c *Config) tradeService() (TradeService, error) {
 	if c.trade == nil {
 		wallet, _ := c.walletService()
@@ -150,6 +279,8 @@ func (c *Config) tradeService() (TradeService, error) {
 		repo, _ := c.repoManager()
 		trade, err := NewTradeService(
 			wallet, pubsub, repo, c.TradePriceSlippage, c.TxSatsPerByte,
+			c.TradeExpiryTime, c.ProposeTradeRateLimit, c.CompleteTradeRateLimit,
+			c.TradeRateLimitInterval, c.MaxPendingTradesPerMarket,
 		)
 		if err != nil {
 			return nil, err
@@ -161,7 +292,11 @@ func (c *Config) tradeService() (TradeService, error) {
 
 func (c *Config) feederService() (FeederService, error) {
 	if c.feeder == nil {
-		feeder, err := NewFeederService(c.PriceFeederSvc, c.repo)
+		pubsub, _ := c.pubsubService()
+		feeder, err := NewFeederService(
+			c.PriceFeederSvc, pubsub, c.repo, c.PriceFeedMaxDeviation,
+			c.PriceFeedDeviationCloseMarket,
+		)
 		if err != nil {
 			return nil, err
 		}
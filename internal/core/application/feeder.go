@@ -3,6 +3,9 @@ package application
 import (
 	"context"
 
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-daemon/internal/core/application/pubsub"
+
 	"github.com/tdex-network/tdex-daemon/internal/core/application/feeder"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
@@ -12,20 +15,51 @@ type FeederService interface {
 	AddPriceFeed(
 		ctx context.Context, market ports.Market, source, ticker string,
 	) (string, error)
+	AddCompositePriceFeed(
+		ctx context.Context, market ports.Market,
+		sources []ports.PriceSource, aggregation ports.PriceAggregation,
+	) (string, error)
+	AddDerivedPriceFeed(
+		ctx context.Context, market ports.Market,
+		legs []ports.PriceLeg, maxAge uint32,
+	) (string, error)
 	StartPriceFeed(ctx context.Context, id string) error
 	StopPriceFeed(ctx context.Context, id string) error
 	UpdatePriceFeed(ctx context.Context, id, source, ticker string) error
+	UpdateCompositePriceFeed(
+		ctx context.Context, id string,
+		sources []ports.PriceSource, aggregation ports.PriceAggregation,
+	) error
+	UpdateDerivedPriceFeed(
+		ctx context.Context, id string, legs []ports.PriceLeg, maxAge uint32,
+	) error
+	UpdatePriceFeedTransformation(
+		ctx context.Context, id string, transformation ports.PriceTransformation,
+	) error
+	PushPrice(ctx context.Context, id string, price ports.MarketPrice) error
 	RemovePriceFeed(ctx context.Context, id string) error
 	GetPriceFeed(ctx context.Context, id string) (ports.PriceFeedInfo, error)
+	ListPriceTicks(
+		ctx context.Context, id string, tickType ports.PriceTickType,
+		timeRange ports.TimeRange, page ports.Page,
+	) ([]ports.PriceTick, error)
 	ListSources(ctx context.Context) []string
 	ListPriceFeeds(ctx context.Context) ([]ports.PriceFeedInfo, error)
 	Close()
 }
 
 func NewFeederService(
-	feederSvc ports.PriceFeeder, repoManager ports.RepoManager,
+	feederSvc ports.PriceFeeder, pubsubSvc PubSubService,
+	repoManager ports.RepoManager, maxPriceDeviation decimal.Decimal,
+	closeMarketOnDeviation bool,
 ) (FeederService, error) {
-	svc, err := feeder.NewService(feederSvc, repoManager)
+	var p *pubsub.Service
+	if pubsubSvc != nil {
+		p = pubsubSvc.(*pubsub.Service)
+	}
+	svc, err := feeder.NewService(
+		feederSvc, p, repoManager, maxPriceDeviation, closeMarketOnDeviation,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+package feeder
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// deviationGuard rejects the prices of a feed that differ too much from the
+// last accepted one. The reference isn't moved by rejected prices, however
+// long they're sent for, so that a feed stuck on a bad price can't get it
+// applied. If the price has actually moved, the operator has to update the
+// market price and restart the feed, which seeds the guard with it.
+type deviationGuard struct {
+	maxDeviation decimal.Decimal
+
+	lastPrice decimal.Decimal
+}
+
+func newDeviationGuard(maxDeviation decimal.Decimal) *deviationGuard {
+	return &deviationGuard{maxDeviation: maxDeviation}
+}
+
+// check returns the relative deviation of the given price from the last
+// accepted one and whether the price is accepted. Accepted prices become the
+// reference for the next checks.
+func (g *deviationGuard) check(price decimal.Decimal) (decimal.Decimal, bool) {
+	if g.maxDeviation.IsZero() || g.lastPrice.IsZero() {
+		g.accept(price)
+		return decimal.Zero, true
+	}
+
+	deviation := price.Sub(g.lastPrice).Abs().Div(g.lastPrice)
+	if deviation.GreaterThan(g.maxDeviation) {
+		return deviation, false
+	}
+
+	g.accept(price)
+	return deviation, true
+}
+
+func (g *deviationGuard) accept(price decimal.Decimal) {
+	g.lastPrice = price
+}
@@ -0,0 +1,85 @@
+package feeder
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviationGuard(t *testing.T) {
+	type step struct {
+		price     string
+		accepted  bool
+		deviation string
+	}
+
+	tests := []struct {
+		name         string
+		maxDeviation string
+		steps        []step
+	}{
+		{
+			name:         "disabled",
+			maxDeviation: "0",
+			steps: []step{
+				{"100", true, "0"},
+				{"200", true, "0"},
+				{"10", true, "0"},
+			},
+		},
+		{
+			name:         "first price is accepted",
+			maxDeviation: "0.1",
+			steps: []step{
+				{"100", true, "0"},
+			},
+		},
+		{
+			name:         "accepted price becomes the reference",
+			maxDeviation: "0.1",
+			steps: []step{
+				{"100", true, "0"},
+				{"110", true, "0.1"},
+				{"120", true, "0.0909"},
+				{"100", false, "0.1667"},
+			},
+		},
+		{
+			name:         "rejected price does not move the reference",
+			maxDeviation: "0.1",
+			steps: []step{
+				{"100", true, "0"},
+				{"150", false, "0.5"},
+				{"160", false, "0.6"},
+				{"105", true, "0.05"},
+			},
+		},
+		{
+			name:         "sustained bad price is never accepted",
+			maxDeviation: "0.1",
+			steps: []step{
+				{"100", true, "0"},
+				{"150", false, "0.5"},
+				{"150", false, "0.5"},
+				{"150", false, "0.5"},
+				{"150", false, "0.5"},
+				{"150", false, "0.5"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := newDeviationGuard(decimal.RequireFromString(tt.maxDeviation))
+			for i, s := range tt.steps {
+				deviation, ok := guard.check(decimal.RequireFromString(s.price))
+				require.Equal(t, s.accepted, ok, "step %d", i)
+				require.True(
+					t, decimal.RequireFromString(s.deviation).Equal(deviation.Round(4)),
+					"step %d: got deviation %s", i, deviation,
+				)
+			}
+		})
+	}
+}
@@ -3,23 +3,42 @@ package feeder
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/tdex-network/tdex-daemon/internal/core/application/pubsub"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 )
 
 type Service struct {
 	repoManager ports.RepoManager
 	feederSvc   ports.PriceFeeder
+	pubsub      *pubsub.Service
+
+	// Price updates deviating more than maxPriceDeviation from the last
+	// accepted price are rejected. A zero max deviation disables the guard.
+	maxPriceDeviation      decimal.Decimal
+	closeMarketOnDeviation bool
 }
 
 func NewService(
-	feederSvc ports.PriceFeeder, repoManager ports.RepoManager,
+	feederSvc ports.PriceFeeder, pubsubSvc *pubsub.Service,
+	repoManager ports.RepoManager, maxPriceDeviation decimal.Decimal,
+	closeMarketOnDeviation bool,
 ) (*Service, error) {
+	if maxPriceDeviation.IsNegative() {
+		return nil, fmt.Errorf("max price deviation must not be negative")
+	}
+
 	svc := &Service{
-		repoManager: repoManager,
-		feederSvc:   feederSvc,
+		repoManager:            repoManager,
+		feederSvc:              feederSvc,
+		pubsub:                 pubsubSvc,
+		maxPriceDeviation:      maxPriceDeviation,
+		closeMarketOnDeviation: closeMarketOnDeviation,
 	}
 
 	if err := svc.startPriceFeeds(); err != nil {
@@ -35,6 +54,20 @@ func (s *Service) AddPriceFeed(
 	return s.feederSvc.AddPriceFeed(ctx, market, source, ticker)
 }
 
+func (s *Service) AddCompositePriceFeed(
+	ctx context.Context, market ports.Market,
+	sources []ports.PriceSource, aggregation ports.PriceAggregation,
+) (string, error) {
+	return s.feederSvc.AddCompositePriceFeed(ctx, market, sources, aggregation)
+}
+
+func (s *Service) AddDerivedPriceFeed(
+	ctx context.Context, market ports.Market,
+	legs []ports.PriceLeg, maxAge uint32,
+) (string, error) {
+	return s.feederSvc.AddDerivedPriceFeed(ctx, market, legs, maxAge)
+}
+
 func (s *Service) StartPriceFeed(ctx context.Context, id string) error {
 	priceFeed, err := s.feederSvc.GetPriceFeed(ctx, id)
 	if err != nil {
@@ -58,14 +91,68 @@ func (s *Service) StartPriceFeed(ctx context.Context, id string) error {
 		return err
 	}
 
-	go func(priceFeed ports.PriceFeedInfo) {
-		log.Debugf(
-			"start listening to price feeds from %s for market %s",
-			priceFeed.GetSource(), priceFeed.GetTicker(),
-		)
+	go s.listenPriceFeed(priceFeed, ch)
+
+	return nil
+}
+
+// listenPriceFeed updates the market price with the prices received through
+// the given channel until this is closed. Prices are first checked by the
+// deviation guard, then debounced and transformed according to the settings
+// of the price feed.
+func (s *Service) listenPriceFeed(
+	priceFeed ports.PriceFeedInfo, ch chan ports.PriceFeed,
+) {
+	log.Debugf(
+		"start listening to price feeds from %s for market %s",
+		priceFeed.GetSource(), priceFeed.GetTicker(),
+	)
+
+	ctx := context.Background()
+	transformation := priceFeed.GetTransformation()
+	guard := newDeviationGuard(s.maxPriceDeviation)
+	var minUpdateInterval time.Duration
+	if transformation != nil {
+		minUpdateInterval = time.Duration(
+			transformation.GetMinUpdateInterval(),
+		) * time.Second
+	}
+
+	// The guard is seeded with the current market price, so that also the
+	// first price received after the feed is (re)started is checked, and the
+	// operator can make it accept a new price level by updating the market
+	// price before restarting the feed.
+	market, _ := s.repoManager.MarketRepository().GetMarketByAssets(
+		ctx, priceFeed.GetMarket().GetBaseAsset(),
+		priceFeed.GetMarket().GetQuoteAsset(),
+	)
+	if market != nil && !market.Price.IsZero() {
+		guard.accept(untransformedQuotePrice(market.Price, transformation))
+	}
+
+	var lastUpdate time.Time
+	var pendingFeed ports.PriceFeed
+	var timer *time.Timer
+	var timerCh <-chan time.Time
+	stopTimer := func() {
+		if timer != nil {
+			timer.Stop()
+		}
+		timer, timerCh, pendingFeed = nil, nil, nil
+	}
+	defer stopTimer()
+
+	for {
+		select {
+		case feed, ok := <-ch:
+			if !ok {
+				log.Debugf(
+					"stop listening to price feeds from %s for market %s",
+					priceFeed.GetSource(), priceFeed.GetTicker(),
+				)
+				return
+			}
 
-		ctx := context.Background()
-		for feed := range ch {
 			market, _ := s.repoManager.MarketRepository().GetMarketByAssets(
 				ctx, feed.GetMarket().GetBaseAsset(), feed.GetMarket().GetQuoteAsset(),
 			)
@@ -73,27 +160,122 @@ func (s *Service) StartPriceFeed(ctx context.Context, id string) error {
 				continue
 			}
 
-			log.Debugf(
-				"received price feed from %s for market %s, updating market price...",
-				priceFeed.GetSource(), priceFeed.GetTicker(),
-			)
-
-			if err := s.repoManager.MarketRepository().UpdateMarketPrice(
-				ctx, market.Name, marketPrice{feed.GetPrice()}.toDomain(),
-			); err != nil {
-				log.WithError(err).Warnf(
-					"failed to update price for market %s", priceFeed.GetTicker(),
+			lastPrice := guard.lastPrice
+			quotePrice := feed.GetPrice().GetQuotePrice()
+			if deviation, ok := guard.check(quotePrice); !ok {
+				s.onPriceDeviation(
+					ctx, priceFeed, *market, lastPrice, quotePrice, deviation,
 				)
+				continue
+			}
+
+			// Prices received too early are debounced, only the last one is
+			// applied once the min interval has elapsed.
+			if wait := minUpdateInterval - time.Since(lastUpdate); wait > 0 {
+				pendingFeed = feed
+				if timer == nil {
+					timer = time.NewTimer(wait)
+					timerCh = timer.C
+				}
+				continue
+			}
+
+			stopTimer()
+			s.updateMarketPrice(ctx, priceFeed, *market, feed)
+			lastUpdate = time.Now()
+
+		case <-timerCh:
+			feed := pendingFeed
+			timer, timerCh, pendingFeed = nil, nil, nil
+
+			market, _ := s.repoManager.MarketRepository().GetMarketByAssets(
+				ctx, priceFeed.GetMarket().GetBaseAsset(),
+				priceFeed.GetMarket().GetQuoteAsset(),
+			)
+			if market == nil || feed == nil {
+				continue
 			}
+			s.updateMarketPrice(ctx, priceFeed, *market, feed)
+			lastUpdate = time.Now()
 		}
+	}
+}
 
-		log.Debugf(
-			"stop listening to price feeds from %s for market %s",
-			priceFeed.GetSource(), priceFeed.GetTicker(),
+// updateMarketPrice applies the transformed price of the given update to the
+// market, and records it as applied by the price feed.
+func (s *Service) updateMarketPrice(
+	ctx context.Context, priceFeed ports.PriceFeedInfo, market domain.Market,
+	feed ports.PriceFeed,
+) {
+	log.Debugf(
+		"received price feed from %s for market %s, updating market price...",
+		priceFeed.GetSource(), priceFeed.GetTicker(),
+	)
+
+	price := marketPrice{feed.GetPrice()}.transform(
+		priceFeed.GetTransformation(), market,
+	)
+	if price.GetBasePrice().IsZero() || price.GetQuotePrice().IsZero() {
+		log.Warnf(
+			"skipped price update for market %s from price feed %s, price "+
+				"rounded to zero",
+			market.Name, priceFeed.GetId(),
 		)
-	}(priceFeed)
+		return
+	}
+	if err := s.repoManager.MarketRepository().UpdateMarketPrice(
+		ctx, market.Name, price,
+	); err != nil {
+		log.WithError(err).Warnf(
+			"failed to update price for market %s", priceFeed.GetTicker(),
+		)
+		return
+	}
 
-	return nil
+	if err := s.feederSvc.AddAppliedPrice(
+		ctx, priceFeed.GetId(), feed.GetTickId(), price,
+	); err != nil {
+		log.WithError(err).Warnf(
+			"failed to record price applied by price feed %s", priceFeed.GetId(),
+		)
+	}
+}
+
+// onPriceDeviation handles a price update rejected by the deviation guard:
+// the market is either closed or left at the last good price, and an alert
+// is published to webhooks.
+func (s *Service) onPriceDeviation(
+	ctx context.Context, priceFeed ports.PriceFeedInfo, market domain.Market,
+	lastPrice, rejectedPrice, deviation decimal.Decimal,
+) {
+	log.Warnf(
+		"rejected price %s for market %s from price feed %s, deviating %s%% "+
+			"from last price %s",
+		rejectedPrice, market.Name, priceFeed.GetId(),
+		deviation.Mul(decimal.NewFromInt(100)).StringFixed(2), lastPrice,
+	)
+
+	marketClosed := false
+	if s.closeMarketOnDeviation && market.IsTradable() {
+		if err := s.repoManager.MarketRepository().CloseMarket(
+			ctx, market.Name,
+		); err != nil {
+			log.WithError(err).Warnf("failed to close market %s", market.Name)
+		} else {
+			marketClosed = true
+			log.Warnf("market %s closed because of price deviation", market.Name)
+		}
+	}
+
+	if s.pubsub == nil {
+		return
+	}
+	if err := s.pubsub.PublishPriceFeedDeviationEvent(
+		priceFeed.GetId(), priceFeed.GetMarket(), lastPrice, rejectedPrice,
+		deviation, marketClosed,
+	); err != nil {
+		log.WithError(err).Warn("failed to publish price feed deviation event")
+	}
 }
 
 func (s *Service) StopPriceFeed(ctx context.Context, id string) error {
@@ -115,6 +297,58 @@ func (s *Service) UpdatePriceFeed(
 	return s.feederSvc.UpdatePriceFeed(ctx, id, source, ticker)
 }
 
+func (s *Service) UpdateCompositePriceFeed(
+	ctx context.Context, id string,
+	sources []ports.PriceSource, aggregation ports.PriceAggregation,
+) error {
+	priceFeed, err := s.feederSvc.GetPriceFeed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if priceFeed.IsStarted() {
+		return fmt.Errorf("price feed must be stopped to be updated")
+	}
+
+	return s.feederSvc.UpdateCompositePriceFeed(ctx, id, sources, aggregation)
+}
+
+func (s *Service) UpdateDerivedPriceFeed(
+	ctx context.Context, id string, legs []ports.PriceLeg, maxAge uint32,
+) error {
+	priceFeed, err := s.feederSvc.GetPriceFeed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if priceFeed.IsStarted() {
+		return fmt.Errorf("price feed must be stopped to be updated")
+	}
+
+	return s.feederSvc.UpdateDerivedPriceFeed(ctx, id, legs, maxAge)
+}
+
+func (s *Service) UpdatePriceFeedTransformation(
+	ctx context.Context, id string, transformation ports.PriceTransformation,
+) error {
+	priceFeed, err := s.feederSvc.GetPriceFeed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if priceFeed.IsStarted() {
+		return fmt.Errorf("price feed must be stopped to be updated")
+	}
+
+	return s.feederSvc.UpdatePriceFeedTransformation(ctx, id, transformation)
+}
+
+func (s *Service) PushPrice(
+	ctx context.Context, id string, price ports.MarketPrice,
+) error {
+	return s.feederSvc.PushPrice(ctx, id, price)
+}
+
 func (s *Service) RemovePriceFeed(ctx context.Context, id string) error {
 	priceFeed, err := s.feederSvc.GetPriceFeed(ctx, id)
 	if err != nil {
@@ -149,6 +383,23 @@ func (s *Service) GetPriceFeed(
 	return s.feederSvc.GetPriceFeed(ctx, id)
 }
 
+func (s *Service) ListPriceTicks(
+	ctx context.Context, id string, tickType ports.PriceTickType,
+	timeRange ports.TimeRange, page ports.Page,
+) ([]ports.PriceTick, error) {
+	var startTime, endTime time.Time
+	if timeRange != nil {
+		var err error
+		startTime, endTime, err = timeRangeToDates(timeRange)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.feederSvc.ListPriceTicks(
+		ctx, id, tickType, startTime, endTime, page,
+	)
+}
+
 func (s *Service) ListSources(ctx context.Context) []string {
 	return s.feederSvc.ListSources(ctx)
 }
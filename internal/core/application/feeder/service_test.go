@@ -0,0 +1,283 @@
+package feeder
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+)
+
+const (
+	baseAsset  = "0000000000000000000000000000000000000000000000000000000000000001"
+	quoteAsset = "0000000000000000000000000000000000000000000000000000000000000002"
+)
+
+func TestPriceDeviation(t *testing.T) {
+	tests := []struct {
+		name            string
+		closeMarket     bool
+		transformation  ports.PriceTransformation
+		prices          []string
+		expectedPrice   string
+		expectTradable  bool
+		expectedApplied int
+	}{
+		{
+			name:            "price in range is applied",
+			closeMarket:     true,
+			prices:          []string{"105"},
+			expectedPrice:   "105",
+			expectTradable:  true,
+			expectedApplied: 1,
+		},
+		{
+			name:           "first price is checked against the market price",
+			closeMarket:    true,
+			prices:         []string{"150"},
+			expectedPrice:  "100",
+			expectTradable: false,
+		},
+		{
+			name:           "market is left open at the last good price",
+			closeMarket:    false,
+			prices:         []string{"150"},
+			expectedPrice:  "100",
+			expectTradable: true,
+		},
+		{
+			name:            "rejected price is skipped",
+			closeMarket:     false,
+			prices:          []string{"150", "95"},
+			expectedPrice:   "95",
+			expectTradable:  true,
+			expectedApplied: 1,
+		},
+		{
+			name:           "sustained bad price is never applied",
+			closeMarket:    false,
+			prices:         []string{"150", "150", "150", "150", "150"},
+			expectedPrice:  "100",
+			expectTradable: true,
+		},
+		{
+			name:            "markup is removed from the market price",
+			closeMarket:     true,
+			transformation:  transformation{markupBps: 4000},
+			prices:          []string{"72"},
+			expectedPrice:   "100.8",
+			expectTradable:  true,
+			expectedApplied: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoManager := newTestRepoManager(t, "100")
+			feeder := &mockFeeder{}
+			svc := &Service{
+				repoManager:            repoManager,
+				feederSvc:              feeder,
+				maxPriceDeviation:      decimal.NewFromFloat(0.1),
+				closeMarketOnDeviation: tt.closeMarket,
+			}
+
+			listen(svc, priceFeed{tt.transformation}, tt.prices...)
+
+			mkt, err := repoManager.MarketRepository().GetMarketByName(
+				context.Background(), "market",
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectTradable, mkt.IsTradable())
+			require.True(
+				t, decimal.RequireFromString(tt.expectedPrice).Equal(
+					mkt.Price.GetQuotePrice(),
+				), "got price %s", mkt.Price.QuotePrice,
+			)
+			require.Equal(t, tt.expectedApplied, feeder.applied())
+		})
+	}
+}
+
+func TestPriceDebounce(t *testing.T) {
+	repoManager := newTestRepoManager(t, "100")
+	feeder := &mockFeeder{}
+	svc := &Service{repoManager: repoManager, feederSvc: feeder}
+	info := priceFeed{transformation{minUpdateInterval: 1}}
+
+	ch := make(chan ports.PriceFeed)
+	done := make(chan struct{})
+	go func() {
+		svc.listenPriceFeed(info, ch)
+		close(done)
+	}()
+
+	// The first price is applied right away, the others received within the
+	// min update interval are debounced and only the last one is applied.
+	for i, p := range []string{"101", "102", "103"} {
+		ch <- newPriceUpdate(string(rune('a'+i)), p)
+	}
+	require.Eventually(t, func() bool {
+		return feeder.applied() == 2
+	}, 3*time.Second, 50*time.Millisecond)
+
+	close(ch)
+	<-done
+
+	require.Equal(t, []string{"a", "c"}, feeder.appliedTicks)
+	mkt, err := repoManager.MarketRepository().GetMarketByName(
+		context.Background(), "market",
+	)
+	require.NoError(t, err)
+	require.True(
+		t, decimal.RequireFromString("103").Equal(mkt.Price.GetQuotePrice()),
+	)
+}
+
+func TestPriceRoundedToZero(t *testing.T) {
+	repoManager := newTestRepoManager(t, "100")
+	feeder := &mockFeeder{}
+	svc := &Service{repoManager: repoManager, feederSvc: feeder}
+
+	// The base price 1/20000 is rounded to zero with a precision of 2.
+	mkt, err := repoManager.MarketRepository().GetMarketByName(
+		context.Background(), "market",
+	)
+	require.NoError(t, err)
+	mkt.BaseAssetPrecision = 2
+	err = repoManager.MarketRepository().UpdateMarket(
+		context.Background(), mkt.Name,
+		func(m *domain.Market) (*domain.Market, error) { return mkt, nil },
+	)
+	require.NoError(t, err)
+
+	listen(svc, priceFeed{transformation{rounding: true}}, "20000")
+
+	mkt, err = repoManager.MarketRepository().GetMarketByName(
+		context.Background(), "market",
+	)
+	require.NoError(t, err)
+	require.True(
+		t, decimal.RequireFromString("100").Equal(mkt.Price.GetQuotePrice()),
+	)
+	require.Zero(t, feeder.applied())
+}
+
+// listen makes the service listen to the given quote prices for the test
+// market, and returns once all of them are handled.
+func listen(svc *Service, info ports.PriceFeedInfo, prices ...string) {
+	ch := make(chan ports.PriceFeed)
+	done := make(chan struct{})
+	go func() {
+		svc.listenPriceFeed(info, ch)
+		close(done)
+	}()
+
+	for i, p := range prices {
+		ch <- newPriceUpdate(string(rune('a'+i)), p)
+	}
+	close(ch)
+	<-done
+}
+
+func newPriceUpdate(tickId, quotePrice string) priceUpdate {
+	qp := decimal.RequireFromString(quotePrice)
+	return priceUpdate{
+		tickId: tickId,
+		price:  price{decimal.NewFromInt(1).Div(qp), qp},
+	}
+}
+
+// newTestRepoManager returns a repo manager with a tradable market with a
+// pluggable strategy and the given quote price.
+func newTestRepoManager(t *testing.T, quotePrice string) ports.RepoManager {
+	mkt, err := domain.NewMarket(
+		baseAsset, quoteAsset, "market", 25, 25, 0, 0, 8, 8,
+		domain.StrategyTypePluggable,
+	)
+	require.NoError(t, err)
+	qp := decimal.RequireFromString(quotePrice)
+	err = mkt.ChangePrice(decimal.NewFromInt(1).Div(qp), qp)
+	require.NoError(t, err)
+	err = mkt.MakeTradable()
+	require.NoError(t, err)
+
+	repoManager := inmemory.NewRepoManager()
+	err = repoManager.MarketRepository().AddMarket(context.Background(), mkt)
+	require.NoError(t, err)
+	return repoManager
+}
+
+type mockFeeder struct {
+	ports.PriceFeeder
+
+	lock         sync.Mutex
+	appliedTicks []string
+}
+
+func (m *mockFeeder) AddAppliedPrice(
+	_ context.Context, _, tickId string, _ ports.MarketPrice,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.appliedTicks = append(m.appliedTicks, tickId)
+	return nil
+}
+
+func (m *mockFeeder) applied() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.appliedTicks)
+}
+
+type market struct{}
+
+func (market) GetBaseAsset() string  { return baseAsset }
+func (market) GetQuoteAsset() string { return quoteAsset }
+
+type priceFeed struct {
+	transformation ports.PriceTransformation
+}
+
+func (f priceFeed) GetId() string                                { return "feed" }
+func (f priceFeed) GetMarket() ports.Market                      { return market{} }
+func (f priceFeed) GetSource() string                            { return "source" }
+func (f priceFeed) GetTicker() string                            { return "ticker" }
+func (f priceFeed) IsStarted() bool                              { return true }
+func (f priceFeed) GetSources() []ports.PriceSource              { return nil }
+func (f priceFeed) GetAggregation() ports.PriceAggregation       { return nil }
+func (f priceFeed) GetLegs() []ports.PriceLeg                    { return nil }
+func (f priceFeed) GetLegsMaxAge() uint32                        { return 0 }
+func (f priceFeed) GetTransformation() ports.PriceTransformation { return f.transformation }
+func (f priceFeed) GetHealth() ports.PriceFeedHealth             { return nil }
+
+type transformation struct {
+	markupBps         int32
+	minUpdateInterval uint32
+	rounding          bool
+}
+
+func (t transformation) GetMarkupBps() int32          { return t.markupBps }
+func (t transformation) GetMinUpdateInterval() uint32 { return t.minUpdateInterval }
+func (t transformation) IsRoundingEnabled() bool      { return t.rounding }
+
+type price struct {
+	basePrice, quotePrice decimal.Decimal
+}
+
+func (p price) GetBasePrice() decimal.Decimal  { return p.basePrice }
+func (p price) GetQuotePrice() decimal.Decimal { return p.quotePrice }
+
+type priceUpdate struct {
+	tickId string
+	price  ports.MarketPrice
+}
+
+func (u priceUpdate) GetMarket() ports.Market     { return market{} }
+func (u priceUpdate) GetPrice() ports.MarketPrice { return u.price }
+func (u priceUpdate) GetTickId() string           { return u.tickId }
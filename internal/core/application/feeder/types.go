@@ -1,6 +1,10 @@
 package feeder
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 )
@@ -15,3 +19,91 @@ func (mp marketPrice) toDomain() domain.MarketPrice {
 		QuotePrice: mp.GetQuotePrice().String(),
 	}
 }
+
+var bpsDenominator = decimal.NewFromInt(10000)
+
+// transform applies the markup and the rounding of the given transformation
+// to the price, if any.
+func (mp marketPrice) transform(
+	t ports.PriceTransformation, market domain.Market,
+) domain.MarketPrice {
+	if t == nil {
+		return mp.toDomain()
+	}
+
+	basePrice, quotePrice := mp.GetBasePrice(), mp.GetQuotePrice()
+	if markup := t.GetMarkupBps(); markup != 0 {
+		quotePrice = quotePrice.Mul(
+			bpsDenominator.Add(decimal.NewFromInt32(markup)),
+		).Div(bpsDenominator)
+		basePrice = decimal.NewFromInt(1).Div(quotePrice).Round(8)
+	}
+	if t.IsRoundingEnabled() {
+		basePrice = basePrice.Round(int32(market.BaseAssetPrecision))
+		quotePrice = quotePrice.Round(int32(market.QuoteAssetPrecision))
+	}
+
+	return domain.MarketPrice{
+		BasePrice:  basePrice.String(),
+		QuotePrice: quotePrice.String(),
+	}
+}
+
+// untransformedQuotePrice returns the given market quote price without the
+// markup of the transformation, if any, to be compared with the prices
+// received by the price feed.
+func untransformedQuotePrice(
+	price domain.MarketPrice, t ports.PriceTransformation,
+) decimal.Decimal {
+	quotePrice := price.GetQuotePrice()
+	if t == nil || t.GetMarkupBps() == 0 {
+		return quotePrice
+	}
+	return quotePrice.Mul(bpsDenominator).Div(
+		bpsDenominator.Add(decimal.NewFromInt32(t.GetMarkupBps())),
+	)
+}
+
+// timeRangeToDates returns the bounds of the given time range. The start
+// time is zero for the whole history.
+func timeRangeToDates(tr ports.TimeRange) (startTime, endTime time.Time, err error) {
+	now := time.Now()
+	endTime = now
+
+	if p := tr.GetCustomPeriod(); p != nil {
+		startTime, err = time.Parse(time.RFC3339, p.GetStartDate())
+		if err != nil {
+			return
+		}
+		if p.GetEndDate() != "" {
+			endTime, err = time.Parse(time.RFC3339, p.GetEndDate())
+		}
+		return
+	}
+
+	p := tr.GetPredefinedPeriod()
+	if p == nil {
+		err = fmt.Errorf("missing predefined or custom period")
+		return
+	}
+	switch {
+	case p.IsLastHour():
+		startTime = now.Add(-time.Hour)
+	case p.IsLastDay():
+		startTime = now.AddDate(0, 0, -1)
+	case p.IsLastWeek():
+		startTime = now.AddDate(0, 0, -7)
+	case p.IsLastMonth():
+		startTime = now.AddDate(0, -1, 0)
+	case p.IsLastThreeMonths():
+		startTime = now.AddDate(0, -3, 0)
+	case p.IsYearToDate():
+		y, _, _ := now.Date()
+		startTime = time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case p.IsLastYear():
+		y, _, _ := now.Date()
+		startTime = time.Date(y-1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		endTime = time.Date(y-1, time.December, 31, 23, 59, 59, 0, time.UTC)
+	}
+	return
+}
@@ -0,0 +1,96 @@
+package feeder
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+func TestMarketPriceTransform(t *testing.T) {
+	tests := []struct {
+		name               string
+		quotePrice         string
+		transformation     ports.PriceTransformation
+		basePrecision      uint
+		quotePrecision     uint
+		expectedBasePrice  string
+		expectedQuotePrice string
+	}{
+		{
+			name:               "no transformation",
+			quotePrice:         "20000",
+			expectedBasePrice:  "0.00005",
+			expectedQuotePrice: "20000",
+		},
+		{
+			name:               "markup",
+			quotePrice:         "20000",
+			transformation:     transformation{markupBps: 150},
+			expectedBasePrice:  "0.00004926",
+			expectedQuotePrice: "20300",
+		},
+		{
+			name:               "markdown",
+			quotePrice:         "20000",
+			transformation:     transformation{markupBps: -250},
+			expectedBasePrice:  "0.00005128",
+			expectedQuotePrice: "19500",
+		},
+		{
+			name:               "rounding",
+			quotePrice:         "3.14159",
+			transformation:     transformation{rounding: true},
+			basePrecision:      4,
+			quotePrecision:     2,
+			expectedBasePrice:  "0.3183",
+			expectedQuotePrice: "3.14",
+		},
+		{
+			name:               "markup and rounding",
+			quotePrice:         "3.14159",
+			transformation:     transformation{markupBps: 100, rounding: true},
+			basePrecision:      4,
+			quotePrecision:     2,
+			expectedBasePrice:  "0.3152",
+			expectedQuotePrice: "3.17",
+		},
+		{
+			name:               "rounding down to zero",
+			quotePrice:         "20000",
+			transformation:     transformation{rounding: true},
+			basePrecision:      2,
+			quotePrecision:     0,
+			expectedBasePrice:  "0",
+			expectedQuotePrice: "20000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotePrice := decimal.RequireFromString(tt.quotePrice)
+			p := price{
+				basePrice:  decimal.NewFromInt(1).Div(quotePrice),
+				quotePrice: quotePrice,
+			}
+			mkt := domain.Market{
+				BaseAssetPrecision:  tt.basePrecision,
+				QuoteAssetPrecision: tt.quotePrecision,
+			}
+
+			got := marketPrice{p}.transform(tt.transformation, mkt)
+			require.True(
+				t, decimal.RequireFromString(tt.expectedBasePrice).Equal(
+					got.GetBasePrice(),
+				), "got base price %s", got.BasePrice,
+			)
+			require.True(
+				t, decimal.RequireFromString(tt.expectedQuotePrice).Equal(
+					got.GetQuotePrice(),
+				), "got quote price %s", got.QuotePrice,
+			)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package application
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-daemon/internal/core/application/operator"
@@ -59,6 +61,9 @@ type OperatorService interface {
 		ctx context.Context,
 		market ports.Market, baseAssetPrecision, quoteAssetPrecision int,
 	) error
+	UpdateMarketTradeExpiryTime(
+		ctx context.Context, market ports.Market, tradeExpiryTime int64,
+	) error
 	UpdateMarketPrice(
 		ctx context.Context,
 		market ports.Market, basePrice, quotePrice decimal.Decimal,
@@ -101,18 +106,65 @@ type OperatorService interface {
 
 	// List methods
 	ListMarkets(ctx context.Context) ([]ports.MarketInfo, error)
+	// The list methods below paginate by page number or, if the number is 0,
+	// by page token, in which case they also return the token of the next
+	// page, if any.
 	ListTradesForMarket(
 		ctx context.Context, market ports.Market, page ports.Page,
-	) ([]ports.Trade, error)
+	) ([]ports.Trade, string, error)
 	ListUtxos(
 		ctx context.Context, accountName string, page ports.Page,
-	) ([]ports.Utxo, []ports.Utxo, error)
+	) ([]ports.Utxo, []ports.Utxo, string, error)
+	// ListDeposits and ListWithdrawals also return, only with the first page,
+	// the total amount per asset of all the records matching the filter.
+	// Filters can't be combined with page numbers.
 	ListDeposits(
-		ctx context.Context, accountName string, page ports.Page,
-	) ([]ports.Deposit, error)
+		ctx context.Context, accountName string, filter ports.TxFilter,
+		page ports.Page,
+	) ([]ports.Deposit, map[string]uint64, string, error)
 	ListWithdrawals(
-		ctx context.Context, accountName string, page ports.Page,
-	) ([]ports.Withdrawal, error)
+		ctx context.Context, accountName string, filter ports.TxFilter,
+		page ports.Page,
+	) ([]ports.Withdrawal, map[string]uint64, string, error)
+
+	// Export
+	// ExportLedger calls fn for every trade, deposit and withdrawal within the
+	// time range, from the most recent, all read from the same snapshot of
+	// the stores.
+	ExportLedger(
+		ctx context.Context, timeRange ports.TimeRange,
+		fn func(entry ports.LedgerEntry) error,
+	) error
+
+	// Backup writes an encrypted snapshot of the daemon's stores and of the
+	// trade archive to w. Every store is snapshotted on its own, therefore the
+	// backup is consistent per store only.
+	Backup(ctx context.Context, password string, w io.Writer) error
+
+	// ArchiveTrades moves the trades that failed or expired and were proposed
+	// longer than the retention period ago from the trade repository to the
+	// archive. A zero retention defaults to the configured one. It returns the
+	// number of archived trades and the location of the archive.
+	ArchiveTrades(
+		ctx context.Context, retention time.Duration,
+	) (int, string, error)
+
+	// GetBalanceHistory returns the balances of the given account, or of all
+	// accounts if empty, periodically recorded within the given time range, or
+	// ever if nil, sorted from the most recent. The history can be paginated
+	// only by page token.
+	GetBalanceHistory(
+		ctx context.Context, accountName string, timeRange ports.TimeRange,
+		page ports.Page,
+	) ([]ports.BalanceSnapshot, string, error)
+
+	// GetDBStats returns the disk usage, the number of keys and the time of
+	// the last garbage collection of every store that supports them.
+	GetDBStats(ctx context.Context) ([]ports.StoreStats, error)
+	// RunDBGC reclaims the disk space left unused by the stores and, with
+	// compact, also compacts them. It returns the stats of the stores once
+	// done.
+	RunDBGC(ctx context.Context, compact bool) ([]ports.StoreStats, error)
 
 	// Webhook
 	AddWebhook(ctx context.Context, hook ports.Webhook) (string, error)
@@ -120,16 +172,22 @@ type OperatorService interface {
 	ListWebhooks(
 		ctx context.Context, event ports.WebhookEvent,
 	) ([]ports.WebhookInfo, error)
+
+	Close()
 }
 
 func NewOperatorService(
 	walletSvc WalletService, pubsubSvc PubSubService,
 	repoManager ports.RepoManager, feeAccountBalanceThreshold uint64,
-	satsPerByte decimal.Decimal,
+	satsPerByte decimal.Decimal, backupStores []ports.Backupable,
+	maintainableStores []ports.Maintainable, tradeArchive ports.TradeArchive,
+	tradeRetention, tradeArchiveInterval, balanceSnapshotInterval time.Duration,
 ) (OperatorService, error) {
 	w := walletSvc.(*wallet.Service)
 	p := pubsubSvc.(*pubsub.Service)
 	return operator.NewService(
-		w, p, repoManager, feeAccountBalanceThreshold, satsPerByte,
+		w, p, repoManager, feeAccountBalanceThreshold, satsPerByte, backupStores,
+		maintainableStores, tradeArchive, tradeRetention, tradeArchiveInterval,
+		balanceSnapshotInterval,
 	)
 }
@@ -0,0 +1,51 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/pkg/backup"
+)
+
+func (s *service) Backup(
+	ctx context.Context, password string, w io.Writer,
+) error {
+	ok, err := s.wallet.Wallet().Auth(ctx, password)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("invalid password")
+	}
+
+	bw, err := backup.NewWriter(w, []byte(password))
+	if err != nil {
+		return err
+	}
+
+	// Every store is snapshotted within a dedicated read transaction, one after
+	// the other, therefore the backup is consistent per store but not across
+	// stores: a trade or tx recorded meanwhile may be found only in some of
+	// them. Trades are not archived while backing up instead, so that they are
+	// found either in the trade store or in the archive.
+	s.archiveLock.Lock()
+	defer s.archiveLock.Unlock()
+
+	for _, store := range s.backupStores {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := store.Backup(bw); err != nil {
+			return err
+		}
+	}
+
+	if err := bw.Close(); err != nil {
+		return err
+	}
+
+	log.Info("backup completed")
+	return nil
+}
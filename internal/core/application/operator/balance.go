@@ -0,0 +1,110 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+func (s *service) GetBalanceHistory(
+	ctx context.Context, accountName string, timeRange ports.TimeRange,
+	page ports.Page,
+) ([]ports.BalanceSnapshot, string, error) {
+	if page != nil && !isTokenPage(page) {
+		return nil, "", fmt.Errorf(
+			"balance history can be paginated only with page tokens",
+		)
+	}
+
+	var startTime, endTime int64
+	if timeRange != nil {
+		start, end, err := timeRangeToDates(timeRange)
+		if err != nil {
+			return nil, "", err
+		}
+		startTime, endTime = start.Unix(), end.Unix()
+	}
+	cursor, limit, err := txPageCursor(page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	snapshots, err := s.repoManager.BalanceSnapshotRepository().
+		GetBalanceSnapshots(ctx, accountName, startTime, endTime, cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	var nextToken string
+	if page != nil && len(snapshots) > int(page.GetSize()) {
+		snapshots = snapshots[:page.GetSize()]
+		nextToken = encodePageToken(snapshots[len(snapshots)-1].Cursor())
+	}
+	return balanceSnapshotList(snapshots).toPortableList(), nextToken, nil
+}
+
+// snapshotBalances records the current balance of the fee account and of
+// every market. All snapshots share the same timestamp so that the balances
+// of the different accounts can be compared at any point of the history.
+func (s *service) snapshotBalances(ctx context.Context) error {
+	markets, err := s.repoManager.MarketRepository().GetAllMarkets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get markets: %s", err)
+	}
+	accounts := []string{domain.FeeAccount}
+	for _, market := range markets {
+		accounts = append(accounts, market.Name)
+	}
+
+	timestamp := time.Now().Unix()
+	snapshots := make([]domain.BalanceSnapshot, 0, len(accounts))
+	for _, account := range accounts {
+		balance, err := s.wallet.Account().GetBalance(ctx, account)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to get balance of account %s: %s", account, err,
+			)
+		}
+
+		balancePerAsset := make(map[string]domain.AssetBalance)
+		for asset, b := range balance {
+			if b == nil {
+				continue
+			}
+			balancePerAsset[asset] = domain.AssetBalance{
+				Confirmed:   b.GetConfirmedBalance(),
+				Unconfirmed: b.GetUnconfirmedBalance(),
+				Locked:      b.GetLockedBalance(),
+			}
+		}
+		snapshots = append(snapshots, domain.BalanceSnapshot{
+			AccountName:     account,
+			Timestamp:       timestamp,
+			BalancePerAsset: balancePerAsset,
+		})
+	}
+
+	_, err = s.repoManager.BalanceSnapshotRepository().AddBalanceSnapshots(
+		ctx, snapshots,
+	)
+	return err
+}
+
+func (s *service) scheduleBalanceSnapshots(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-ticker.C:
+			if err := s.snapshotBalances(context.Background()); err != nil {
+				log.WithError(err).Warn("failed to record balance snapshots")
+			}
+		}
+	}
+}
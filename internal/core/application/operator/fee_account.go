@@ -53,13 +53,9 @@ func (s *service) WithdrawFeeFunds(
 		return "", fmt.Errorf("invalid password")
 	}
 
-	txHex, err := s.wallet.Transaction().Transfer(
-		ctx, domain.FeeAccount, outs, millisatsPerByte,
-	)
-	if err != nil {
-		return "", err
-	}
-	return s.wallet.Transaction().BroadcastTransaction(ctx, txHex)
+	// The fee account utxos may be reserved for pending trades, hence the tx
+	// must be built by the daemon rather than the wallet.
+	return s.wallet.SendToMany(domain.FeeAccount, outs, millisatsPerByte)
 }
 
 func (s *service) accountExists(ctx context.Context, account string) bool {
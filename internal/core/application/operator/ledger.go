@@ -0,0 +1,170 @@
+package operator
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// ledgerPageSize is the number of trades, deposits or withdrawals read at a
+// time while exporting the ledger.
+const ledgerPageSize = 100
+
+func (s *service) ExportLedger(
+	ctx context.Context, timeRange ports.TimeRange,
+	fn func(entry ports.LedgerEntry) error,
+) error {
+	rangeStart, rangeEnd, err := timeRangeToDates(timeRange)
+	if err != nil {
+		return err
+	}
+	startTime, endTime := rangeStart.Unix(), rangeEnd.Unix()
+	if endTime <= 0 {
+		return nil
+	}
+	if startTime < 0 {
+		startTime = 0
+	}
+	filter := domain.TxFilter{StartTime: startTime, EndTime: endTime}
+
+	// Trades, deposits and withdrawals are read page by page within the same
+	// read transaction and merged from the most recent, so that the export is
+	// consistent without loading all of them in memory.
+	return s.repoManager.RunReadTransaction(ctx, func(ctx context.Context) error {
+		pagers := []*ledgerPager{
+			{fetch: func(
+				ctx context.Context, cursor *domain.Cursor,
+			) ([]ledgerEntryInfo, *domain.Cursor, error) {
+				trades, err := s.repoManager.TradeRepository().GetAllTradesAfter(
+					ctx, startTime, endTime, cursor, ledgerPageSize,
+				)
+				if err != nil || len(trades) < ledgerPageSize {
+					return tradeEntries(trades), nil, err
+				}
+				next := trades[len(trades)-1].Cursor()
+				return tradeEntries(trades), &next, nil
+			}},
+			{fetch: func(
+				ctx context.Context, cursor *domain.Cursor,
+			) ([]ledgerEntryInfo, *domain.Cursor, error) {
+				deposits, err := s.repoManager.DepositRepository().
+					GetAllDepositsAfter(ctx, filter, cursor, ledgerPageSize)
+				if err != nil || len(deposits) < ledgerPageSize {
+					return depositEntries(deposits), nil, err
+				}
+				next := deposits[len(deposits)-1].AllAccountsCursor()
+				return depositEntries(deposits), &next, nil
+			}},
+			{fetch: func(
+				ctx context.Context, cursor *domain.Cursor,
+			) ([]ledgerEntryInfo, *domain.Cursor, error) {
+				withdrawals, err := s.repoManager.WithdrawalRepository().
+					GetAllWithdrawalsAfter(ctx, filter, cursor, ledgerPageSize)
+				if err != nil || len(withdrawals) < ledgerPageSize {
+					return withdrawalEntries(withdrawals), nil, err
+				}
+				next := withdrawals[len(withdrawals)-1].AllAccountsCursor()
+				return withdrawalEntries(withdrawals), &next, nil
+			}},
+		}
+
+		for {
+			var latest *ledgerPager
+			for _, p := range pagers {
+				entry, err := p.peek(ctx)
+				if err != nil {
+					return err
+				}
+				if entry == nil {
+					continue
+				}
+				if latest == nil || entry.timestamp > latest.entries[0].timestamp {
+					latest = p
+				}
+			}
+			if latest == nil {
+				return nil
+			}
+			if err := fn(latest.pop()); err != nil {
+				return err
+			}
+		}
+	})
+}
+
+// ledgerPager reads the entries of one kind page by page. fetch returns the
+// entries of the page following the given cursor and the cursor of the next
+// one, nil if there are no more pages.
+type ledgerPager struct {
+	fetch func(
+		ctx context.Context, cursor *domain.Cursor,
+	) ([]ledgerEntryInfo, *domain.Cursor, error)
+	entries []ledgerEntryInfo
+	cursor  *domain.Cursor
+	done    bool
+}
+
+// peek returns the next entry without consuming it, or nil if there are no
+// more entries.
+func (p *ledgerPager) peek(ctx context.Context) (*ledgerEntryInfo, error) {
+	for len(p.entries) <= 0 && !p.done {
+		entries, cursor, err := p.fetch(ctx, p.cursor)
+		if err != nil {
+			return nil, err
+		}
+		p.entries, p.cursor, p.done = entries, cursor, cursor == nil
+	}
+	if len(p.entries) <= 0 {
+		return nil, nil
+	}
+	return &p.entries[0], nil
+}
+
+func (p *ledgerPager) pop() ledgerEntryInfo {
+	entry := p.entries[0]
+	p.entries = p.entries[1:]
+	return entry
+}
+
+func tradeEntries(trades []domain.Trade) []ledgerEntryInfo {
+	entries := make([]ledgerEntryInfo, 0, len(trades))
+	for _, trade := range trades {
+		if trade.SwapRequest == nil {
+			continue
+		}
+		entries = append(entries, ledgerEntryInfo{
+			entryType:   ledgerEntryTypeTrade,
+			accountName: trade.MarketName,
+			timestamp:   trade.SwapRequest.Timestamp,
+			trade:       tradeInfo{trade},
+		})
+	}
+	return entries
+}
+
+func depositEntries(deposits []domain.Deposit) []ledgerEntryInfo {
+	entries := make([]ledgerEntryInfo, 0, len(deposits))
+	for _, deposit := range deposits {
+		entries = append(entries, ledgerEntryInfo{
+			entryType:   ledgerEntryTypeDeposit,
+			accountName: deposit.AccountName,
+			timestamp:   deposit.Timestamp,
+			deposit:     depositInfo(deposit),
+		})
+	}
+	return entries
+}
+
+func withdrawalEntries(withdrawals []domain.Withdrawal) []ledgerEntryInfo {
+	entries := make([]ledgerEntryInfo, 0, len(withdrawals))
+	for _, withdrawal := range withdrawals {
+		entries = append(entries, ledgerEntryInfo{
+			entryType:   ledgerEntryTypeWithdrawal,
+			accountName: withdrawal.AccountName,
+			timestamp:   withdrawal.Timestamp,
+			withdrawal:  withdrawalInfo(withdrawal),
+		})
+	}
+	return entries
+}
@@ -0,0 +1,135 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestExportLedger(t *testing.T) {
+	ctx := context.Background()
+	repoManager := inmemory.NewRepoManager()
+	svc := &service{repoManager: repoManager}
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) int64 {
+		return start.Add(time.Duration(hours) * time.Hour).Unix()
+	}
+
+	trades := []*domain.Trade{
+		{Id: "t1", MarketName: "mkt", SwapRequest: &domain.Swap{Timestamp: at(3)}},
+		{Id: "t2", MarketName: "mkt", SwapRequest: &domain.Swap{Timestamp: at(-1)}},
+	}
+	for _, trade := range trades {
+		err := repoManager.TradeRepository().AddTrade(ctx, trade)
+		require.NoError(t, err)
+	}
+	_, err := repoManager.DepositRepository().AddDeposits(ctx, []domain.Deposit{
+		{AccountName: "mkt", TxID: "d1", Timestamp: at(1)},
+		{AccountName: domain.FeeAccount, TxID: "d2", Timestamp: at(5)},
+	})
+	require.NoError(t, err)
+	_, err = repoManager.WithdrawalRepository().AddWithdrawals(
+		ctx, []domain.Withdrawal{
+			{AccountName: "mkt", TxID: "w1", Timestamp: at(2)},
+			{AccountName: "mkt", TxID: "w2", Timestamp: at(30)},
+		},
+	)
+	require.NoError(t, err)
+
+	timeRange := customTimeRange{
+		start.Format(time.RFC3339), start.Add(24 * time.Hour).Format(time.RFC3339),
+	}
+	entries, err := exportLedger(ctx, svc, timeRange)
+	require.NoError(t, err)
+
+	// Entries out of the time range are skipped and the others are sorted from
+	// the most recent.
+	expected := []struct {
+		entryType   int
+		accountName string
+		timestamp   int64
+	}{
+		{ledgerEntryTypeDeposit, domain.FeeAccount, at(5)},
+		{ledgerEntryTypeTrade, "mkt", at(3)},
+		{ledgerEntryTypeWithdrawal, "mkt", at(2)},
+		{ledgerEntryTypeDeposit, "mkt", at(1)},
+	}
+	require.Len(t, entries, len(expected))
+	for i, entry := range entries {
+		require.Equal(t, expected[i].accountName, entry.GetAccountName())
+		require.Equal(t, expected[i].timestamp, entry.GetTimestamp())
+		switch expected[i].entryType {
+		case ledgerEntryTypeTrade:
+			require.True(t, entry.GetType().IsTrade())
+			require.NotNil(t, entry.GetTrade())
+		case ledgerEntryTypeDeposit:
+			require.True(t, entry.GetType().IsDeposit())
+			require.NotNil(t, entry.GetDeposit())
+		case ledgerEntryTypeWithdrawal:
+			require.True(t, entry.GetType().IsWithdrawal())
+			require.NotNil(t, entry.GetWithdrawal())
+		}
+	}
+
+	// Entries spanning several pages are all exported in order, including
+	// those of the same tx for different accounts.
+	deposits := make([]domain.Deposit, 0, 2*ledgerPageSize)
+	for i := 0; i < ledgerPageSize; i++ {
+		txid := fmt.Sprintf("tx%03d", i)
+		ts := at(6) + int64(i)
+		deposits = append(
+			deposits,
+			domain.Deposit{AccountName: "mkt", TxID: txid, Timestamp: ts},
+			domain.Deposit{AccountName: domain.FeeAccount, TxID: txid, Timestamp: ts},
+		)
+	}
+	_, err = repoManager.DepositRepository().AddDeposits(ctx, deposits)
+	require.NoError(t, err)
+
+	entries, err = exportLedger(ctx, svc, timeRange)
+	require.NoError(t, err)
+	require.Len(t, entries, len(expected)+len(deposits))
+	for i := 1; i < len(entries); i++ {
+		require.GreaterOrEqual(
+			t, entries[i-1].GetTimestamp(), entries[i].GetTimestamp(),
+		)
+	}
+
+	_, err = exportLedger(ctx, svc, customTimeRange{"not a date", ""})
+	require.Error(t, err)
+}
+
+func exportLedger(
+	ctx context.Context, svc *service, timeRange ports.TimeRange,
+) ([]ports.LedgerEntry, error) {
+	entries := make([]ports.LedgerEntry, 0)
+	err := svc.ExportLedger(ctx, timeRange, func(entry ports.LedgerEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	return entries, err
+}
+
+type customTimeRange struct {
+	startDate, endDate string
+}
+
+func (r customTimeRange) GetPredefinedPeriod() ports.PredefinedPeriod {
+	return nil
+}
+func (r customTimeRange) GetCustomPeriod() ports.CustomPeriod {
+	return r
+}
+func (r customTimeRange) GetStartDate() string {
+	return r.startDate
+}
+func (r customTimeRange) GetEndDate() string {
+	return r.endDate
+}
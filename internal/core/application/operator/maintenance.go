@@ -0,0 +1,46 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+func (s *service) GetDBStats(ctx context.Context) ([]ports.StoreStats, error) {
+	stats := make([]ports.StoreStats, 0)
+	for _, store := range s.maintainableStores {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		storeStats, err := store.StoreStats()
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, storeStats...)
+	}
+	return stats, nil
+}
+
+func (s *service) RunDBGC(
+	ctx context.Context, compact bool,
+) ([]ports.StoreStats, error) {
+	start := time.Now()
+	for _, store := range s.maintainableStores {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := store.RunGC(compact); err != nil {
+			return nil, err
+		}
+	}
+
+	mode := "garbage collection"
+	if compact {
+		mode = "compaction"
+	}
+	log.Infof("db %s completed in %s", mode, time.Since(start).Truncate(time.Millisecond))
+
+	return s.GetDBStats(ctx)
+}
@@ -352,6 +352,27 @@ func (s *service) UpdateMarketAssetsPrecision(
 	)
 }
 
+func (s *service) UpdateMarketTradeExpiryTime(
+	ctx context.Context, market ports.Market, tradeExpiryTime int64,
+) error {
+	mkt, err := s.repoManager.MarketRepository().GetMarketByAssets(
+		ctx, market.GetBaseAsset(), market.GetQuoteAsset(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := mkt.ChangeTradeExpiryTime(tradeExpiryTime); err != nil {
+		return err
+	}
+
+	return s.repoManager.MarketRepository().UpdateMarket(
+		ctx, mkt.Name, func(_ *domain.Market) (*domain.Market, error) {
+			return mkt, nil
+		},
+	)
+}
+
 func (s *service) UpdateMarketStrategy(
 	ctx context.Context, market ports.Market, strategyType int,
 ) error {
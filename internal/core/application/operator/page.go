@@ -0,0 +1,174 @@
+package operator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// isTokenPage returns whether the list must be paginated with tokens rather
+// than with page numbers.
+func isTokenPage(page ports.Page) bool {
+	return page != nil && page.GetNumber() <= 0
+}
+
+// isFirstPage returns whether the given page is the first one of the list,
+// which is also the case when the entire list is requested.
+func isFirstPage(page ports.Page) bool {
+	if page == nil {
+		return true
+	}
+	if isTokenPage(page) {
+		return page.GetToken() == ""
+	}
+	return page.GetNumber() == 1
+}
+
+// decodePageToken returns the cursor encoded in the given opaque token, or
+// nil for an empty token, meaning the first page.
+func decodePageToken(token string) (*domain.Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	parts := strings.SplitN(string(buf), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	return &domain.Cursor{Timestamp: timestamp, Id: parts[1]}, nil
+}
+
+func encodePageToken(cursor domain.Cursor) string {
+	return base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%d:%s", cursor.Timestamp, cursor.Id)),
+	)
+}
+
+// pageLimit returns the number of records to fetch for the given page. One
+// more than the page size is fetched to know whether there's a next page.
+func pageLimit(page ports.Page) int {
+	return int(page.GetSize()) + 1
+}
+
+// txPageCursor returns the cursor and the limit for listing the deposits,
+// withdrawals or balance snapshots of the given token page. A nil page lists
+// all of them.
+func txPageCursor(page ports.Page) (*domain.Cursor, int, error) {
+	if page == nil {
+		return nil, 0, nil
+	}
+	cursor, err := decodePageToken(page.GetToken())
+	if err != nil {
+		return nil, 0, err
+	}
+	return cursor, pageLimit(page), nil
+}
+
+// parseTxFilter converts the given filter for deposits or withdrawals into
+// the domain one. Filters can be combined only with page tokens because the
+// records matching them can't be counted to get the offset of a page number.
+func parseTxFilter(
+	filter ports.TxFilter, page ports.Page,
+) (domain.TxFilter, error) {
+	if filter == nil {
+		return domain.TxFilter{}, nil
+	}
+
+	txFilter := domain.TxFilter{
+		Asset: filter.GetAsset(),
+		TxID:  filter.GetTxid(),
+	}
+	if tr := filter.GetTimeRange(); tr != nil {
+		start, end, err := timeRangeToDates(tr)
+		if err != nil {
+			return domain.TxFilter{}, err
+		}
+		txFilter.StartTime, txFilter.EndTime = start.Unix(), end.Unix()
+	}
+	if !txFilter.IsEmpty() && page != nil && !isTokenPage(page) {
+		return domain.TxFilter{}, fmt.Errorf(
+			"filters can be used only with page tokens, not with page numbers",
+		)
+	}
+	return txFilter, nil
+}
+
+// utxoCursor returns the cursor identifying the given utxo, sorted by
+// confirmation time. Unconfirmed utxos are considered the most recent.
+func utxoCursor(u ports.Utxo) domain.Cursor {
+	timestamp := int64(math.MaxInt64)
+	if status := u.GetConfirmedStatus(); status != nil {
+		if info := status.GetBlockInfo(); info != nil && info.GetTimestamp() > 0 {
+			timestamp = info.GetTimestamp()
+		}
+	}
+	return domain.Cursor{
+		Timestamp: timestamp,
+		Id:        fmt.Sprintf("%s:%d", u.GetTxid(), u.GetIndex()),
+	}
+}
+
+// paginateUtxos returns the page of the given spendable and locked utxos
+// identified by the given token, along with the token of the next one.
+// Utxos are not stored by the daemon, therefore they're sorted and paginated
+// in memory.
+func paginateUtxos(
+	spendable, locked []ports.Utxo, page ports.Page,
+) ([]ports.Utxo, []ports.Utxo, string, error) {
+	cursor, err := decodePageToken(page.GetToken())
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	isLocked := make(map[domain.Cursor]bool)
+	utxos := make([]ports.Utxo, 0, len(spendable)+len(locked))
+	utxos = append(utxos, spendable...)
+	for _, u := range locked {
+		isLocked[utxoCursor(u)] = true
+		utxos = append(utxos, u)
+	}
+	sort.SliceStable(utxos, func(i, j int) bool {
+		c := utxoCursor(utxos[i])
+		return c.Precedes(utxoCursor(utxos[j]))
+	})
+
+	pageSpendable := make([]ports.Utxo, 0)
+	pageLocked := make([]ports.Utxo, 0)
+	count := 0
+	var last domain.Cursor
+	for _, u := range utxos {
+		c := utxoCursor(u)
+		if !cursor.Precedes(c) {
+			continue
+		}
+		count++
+		if count > int(page.GetSize()) {
+			break
+		}
+		last = c
+		if isLocked[c] {
+			pageLocked = append(pageLocked, u)
+		} else {
+			pageSpendable = append(pageSpendable, u)
+		}
+	}
+	var nextToken string
+	if count > int(page.GetSize()) {
+		nextToken = encodePageToken(last)
+	}
+	return pageSpendable, pageLocked, nextToken, nil
+}
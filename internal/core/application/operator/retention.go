@@ -0,0 +1,79 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// archiveBatchSize is the max number of trades archived and removed from the
+// trade repository at once.
+const archiveBatchSize = 500
+
+func (s *service) ArchiveTrades(
+	ctx context.Context, retention time.Duration,
+) (int, string, error) {
+	if s.tradeArchive == nil {
+		return -1, "", fmt.Errorf("trade archive is not configured")
+	}
+	if retention <= 0 {
+		retention = s.tradeRetention
+	}
+	if retention <= 0 {
+		return -1, "", fmt.Errorf("missing retention period")
+	}
+
+	s.archiveLock.Lock()
+	defer s.archiveLock.Unlock()
+
+	before := time.Now().Add(-retention).Unix()
+	repo := s.repoManager.TradeRepository()
+	count := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, s.tradeArchive.Location(), err
+		}
+
+		trades, err := repo.GetArchivableTrades(ctx, before, archiveBatchSize)
+		if err != nil {
+			return count, s.tradeArchive.Location(), err
+		}
+		if len(trades) <= 0 {
+			break
+		}
+
+		// Trades are removed only once durably archived.
+		if err := s.tradeArchive.Archive(trades); err != nil {
+			return count, s.tradeArchive.Location(), err
+		}
+		ids := make([]string, 0, len(trades))
+		for _, trade := range trades {
+			ids = append(ids, trade.Id)
+		}
+		if err := repo.DeleteTrades(ctx, ids); err != nil {
+			return count, s.tradeArchive.Location(), err
+		}
+		count += len(trades)
+	}
+
+	if count > 0 {
+		log.Infof(
+			"archived %d failed or expired trades to %s",
+			count, s.tradeArchive.Location(),
+		)
+	}
+	return count, s.tradeArchive.Location(), nil
+}
+
+func (s *service) scheduleTradesArchival(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if _, _, err := s.ArchiveTrades(context.Background(), 0); err != nil {
+			log.WithError(err).Warn("failed to archive trades")
+		}
+	}
+}
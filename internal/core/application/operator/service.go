@@ -36,12 +36,20 @@ type service struct {
 	network                    network.Network
 	accounts                   *accountMap
 	milliSatsPerByte           uint64
+	backupStores               []ports.Backupable
+	maintainableStores         []ports.Maintainable
+	tradeArchive               ports.TradeArchive
+	tradeRetention             time.Duration
+	archiveLock                *sync.Mutex
+	quit                       chan struct{}
 }
 
 func NewService(
 	walletSvc *wallet.Service, pubsubSvc *pubsub.Service,
 	repoManager ports.RepoManager, feeAccountBalanceThreshold uint64,
-	satsPerByte decimal.Decimal,
+	satsPerByte decimal.Decimal, backupStores []ports.Backupable,
+	maintainableStores []ports.Maintainable, tradeArchive ports.TradeArchive,
+	tradeRetention, tradeArchiveInterval, balanceSnapshotInterval time.Duration,
 ) (*service, error) {
 	if walletSvc == nil {
 		return nil, fmt.Errorf("missing wallet service")
@@ -73,11 +81,20 @@ func NewService(
 
 	svc := &service{
 		walletSvc, pubsubSvc, repoManager, feeAccountBalanceThreshold,
-		walletSvc.Network(), accounts, msatsPerByte,
+		walletSvc.Network(), accounts, msatsPerByte, backupStores,
+		maintainableStores, tradeArchive, tradeRetention, &sync.Mutex{},
+		make(chan struct{}),
 	}
 
 	svc.wallet.RegisterHandlerForTxEvent(svc.classifyAndStoreTx())
 	svc.wallet.RegisterHandlerForTxEvent(svc.checkAccountsLowBalance())
+
+	if tradeArchive != nil && tradeRetention > 0 && tradeArchiveInterval > 0 {
+		go svc.scheduleTradesArchival(tradeArchiveInterval)
+	}
+	if balanceSnapshotInterval > 0 {
+		go svc.scheduleBalanceSnapshots(balanceSnapshotInterval)
+	}
 	return svc, nil
 }
 
@@ -100,58 +117,159 @@ func (s *service) ListMarkets(ctx context.Context) ([]ports.MarketInfo, error) {
 
 func (s *service) ListTradesForMarket(
 	ctx context.Context, market ports.Market, page ports.Page,
-) ([]ports.Trade, error) {
+) ([]ports.Trade, string, error) {
 	if market == nil {
-		return nil, fmt.Errorf("missing market")
+		return nil, "", fmt.Errorf("missing market")
 	}
 
 	mkt, err := s.repoManager.MarketRepository().GetMarketByAssets(
 		ctx, market.GetBaseAsset(), market.GetQuoteAsset(),
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if mkt == nil {
-		return nil, fmt.Errorf("market not found")
+		return nil, "", fmt.Errorf("market not found")
 	}
 
-	trades, err := s.repoManager.TradeRepository().GetAllTradesByMarket(
-		ctx, mkt.Name, page,
+	if !isTokenPage(page) {
+		trades, err := s.repoManager.TradeRepository().GetAllTradesByMarket(
+			ctx, mkt.Name, page,
+		)
+		if err != nil {
+			return nil, "", err
+		}
+		return tradeList(trades).toPortableList(), "", nil
+	}
+
+	cursor, err := decodePageToken(page.GetToken())
+	if err != nil {
+		return nil, "", err
+	}
+	trades, err := s.repoManager.TradeRepository().GetTradesByMarketAfter(
+		ctx, mkt.Name, cursor, pageLimit(page),
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	var nextToken string
+	if len(trades) > int(page.GetSize()) {
+		trades = trades[:page.GetSize()]
+		nextToken = encodePageToken(trades[len(trades)-1].Cursor())
 	}
-	return tradeList(trades).toPortableList(), nil
+	return tradeList(trades).toPortableList(), nextToken, nil
 }
 
 func (s *service) ListUtxos(
 	ctx context.Context, accountName string, page ports.Page,
-) ([]ports.Utxo, []ports.Utxo, error) {
-	return s.wallet.Account().ListUtxos(ctx, accountName)
+) ([]ports.Utxo, []ports.Utxo, string, error) {
+	spendable, locked, err := s.wallet.Account().ListUtxos(ctx, accountName)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if !isTokenPage(page) {
+		return spendable, locked, "", nil
+	}
+	return paginateUtxos(spendable, locked, page)
 }
 
 func (s *service) ListDeposits(
-	ctx context.Context, accountName string, page ports.Page,
-) ([]ports.Deposit, error) {
-	deposits, err := s.repoManager.DepositRepository().GetDepositsForAccount(
-		ctx, accountName, page,
+	ctx context.Context, accountName string, filter ports.TxFilter,
+	page ports.Page,
+) ([]ports.Deposit, map[string]uint64, string, error) {
+	txFilter, err := parseTxFilter(filter, page)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	// Totals don't depend on the page, therefore they're computed only once for
+	// the first one.
+	repo := s.repoManager.DepositRepository()
+	var totals map[string]uint64
+	if isFirstPage(page) {
+		totals, err = repo.GetDepositsTotalAmountPerAsset(
+			ctx, accountName, txFilter,
+		)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+	if page != nil && !isTokenPage(page) {
+		deposits, err := repo.GetDepositsForAccount(ctx, accountName, page)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return depositList(deposits).toPortableList(), totals, "", nil
+	}
+
+	cursor, limit, err := txPageCursor(page)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	deposits, err := repo.GetDepositsForAccountAfter(
+		ctx, accountName, txFilter, cursor, limit,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
+	}
+	var nextToken string
+	if page != nil && len(deposits) > int(page.GetSize()) {
+		deposits = deposits[:page.GetSize()]
+		nextToken = encodePageToken(deposits[len(deposits)-1].Cursor())
 	}
-	return depositList(deposits).toPortableList(), nil
+	return depositList(deposits).toPortableList(), totals, nextToken, nil
 }
 
 func (s *service) ListWithdrawals(
-	ctx context.Context, accountName string, page ports.Page,
-) ([]ports.Withdrawal, error) {
-	withdrawals, err := s.repoManager.WithdrawalRepository().GetWithdrawalsForAccount(
-		ctx, accountName, page,
+	ctx context.Context, accountName string, filter ports.TxFilter,
+	page ports.Page,
+) ([]ports.Withdrawal, map[string]uint64, string, error) {
+	txFilter, err := parseTxFilter(filter, page)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	// Totals don't depend on the page, therefore they're computed only once for
+	// the first one.
+	repo := s.repoManager.WithdrawalRepository()
+	var totals map[string]uint64
+	if isFirstPage(page) {
+		totals, err = repo.GetWithdrawalsTotalAmountPerAsset(
+			ctx, accountName, txFilter,
+		)
+		if err != nil {
+			return nil, nil, "", err
+		}
+	}
+	if page != nil && !isTokenPage(page) {
+		withdrawals, err := repo.GetWithdrawalsForAccount(ctx, accountName, page)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return withdrawalList(withdrawals).toPortableList(), totals, "", nil
+	}
+
+	cursor, limit, err := txPageCursor(page)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	withdrawals, err := repo.GetWithdrawalsForAccountAfter(
+		ctx, accountName, txFilter, cursor, limit,
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
 	}
-	return withdrawalList(withdrawals).toPortableList(), nil
+	var nextToken string
+	if page != nil && len(withdrawals) > int(page.GetSize()) {
+		withdrawals = withdrawals[:page.GetSize()]
+		nextToken = encodePageToken(withdrawals[len(withdrawals)-1].Cursor())
+	}
+	return withdrawalList(withdrawals).toPortableList(), totals, nextToken, nil
+}
+
+// Close stops the periodic balance snapshots.
+func (s *service) Close() {
+	close(s.quit)
 }
 
 func (s *service) checkAccountsLowBalance() func(ports.WalletTxNotification) bool {
@@ -264,16 +382,16 @@ func (s *service) classifyAndStoreTx() func(ports.WalletTxNotification) bool {
 			}
 
 			if len(deposits) > 0 {
-				count, err := s.repoManager.DepositRepository().AddDeposits(ctx, deposits)
+				added, err := s.repoManager.DepositRepository().AddDeposits(ctx, deposits)
 				if err != nil {
 					log.WithError(err).Warn("failed to add deposit txs")
 				} else {
-					if count > 0 {
+					if count := len(added); count > 0 {
 						log.Debugf("added %d new deposit(s) for account %s", count, deposits[0].AccountName)
 
 						// Spawn go routine to publish event for account's deposit.
 						go func() {
-							for _, deposit := range deposits {
+							for _, deposit := range added {
 								accountName := deposit.AccountName
 								// Skip fragmenters account, we just don't want to publish
 								// events for these auxiliary accounts.
@@ -302,16 +420,16 @@ func (s *service) classifyAndStoreTx() func(ports.WalletTxNotification) bool {
 			}
 
 			if len(withdrawals) > 0 {
-				count, err := s.repoManager.WithdrawalRepository().AddWithdrawals(ctx, withdrawals)
+				added, err := s.repoManager.WithdrawalRepository().AddWithdrawals(ctx, withdrawals)
 				if err != nil {
 					log.WithError(err).Warn("failed to add withdrawal txs")
 				} else {
-					if count > 0 {
+					if count := len(added); count > 0 {
 						log.Debugf("added %d new withdrawal(s) for account %s", count, withdrawals[0].AccountName)
 
 						// Spawn go routine to publish event for account's withdrawal.
 						go func() {
-							for _, withdrawal := range withdrawals {
+							for _, withdrawal := range added {
 								accountName := withdrawal.AccountName
 								// Skip fragmenters account, we just don't want to publish
 								// events for these auxiliary accounts.
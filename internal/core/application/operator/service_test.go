@@ -0,0 +1,63 @@
+package operator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+)
+
+func TestListDepositsTotals(t *testing.T) {
+	ctx := context.Background()
+	repoManager := inmemory.NewRepoManager()
+	svc := &service{repoManager: repoManager}
+
+	_, err := repoManager.DepositRepository().AddDeposits(ctx, []domain.Deposit{
+		{
+			AccountName: "mkt", TxID: "d1", Timestamp: 1,
+			TotAmountPerAsset: map[string]uint64{"asset": 10},
+		},
+		{
+			AccountName: "mkt", TxID: "d2", Timestamp: 2,
+			TotAmountPerAsset: map[string]uint64{"asset": 20},
+		},
+	})
+	require.NoError(t, err)
+
+	// Totals are returned only with the first page.
+	deposits, totals, token, err := svc.ListDeposits(
+		ctx, "mkt", nil, tokenPage{size: 1},
+	)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, map[string]uint64{"asset": 30}, totals)
+	require.NotEmpty(t, token)
+
+	deposits, totals, _, err = svc.ListDeposits(
+		ctx, "mkt", nil, tokenPage{size: 1, token: token},
+	)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Nil(t, totals)
+
+	_, totals, _, err = svc.ListDeposits(ctx, "mkt", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"asset": 30}, totals)
+}
+
+type tokenPage struct {
+	size  int64
+	token string
+}
+
+func (p tokenPage) GetNumber() int64 {
+	return 0
+}
+func (p tokenPage) GetSize() int64 {
+	return p.size
+}
+func (p tokenPage) GetToken() string {
+	return p.token
+}
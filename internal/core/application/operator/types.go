@@ -61,6 +61,47 @@ func (l withdrawalList) toPortableList() []ports.Withdrawal {
 	return list
 }
 
+type assetBalanceInfo domain.AssetBalance
+
+func (i assetBalanceInfo) GetConfirmedBalance() uint64 {
+	return i.Confirmed
+}
+func (i assetBalanceInfo) GetUnconfirmedBalance() uint64 {
+	return i.Unconfirmed
+}
+func (i assetBalanceInfo) GetLockedBalance() uint64 {
+	return i.Locked
+}
+func (i assetBalanceInfo) GetTotalBalance() uint64 {
+	return i.Confirmed + i.Unconfirmed + i.Locked
+}
+
+type balanceSnapshotInfo domain.BalanceSnapshot
+
+func (i balanceSnapshotInfo) GetAccountName() string {
+	return i.AccountName
+}
+func (i balanceSnapshotInfo) GetTimestamp() int64 {
+	return i.Timestamp
+}
+func (i balanceSnapshotInfo) GetBalance() map[string]ports.Balance {
+	balance := make(map[string]ports.Balance)
+	for asset, b := range i.BalancePerAsset {
+		balance[asset] = assetBalanceInfo(b)
+	}
+	return balance
+}
+
+type balanceSnapshotList []domain.BalanceSnapshot
+
+func (l balanceSnapshotList) toPortableList() []ports.BalanceSnapshot {
+	list := make([]ports.BalanceSnapshot, 0, len(l))
+	for _, s := range l {
+		list = append(list, balanceSnapshotInfo(s))
+	}
+	return list
+}
+
 type marketFeeInfo domain.MarketFee
 
 func (i marketFeeInfo) GetBaseAsset() uint64 {
@@ -90,6 +131,9 @@ func (i marketInfo) GetBaseAssetPrecision() uint32 {
 func (i marketInfo) GetQuoteAssetPrecision() uint32 {
 	return uint32(i.Market.QuoteAssetPrecision)
 }
+func (i marketInfo) GetTradeExpiryTime() uint64 {
+	return i.Market.TradeExpiryTime
+}
 func (i marketInfo) GetPercentageFee() ports.MarketFee {
 	return marketFeeInfo(i.PercentageFee)
 }
@@ -468,3 +512,49 @@ func (m *accountMap) getLabel(namespace string) string {
 
 	return m.labelsByNamespace[namespace]
 }
+
+const (
+	ledgerEntryTypeTrade = iota + 1
+	ledgerEntryTypeDeposit
+	ledgerEntryTypeWithdrawal
+)
+
+type ledgerEntryTypeInfo int
+
+func (i ledgerEntryTypeInfo) IsTrade() bool {
+	return i == ledgerEntryTypeTrade
+}
+func (i ledgerEntryTypeInfo) IsDeposit() bool {
+	return i == ledgerEntryTypeDeposit
+}
+func (i ledgerEntryTypeInfo) IsWithdrawal() bool {
+	return i == ledgerEntryTypeWithdrawal
+}
+
+type ledgerEntryInfo struct {
+	entryType   ledgerEntryTypeInfo
+	accountName string
+	timestamp   int64
+	trade       ports.Trade
+	deposit     ports.Deposit
+	withdrawal  ports.Withdrawal
+}
+
+func (i ledgerEntryInfo) GetType() ports.LedgerEntryType {
+	return i.entryType
+}
+func (i ledgerEntryInfo) GetAccountName() string {
+	return i.accountName
+}
+func (i ledgerEntryInfo) GetTimestamp() int64 {
+	return i.timestamp
+}
+func (i ledgerEntryInfo) GetTrade() ports.Trade {
+	return i.trade
+}
+func (i ledgerEntryInfo) GetDeposit() ports.Deposit {
+	return i.deposit
+}
+func (i ledgerEntryInfo) GetWithdrawal() ports.Withdrawal {
+	return i.withdrawal
+}
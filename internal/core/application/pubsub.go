@@ -3,6 +3,8 @@ package application
 import (
 	"context"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/tdex-network/tdex-daemon/internal/core/application/pubsub"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
@@ -28,6 +30,13 @@ type PubSubService interface {
 		accountName string, accountBalance map[string]ports.Balance,
 		trade domain.Trade,
 	) error
+	PublishPriceFeedDeviationEvent(
+		priceFeedId string, market ports.Market,
+		lastPrice, rejectedPrice, deviation decimal.Decimal, marketClosed bool,
+	) error
+	// SubscribeToChangeFeed makes the service publish an event for every
+	// change made to trades and markets.
+	SubscribeToChangeFeed(feed ports.ChangeFeed)
 	Close()
 }
 
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 )
@@ -15,14 +17,24 @@ const (
 	eventAccountLowBalance = "ACCOUNT_LOW_BALANCE"
 	eventAccountWithdraw   = "ACCOUNT_WITHDRAW"
 	eventAccountDeposit    = "ACCOUNT_DEPOSIT"
+	eventTradeUpdated      = "TRADE_UPDATED"
+	eventMarketUpdated     = "MARKET_UPDATED"
+	eventPriceDeviation    = "PRICE_FEED_DEVIATION"
 )
 
 type Service struct {
-	pubsub ports.SecurePubSub
+	pubsub      ports.SecurePubSub
+	unsubscribe func()
 }
 
 func NewService(pubsub ports.SecurePubSub) *Service {
-	return &Service{pubsub}
+	return &Service{pubsub: pubsub}
+}
+
+// SubscribeToChangeFeed makes the service publish an event for every change
+// made to trades and markets, notified through the given feed.
+func (s *Service) SubscribeToChangeFeed(feed ports.ChangeFeed) {
+	s.unsubscribe = feed.Subscribe("pubsub", s.publishChange)
 }
 
 func (s *Service) SecurePubSub() ports.SecurePubSub {
@@ -161,7 +173,61 @@ func (s *Service) PublishTradeSettledEvent(
 	return nil
 }
 
+// PublishPriceFeedDeviationEvent notifies that an update of the given price
+// feed has been rejected because too distant from the last accepted price.
+func (s *Service) PublishPriceFeedDeviationEvent(
+	priceFeedId string, market ports.Market,
+	lastPrice, rejectedPrice, deviation decimal.Decimal, marketClosed bool,
+) error {
+	event := eventPriceDeviation
+	payload := map[string]interface{}{
+		"event":         event,
+		"price_feed_id": priceFeedId,
+		"market": map[string]string{
+			"base_asset":  market.GetBaseAsset(),
+			"quote_asset": market.GetQuoteAsset(),
+		},
+		"last_quote_price":     lastPrice.String(),
+		"rejected_quote_price": rejectedPrice.String(),
+		"deviation":            deviation.String(),
+		"market_closed":        marketClosed,
+		"timestamp":            time.Now().Unix(),
+	}
+	message, _ := json.Marshal(payload)
+	if err := s.pubsub.Publish(event, string(message)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Service) publishChange(change domain.ChangeEvent) {
+	var event string
+	payload := map[string]interface{}{
+		"change":    change.Type.String(),
+		"timestamp": change.Timestamp,
+	}
+	switch {
+	case change.Trade != nil:
+		event = eventTradeUpdated
+		payload["trade"] = getTradePayload(*change.Trade)
+	case change.Market != nil:
+		event = eventMarketUpdated
+		payload["market"] = getMarketInfoPayload(*change.Market)
+	default:
+		return
+	}
+	payload["event"] = event
+
+	message, _ := json.Marshal(payload)
+	if err := s.pubsub.Publish(event, string(message)); err != nil {
+		log.WithError(err).Warnf("failed to publish %s event", event)
+	}
+}
+
 func (s *Service) Close() {
+	if s.unsubscribe != nil {
+		s.unsubscribe()
+	}
 	s.pubsub.Store().Close()
 }
 
@@ -199,6 +265,15 @@ func (i webhookEventInfo) IsAccountWithdraw() bool {
 func (i webhookEventInfo) IsAccountDeposit() bool {
 	return i == eventAccountDeposit
 }
+func (i webhookEventInfo) IsTradeUpdated() bool {
+	return i == eventTradeUpdated
+}
+func (i webhookEventInfo) IsMarketUpdated() bool {
+	return i == eventMarketUpdated
+}
+func (i webhookEventInfo) IsPriceFeedDeviation() bool {
+	return i == eventPriceDeviation
+}
 func (i webhookEventInfo) IsAny() bool {
 	return i == ports.AnyTopic
 }
@@ -15,6 +15,12 @@ func topicForEvent(event ports.WebhookEvent) string {
 		return eventAccountWithdraw
 	case event.IsAccountDeposit():
 		return eventAccountDeposit
+	case event.IsTradeUpdated():
+		return eventTradeUpdated
+	case event.IsMarketUpdated():
+		return eventMarketUpdated
+	case event.IsPriceFeedDeviation():
+		return eventPriceDeviation
 	case event.IsAny():
 		return ports.AnyTopic
 	case event.IsUnspecified():
@@ -74,3 +80,75 @@ func getMarketPayload(trade domain.Trade) map[string]interface{} {
 		"quote_asset": trade.MarketQuoteAsset,
 	}
 }
+
+var tradeStatuses = map[int]string{
+	domain.TradeStatusCodeUndefined: "UNDEFINED",
+	domain.TradeStatusCodeProposal:  "PROPOSAL",
+	domain.TradeStatusCodeAccepted:  "ACCEPTED",
+	domain.TradeStatusCodeCompleted: "COMPLETED",
+	domain.TradeStatusCodeSettled:   "SETTLED",
+	domain.TradeStatusCodeExpired:   "EXPIRED",
+}
+
+func getTradePayload(trade domain.Trade) map[string]interface{} {
+	payload := map[string]interface{}{
+		"id": trade.Id,
+	}
+	// Deleted trades carry only their id.
+	if trade.MarketName == "" {
+		return payload
+	}
+
+	status, ok := tradeStatuses[trade.Status.Code]
+	if !ok {
+		status = "UNKNOWN"
+	}
+	payload["type"] = trade.Type.String()
+	payload["market"] = getMarketPayload(trade)
+	payload["status"] = status
+	payload["failed"] = trade.Status.Failed
+	payload["txid"] = trade.TxId
+	payload["expiry_timestamp"] = trade.ExpiryTime
+	payload["settlement_timestamp"] = trade.SettlementTime
+	if domain.SwapParserManager == nil {
+		return payload
+	}
+	if sr := trade.SwapRequestMessage(); sr != nil {
+		payload["swap"] = getSwapPayload(sr)
+	}
+	if fail := trade.SwapFailMessage(); fail != nil {
+		payload["fail"] = map[string]interface{}{
+			"code":    fail.GetFailureCode(),
+			"message": fail.GetFailureMessage(),
+		}
+	}
+	return payload
+}
+
+func getMarketInfoPayload(market domain.Market) map[string]interface{} {
+	payload := map[string]interface{}{
+		"name": market.Name,
+	}
+	// Deleted markets carry only their name.
+	if market.BaseAsset == "" {
+		return payload
+	}
+
+	payload["base_asset"] = market.BaseAsset
+	payload["quote_asset"] = market.QuoteAsset
+	payload["tradable"] = market.Tradable
+	payload["strategy_type"] = market.StrategyType
+	payload["price"] = map[string]string{
+		"base_price":  market.Price.BasePrice,
+		"quote_price": market.Price.QuotePrice,
+	}
+	payload["percentage_fee"] = map[string]uint64{
+		"base_asset":  market.PercentageFee.BaseAsset,
+		"quote_asset": market.PercentageFee.QuoteAsset,
+	}
+	payload["fixed_fee"] = map[string]uint64{
+		"base_asset":  market.FixedFee.BaseAsset,
+		"quote_asset": market.FixedFee.QuoteAsset,
+	}
+	return payload
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-daemon/internal/core/application/pubsub"
@@ -10,6 +11,10 @@ import (
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 )
 
+// ErrSwapRequestIdInUse is returned when proposing a trade whose swap request
+// reuses the id of a different one.
+var ErrSwapRequestIdInUse = trade.ErrSwapRequestIdInUse
+
 type TradeService interface {
 	GetTradableMarkets(ctx context.Context) ([]ports.MarketInfo, error)
 	GetMarketPrice(
@@ -23,6 +28,10 @@ type TradeService interface {
 		ctx context.Context, market ports.Market,
 		tradeType ports.TradeType, swapRequest ports.SwapRequest,
 	) (ports.SwapAccept, ports.SwapFail, int64, error)
+	TradeProposeDryRun(
+		ctx context.Context, market ports.Market,
+		tradeType ports.TradeType, swapRequest ports.SwapRequest,
+	) (ports.TradeDryRun, error)
 	TradeComplete(
 		ctx context.Context,
 		swapComplete ports.SwapComplete, swapFail ports.SwapFail,
@@ -35,9 +44,15 @@ type TradeService interface {
 func NewTradeService(
 	walletSvc WalletService, pubsubSvc PubSubService,
 	repoManager ports.RepoManager,
-	priceSlippage, satsPerByte decimal.Decimal,
+	priceSlippage, satsPerByte decimal.Decimal, tradeExpiryTime uint64,
+	proposeRateLimit, completeRateLimit int, rateLimitInterval time.Duration,
+	maxPendingTradesPerMarket int,
 ) (TradeService, error) {
 	w := walletSvc.(*wallet.Service)
 	p := pubsubSvc.(*pubsub.Service)
-	return trade.NewService(w, p, repoManager, priceSlippage, satsPerByte)
+	return trade.NewService(
+		w, p, repoManager, priceSlippage, satsPerByte, tradeExpiryTime,
+		proposeRateLimit, completeRateLimit, rateLimitInterval,
+		maxPendingTradesPerMarket,
+	)
 }
@@ -0,0 +1,97 @@
+package trade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/psetv2"
+)
+
+// reservePendingTrade reserves a slot for a new trade of the given market,
+// identified by its swap request id. It returns false if the max number of
+// pending trades for the market has been reached.
+// The slot must be either confirmed with addPendingTrade once the trade is
+// accepted, or released with removePendingTrade otherwise.
+func (s *Service) reservePendingTrade(marketName, swapRequestId string) bool {
+	if s.maxPendingTradesPerMarket <= 0 {
+		return true
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	trades, ok := s.pendingTrades[marketName]
+	if !ok {
+		trades = make(map[string]int64)
+		s.pendingTrades[marketName] = trades
+	}
+
+	now := time.Now().Unix()
+	for id, expiryTime := range trades {
+		if expiryTime > 0 && expiryTime <= now {
+			delete(trades, id)
+		}
+	}
+
+	if len(trades) >= s.maxPendingTradesPerMarket {
+		return false
+	}
+	// A zero expiry identifies a trade not yet accepted.
+	trades[swapRequestId] = 0
+	return true
+}
+
+func (s *Service) addPendingTrade(
+	marketName, swapRequestId string, expiryTime int64,
+) {
+	if s.maxPendingTradesPerMarket <= 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.pendingTrades[marketName]; !ok {
+		s.pendingTrades[marketName] = make(map[string]int64)
+	}
+	s.pendingTrades[marketName][swapRequestId] = expiryTime
+}
+
+func (s *Service) removePendingTrade(marketName, swapRequestId string) {
+	if s.maxPendingTradesPerMarket <= 0 {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if trades, ok := s.pendingTrades[marketName]; ok {
+		delete(trades, swapRequestId)
+	}
+}
+
+// traderKeys returns the outpoints spent by the given swap transaction, used
+// to identify the trader for rate limiting purposes.
+func traderKeys(tx string) []string {
+	ptx, err := psetv2.NewPsetFromBase64(tx)
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(ptx.Inputs))
+	for _, in := range ptx.Inputs {
+		keys = append(keys, fmt.Sprintf(
+			"%s:%d", elementsutil.TxIDFromBytes(in.PreviousTxid),
+			in.PreviousTxIndex,
+		))
+	}
+	return keys
+}
+
+func newSwapFail(messageId string, errCode int) ports.SwapFail {
+	_, msg := domain.SwapParserManager.SerializeFail(messageId, errCode)
+	return domain.SwapParserManager.DeserializeFail(msg)
+}
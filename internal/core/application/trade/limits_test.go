@@ -0,0 +1,128 @@
+package trade
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	swap_parser "github.com/tdex-network/tdex-daemon/internal/infrastructure/swap-parser"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
+)
+
+func TestPendingTrades(t *testing.T) {
+	newService := func(maxPendingTrades int) *Service {
+		return &Service{
+			lock:                      &sync.Mutex{},
+			pendingTrades:             make(map[string]map[string]int64),
+			maxPendingTradesPerMarket: maxPendingTrades,
+		}
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		svc := newService(0)
+		for _, id := range []string{"a", "b", "c"} {
+			require.True(t, svc.reservePendingTrade("mkt", id))
+			svc.addPendingTrade("mkt", id, time.Now().Add(time.Minute).Unix())
+		}
+		require.Empty(t, svc.pendingTrades)
+	})
+
+	t.Run("limit per market", func(t *testing.T) {
+		svc := newService(2)
+		require.True(t, svc.reservePendingTrade("mkt", "a"))
+		require.True(t, svc.reservePendingTrade("mkt", "b"))
+		require.False(t, svc.reservePendingTrade("mkt", "c"))
+
+		// Other markets have their own limit.
+		require.True(t, svc.reservePendingTrade("other", "c"))
+	})
+
+	t.Run("release on removal", func(t *testing.T) {
+		svc := newService(1)
+		require.True(t, svc.reservePendingTrade("mkt", "a"))
+		svc.addPendingTrade("mkt", "a", time.Now().Add(time.Minute).Unix())
+		require.False(t, svc.reservePendingTrade("mkt", "b"))
+
+		svc.removePendingTrade("mkt", "a")
+		require.True(t, svc.reservePendingTrade("mkt", "b"))
+	})
+
+	t.Run("release on expiry", func(t *testing.T) {
+		svc := newService(1)
+		require.True(t, svc.reservePendingTrade("mkt", "a"))
+		svc.addPendingTrade("mkt", "a", time.Now().Add(-time.Second).Unix())
+		require.True(t, svc.reservePendingTrade("mkt", "b"))
+	})
+
+	t.Run("reserved slot never expires", func(t *testing.T) {
+		svc := newService(1)
+		require.True(t, svc.reservePendingTrade("mkt", "a"))
+		require.False(t, svc.reservePendingTrade("mkt", "b"))
+	})
+}
+
+func TestTradeProposePendingTradesLimit(t *testing.T) {
+	domain.SwapParserManager = swapParser{swap_parser.NewService()}
+	ctx := context.Background()
+
+	svc, _ := newTestService(t, networkFee, true)
+	svc.maxPendingTradesPerMarket = 1
+
+	propose := func(id string) (string, ports.SwapFail) {
+		swapRequest := newTestSwapRequest(t, svc, 30000)
+		swapRequest.id = id
+		accept, fail, _, err := svc.TradePropose(
+			ctx, market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		if fail != nil {
+			require.Nil(t, accept)
+			return "", fail
+		}
+		require.NotNil(t, accept)
+
+		trade, err := svc.repoManager.TradeRepository().GetTradeBySwapRequestId(
+			ctx, marketAccount, id,
+		)
+		require.NoError(t, err)
+		return trade.SwapAccept.Id, nil
+	}
+
+	acceptId, fail := propose("swap-1")
+	require.Nil(t, fail)
+
+	// The market has reached the max number of pending trades.
+	_, fail = propose("swap-2")
+	require.NotNil(t, fail)
+	require.Equal(
+		t, uint32(pkgswap.ErrCodeTooManyPendingTrades), fail.GetFailureCode(),
+	)
+	trade, err := svc.repoManager.TradeRepository().GetTradeBySwapRequestId(
+		ctx, marketAccount, "swap-2",
+	)
+	require.NoError(t, err)
+	require.Nil(t, trade)
+
+	// Completing the trade releases its slot.
+	_, fail2, err := svc.TradeComplete(ctx, &domain.SwapComplete{
+		Id: "complete-1", AcceptId: acceptId,
+	}, nil)
+	require.NoError(t, err)
+	require.Nil(t, fail2)
+
+	acceptId, fail = propose("swap-2")
+	require.Nil(t, fail)
+
+	// Aborting the trade releases its slot too.
+	_, _, err = svc.TradeComplete(ctx, nil, &domain.SwapFail{
+		Id: "fail-2", MessageId: acceptId,
+	})
+	require.NoError(t, err)
+
+	_, fail = propose("swap-3")
+	require.Nil(t, fail)
+}
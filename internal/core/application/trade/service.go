@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 	log "github.com/sirupsen/logrus"
@@ -11,6 +13,7 @@ import (
 	"github.com/tdex-network/tdex-daemon/internal/core/application/wallet"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/pkg/ratelimiter"
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/psetv2"
 )
@@ -18,6 +21,9 @@ import (
 var (
 	ErrServiceUnavailable = fmt.Errorf("service is unavailable, retry later")
 	ErrMarketUnavailable  = fmt.Errorf("market is closed, retry later")
+	ErrSwapRequestIdInUse = fmt.Errorf(
+		"swap request id already used for a different swap request",
+	)
 
 	minSatsPerByte = decimal.NewFromFloat(0.1)
 	maxSatsPerByte = decimal.NewFromInt(10000)
@@ -28,15 +34,33 @@ type Service struct {
 	pubsub      *pubsub.Service
 	repoManager ports.RepoManager
 
-	priceSlippage    decimal.Decimal
-	milliSatsPerByte uint64
+	priceSlippage          decimal.Decimal
+	milliSatsPerByte       uint64
+	defaultTradeExpiryTime uint64
+
+	// pendingSwapRequests keeps track of the swap requests currently being
+	// processed to prevent handling the same one multiple times concurrently.
+	pendingSwapRequests map[string]struct{}
+	lock                *sync.Mutex
+
+	// proposeLimiter and completeLimiter limit the number of trade proposals
+	// and completions per trader, identified by the outpoints it spends.
+	proposeLimiter  *ratelimiter.RateLimiter
+	completeLimiter *ratelimiter.RateLimiter
+	// pendingTrades keeps track of the accepted but not yet completed trades
+	// for every market, to limit them to maxPendingTradesPerMarket.
+	// Trades are identified by swap request id and mapped to their expiry.
+	pendingTrades             map[string]map[string]int64
+	maxPendingTradesPerMarket int
 }
 
 func NewService(
 	walletSvc *wallet.Service,
 	pubsubSvc *pubsub.Service,
 	repoManager ports.RepoManager,
-	priceSlippage, satsPerByte decimal.Decimal,
+	priceSlippage, satsPerByte decimal.Decimal, tradeExpiryTime uint64,
+	proposeRateLimit, completeRateLimit int, rateLimitInterval time.Duration,
+	maxPendingTradesPerMarket int,
 ) (*Service, error) {
 	if walletSvc == nil {
 		return nil, fmt.Errorf("missing wallet service")
@@ -54,10 +78,34 @@ func NewService(
 			minSatsPerByte, maxSatsPerByte,
 		)
 	}
+	if tradeExpiryTime < domain.MinTradeExpiryTime ||
+		tradeExpiryTime > domain.MaxTradeExpiryTime {
+		log.Warnf(
+			"trade expiry time %d out of range [%d, %d], using default %d",
+			tradeExpiryTime, domain.MinTradeExpiryTime, domain.MaxTradeExpiryTime,
+			domain.DefaultTradeExpiryTime,
+		)
+		tradeExpiryTime = domain.DefaultTradeExpiryTime
+	}
+	if proposeRateLimit < 0 || completeRateLimit < 0 {
+		return nil, fmt.Errorf("trade rate limits must not be negative")
+	}
+	if (proposeRateLimit > 0 || completeRateLimit > 0) && rateLimitInterval <= 0 {
+		return nil, fmt.Errorf("trade rate limit interval must be positive")
+	}
+	if maxPendingTradesPerMarket < 0 {
+		return nil, fmt.Errorf(
+			"max number of pending trades per market must not be negative",
+		)
+	}
 	msatsPerByte := satsPerByte.Mul(decimal.NewFromInt(1000)).BigInt().Uint64()
 
 	svc := &Service{
 		walletSvc, pubsubSvc, repoManager, priceSlippage, msatsPerByte,
+		tradeExpiryTime, make(map[string]struct{}), &sync.Mutex{},
+		ratelimiter.NewRateLimiter(proposeRateLimit, rateLimitInterval),
+		ratelimiter.NewRateLimiter(completeRateLimit, rateLimitInterval),
+		make(map[string]map[string]int64), maxPendingTradesPerMarket,
 	}
 
 	go svc.checkForPendingTrades()
@@ -144,6 +192,12 @@ func (s *Service) checkForPendingTrades() {
 				continue
 			}
 
+			if trade.IsAccepted() && !trade.IsRejected() {
+				s.addPendingTrade(
+					trade.MarketName, trade.SwapRequest.Id, trade.ExpiryTime,
+				)
+			}
+
 			pset, _ := psetv2.NewPsetFromBase64(
 				trade.SwapAcceptMessage().Transaction,
 			)
@@ -152,7 +206,12 @@ func (s *Service) checkForPendingTrades() {
 				prevTxid := elementsutil.TxIDFromBytes(in.PreviousTxid)
 				tradeUtxos = append(tradeUtxos, utxo{prevTxid, in.PreviousTxIndex})
 			}
-			s.makeTradeSettledOrExpired(trade.Id, tradeUtxos)
+			// Utxo reservations don't survive restarts, they're restored here.
+			s.wallet.LockUtxos(tradeUtxos, trade.ExpiryTime)
+			s.wallet.RegisterHandlerForUtxoEvent(
+				s.makeTradeSettledOrExpired(trade.Id, tradeUtxos),
+			)
+			s.scheduleTradeExpiry(trade.Id, tradeUtxos, trade.ExpiryTime)
 		}
 	}
 
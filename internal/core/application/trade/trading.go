@@ -62,6 +62,57 @@ func (s *Service) TradePropose(
 		return nil, nil, -1, ErrServiceUnavailable
 	}
 
+	// Make sure the same swap request is not processed more than once, for
+	// example in case the trader retries after a network timeout.
+	// If the swap request has been already accepted or rejected, the stored
+	// response is returned instead of locking new utxos for a new trade, as
+	// long as the retry has the same content of the stored swap request.
+	if !s.addPendingSwapRequest(swapRequest.GetId()) {
+		return nil, nil, -1, ErrServiceUnavailable
+	}
+	defer s.removePendingSwapRequest(swapRequest.GetId())
+
+	prevTrade, err := s.repoManager.TradeRepository().GetTradeBySwapRequestId(
+		ctx, mkt.Name, swapRequest.GetId(),
+	)
+	if err != nil {
+		log.WithError(err).Debug("failed to fetch trade by swap request id")
+		return nil, nil, -1, ErrServiceUnavailable
+	}
+	if prevTrade != nil {
+		isPending := prevTrade.SwapAccept != nil && !prevTrade.IsExpired()
+		if (prevTrade.IsRejected() || isPending) &&
+			!isSameSwapRequest(prevTrade, swapRequest) {
+			return nil, nil, -1, ErrSwapRequestIdInUse
+		}
+		if prevTrade.IsRejected() {
+			return nil, prevTrade.SwapFailMessage(), -1, nil
+		}
+		if isPending {
+			return swapAcceptInfo{prevTrade.SwapAcceptMessage()}, nil,
+				prevTrade.ExpiryTime, nil
+		}
+	}
+
+	// Rejections because of rate limiting are not stored, the trader is free
+	// to retry with the same swap request later.
+	if !s.proposeLimiter.Allow(traderKeys(swapRequest.GetTransaction())...) {
+		return nil, newSwapFail(
+			swapRequest.GetId(), pkgswap.ErrCodeTooManyRequests,
+		), -1, nil
+	}
+	if !s.reservePendingTrade(mkt.Name, swapRequest.GetId()) {
+		return nil, newSwapFail(
+			swapRequest.GetId(), pkgswap.ErrCodeTooManyPendingTrades,
+		), -1, nil
+	}
+	accepted := false
+	defer func() {
+		if !accepted {
+			s.removePendingTrade(mkt.Name, swapRequest.GetId())
+		}
+	}()
+
 	trade := domain.NewTrade()
 
 	defer func() {
@@ -77,6 +128,14 @@ func (s *Service) TradePropose(
 	feesToAdd := tradeType.IsBuy() && feeAsset == mkt.QuoteAsset ||
 		tradeType.IsSell() && feeAsset == mkt.BaseAsset
 
+	if ok, _ := trade.Propose(
+		tradeTypeInfo{tradeType}.toDomain(), swapRequestInfo{swapRequest}.toDomain(),
+		mkt.Name, mkt.BaseAsset, mkt.QuoteAsset,
+		mkt.PercentageFee, mkt.FixedFee, nil,
+	); !ok {
+		return nil, trade.SwapFailMessage(), -1, nil
+	}
+
 	if !isValidTradePrice(
 		*mkt, balance, tradeType, swapRequest, s.priceSlippage,
 	) {
@@ -86,16 +145,12 @@ func (s *Service) TradePropose(
 		return nil, trade.SwapFailMessage(), -1, nil
 	}
 
-	if ok, _ := trade.Propose(
-		tradeTypeInfo{tradeType}.toDomain(), swapRequestInfo{swapRequest}.toDomain(),
-		mkt.Name, mkt.BaseAsset, mkt.QuoteAsset,
-		mkt.PercentageFee, mkt.FixedFee, nil,
-	); !ok {
-		return nil, trade.SwapFailMessage(), -1, nil
+	tradeExpiryTime := mkt.TradeExpiryTime
+	if tradeExpiryTime == 0 {
+		tradeExpiryTime = s.defaultTradeExpiryTime
 	}
-
-	signedPset, selectedUtxos, tradeExpiryTime, err := s.wallet.CompleteSwap(
-		mkt.Name, swapRequest, s.milliSatsPerByte, feesToAdd,
+	signedPset, selectedUtxos, tradeExpiryDate, err := s.wallet.CompleteSwap(
+		mkt.Name, swapRequest, s.milliSatsPerByte, feesToAdd, tradeExpiryTime,
 	)
 	if err != nil {
 		log.WithError(err).Warn("failed to complete swap request")
@@ -112,18 +167,89 @@ func (s *Service) TradePropose(
 			AmountBlinder: u.GetValueBlinder(),
 		})
 	}
-	if ok, _ := trade.Accept(signedPset, unblindedIns, tradeExpiryTime); !ok {
+	if ok, _ := trade.Accept(signedPset, unblindedIns, tradeExpiryDate); !ok {
 		return nil, trade.SwapFailMessage(), -1, nil
 	}
+	accepted = true
+	s.addPendingTrade(mkt.Name, swapRequest.GetId(), trade.ExpiryTime)
 
 	s.wallet.RegisterHandlerForUtxoEvent(
 		s.makeTradeSettledOrExpired(trade.Id, selectedUtxos),
 	)
+	s.scheduleTradeExpiry(trade.Id, selectedUtxos, trade.ExpiryTime)
 
 	return swapAcceptInfo{trade.SwapAcceptMessage()}, nil,
 		trade.ExpiryTime, nil
 }
 
+// TradeProposeDryRun validates the given swap request like TradePropose
+// does, without signing the swap transaction nor locking any utxo,
+// and without storing any trade.
+func (s *Service) TradeProposeDryRun(
+	ctx context.Context, market ports.Market,
+	tradeType ports.TradeType, swapRequest ports.SwapRequest,
+) (ports.TradeDryRun, error) {
+	mkt, err := s.repoManager.MarketRepository().GetMarketByAssets(
+		ctx, market.GetBaseAsset(), market.GetQuoteAsset(),
+	)
+	if err != nil {
+		log.WithError(err).Debug("failed to fetch market")
+		return nil, ErrServiceUnavailable
+	}
+
+	if !mkt.IsTradable() {
+		return tradeDryRunInfo{reason: ErrMarketUnavailable.Error()}, nil
+	}
+
+	balance, err := s.wallet.Account().GetBalance(ctx, mkt.Name)
+	if err != nil {
+		log.WithError(err).Warn("failed to fetch market balance")
+		return nil, ErrServiceUnavailable
+	}
+
+	feeAsset := swapRequest.GetFeeAsset()
+	feesToAdd := tradeType.IsBuy() && feeAsset == mkt.QuoteAsset ||
+		tradeType.IsSell() && feeAsset == mkt.BaseAsset
+
+	trade := domain.NewTrade()
+	if ok, _ := trade.Propose(
+		tradeTypeInfo{tradeType}.toDomain(), swapRequestInfo{swapRequest}.toDomain(),
+		mkt.Name, mkt.BaseAsset, mkt.QuoteAsset,
+		mkt.PercentageFee, mkt.FixedFee, nil,
+	); !ok {
+		fail := trade.SwapFailMessage()
+		reason := fail.GetFailureMessage()
+		if err := validateSwapRequest(swapRequest); err != nil {
+			reason = err.Error()
+		}
+		return tradeDryRunInfo{reason: reason, swapFail: fail}, nil
+	}
+
+	if !isValidTradePrice(
+		*mkt, balance, tradeType, swapRequest, s.priceSlippage,
+	) {
+		fail := newSwapFail(
+			swapRequest.GetId(), pkgswap.ErrCodeBadPricingSwapRequest,
+		)
+		return tradeDryRunInfo{
+			reason: fail.GetFailureMessage(), swapFail: fail,
+		}, nil
+	}
+
+	networkFeeAmount, err := s.wallet.CompleteSwapDryRun(
+		mkt.Name, swapRequest, s.milliSatsPerByte, feesToAdd,
+	)
+	if err != nil {
+		return tradeDryRunInfo{
+			reason: fmt.Sprintf("failed to complete swap request: %s", err),
+		}, nil
+	}
+
+	return tradeDryRunInfo{
+		accepted: true, networkFeeAmount: networkFeeAmount,
+	}, nil
+}
+
 func (s *Service) TradeComplete(
 	ctx context.Context,
 	swapComplete ports.SwapComplete, swapFail ports.SwapFail,
@@ -141,6 +267,12 @@ func (s *Service) TradeComplete(
 	if trade.IsExpired() {
 		return "", nil, fmt.Errorf("trade is expired")
 	}
+	swapRequest := trade.SwapRequestMessage()
+	if !s.completeLimiter.Allow(traderKeys(swapRequest.GetTransaction())...) {
+		return "", newSwapFail(
+			swapComplete.GetId(), pkgswap.ErrCodeTooManyRequests,
+		), nil
+	}
 
 	defer func() {
 		if _err := s.repoManager.TradeRepository().UpdateTrade(
@@ -155,6 +287,7 @@ func (s *Service) TradeComplete(
 	}()
 
 	ok, _ := trade.Complete(swapComplete.GetTransaction())
+	s.removePendingTrade(trade.MarketName, trade.SwapRequest.Id)
 	if !ok {
 		return "", trade.SwapFailMessage(), nil
 	}
@@ -189,6 +322,7 @@ func (s *Service) tradeFail(
 	trade.Fail(
 		swapAcceptId, pkgswap.ErrCodeAborted,
 	)
+	s.removePendingTrade(trade.MarketName, trade.SwapRequest.Id)
 
 	if err := s.repoManager.TradeRepository().UpdateTrade(
 		ctx, trade.Id, func(_ *domain.Trade) (*domain.Trade, error) {
@@ -243,9 +377,14 @@ func (s *Service) makeTradeSettledOrExpired(
 			trade.Settle(settlementTimestamp)
 			tradeStatus = "settled"
 		} else if eventType.IsUnlocked() {
-			//nolint
-			trade.Expire()
+			// The wallet lock may be shorter than the trade expiry time, in which
+			// case the trade is expired by scheduleTradeExpiry.
+			if ok, _ := trade.Expire(); !ok {
+				return false
+			}
 			tradeStatus = "expired"
+		} else {
+			return false
 		}
 
 		//nolint
@@ -255,6 +394,7 @@ func (s *Service) makeTradeSettledOrExpired(
 			},
 		)
 		log.Debugf("trade with id %s %s", trade.Id, tradeStatus)
+		s.wallet.UnlockUtxos(tradeUtxos)
 
 		if trade.IsSettled() {
 			go func() {
@@ -279,3 +419,64 @@ func (s *Service) makeTradeSettledOrExpired(
 		return true
 	}
 }
+
+// scheduleTradeExpiry expires the given trade once its expiry time is reached,
+// if still waiting to be completed, and releases the utxos reserved for it.
+// The utxos of a trade completed in the meantime stay locked until its
+// transaction is settled.
+func (s *Service) scheduleTradeExpiry(
+	tradeId string, tradeUtxos []ports.Utxo, expiryTime int64,
+) {
+	time.AfterFunc(time.Until(time.Unix(expiryTime, 0)), func() {
+		ctx := context.Background()
+		expired := false
+		var marketName, swapRequestId string
+		if err := s.repoManager.TradeRepository().UpdateTrade(
+			ctx, tradeId, func(trade *domain.Trade) (*domain.Trade, error) {
+				// The trade is already expired by time at this point, its status
+				// tells whether it's still waiting to be completed.
+				if trade.Status.Code != domain.TradeStatusCodeAccepted ||
+					trade.IsRejected() {
+					return trade, nil
+				}
+				if _, err := trade.Expire(); err != nil {
+					return nil, err
+				}
+				expired = true
+				marketName, swapRequestId = trade.MarketName, trade.SwapRequest.Id
+				return trade, nil
+			},
+		); err != nil {
+			log.WithError(err).Warnf("failed to expire trade with id %s", tradeId)
+			return
+		}
+
+		if !expired {
+			return
+		}
+
+		s.wallet.UnlockUtxos(tradeUtxos)
+		s.removePendingTrade(marketName, swapRequestId)
+		log.Debugf("trade with id %s expired", tradeId)
+	})
+}
+
+// addPendingSwapRequest marks the given swap request as being processed.
+// It returns false if the swap request is already being processed.
+func (s *Service) addPendingSwapRequest(swapRequestId string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.pendingSwapRequests[swapRequestId]; ok {
+		return false
+	}
+	s.pendingSwapRequests[swapRequestId] = struct{}{}
+	return true
+}
+
+func (s *Service) removePendingSwapRequest(swapRequestId string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.pendingSwapRequests, swapRequestId)
+}
@@ -0,0 +1,584 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
+	"github.com/tdex-network/tdex-daemon/internal/core/application/pubsub"
+	"github.com/tdex-network/tdex-daemon/internal/core/application/wallet"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+	swap_parser "github.com/tdex-network/tdex-daemon/internal/infrastructure/swap-parser"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/psetv2"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	marketAccount = "market"
+	baseAsset     = "0000000000000000000000000000000000000000000000000000000000000001"
+	quoteAsset    = "0000000000000000000000000000000000000000000000000000000000000002"
+	networkFee    = 1000
+)
+
+var lbtc = network.Regtest.AssetID
+
+func TestTradeProposeDryRun(t *testing.T) {
+	domain.SwapParserManager = swapParser{swap_parser.NewService()}
+
+	t.Run("accepted", func(t *testing.T) {
+		svc, _ := newTestService(t, networkFee, true)
+		swapRequest := newTestSwapRequest(t, svc, 70000)
+
+		dryRun, err := svc.TradeProposeDryRun(
+			context.Background(), market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		require.True(t, dryRun.IsAccepted())
+		require.Empty(t, dryRun.GetReason())
+		require.Nil(t, dryRun.GetSwapFail())
+		require.Equal(t, uint64(networkFee), dryRun.GetNetworkFeeAmount())
+
+		// The dry run doesn't reserve the market utxos, nor stores any trade.
+		dryRun, err = svc.TradeProposeDryRun(
+			context.Background(), market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		require.True(t, dryRun.IsAccepted())
+
+		trades, err := svc.repoManager.TradeRepository().GetAllTrades(
+			context.Background(), nil,
+		)
+		require.NoError(t, err)
+		require.Empty(t, trades)
+	})
+
+	t.Run("market closed", func(t *testing.T) {
+		svc, _ := newTestService(t, networkFee, false)
+		swapRequest := newTestSwapRequest(t, svc, 70000)
+
+		dryRun, err := svc.TradeProposeDryRun(
+			context.Background(), market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		require.False(t, dryRun.IsAccepted())
+		require.Equal(t, ErrMarketUnavailable.Error(), dryRun.GetReason())
+		require.Nil(t, dryRun.GetSwapFail())
+	})
+
+	t.Run("bad pricing", func(t *testing.T) {
+		svc, _ := newTestService(t, networkFee, true)
+		swapRequest := newTestSwapRequest(t, svc, 70000)
+		swapRequest.amountP /= 2
+
+		dryRun, err := svc.TradeProposeDryRun(
+			context.Background(), market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		require.False(t, dryRun.IsAccepted())
+		require.NotEmpty(t, dryRun.GetReason())
+		require.NotNil(t, dryRun.GetSwapFail())
+		require.Equal(
+			t, uint32(pkgswap.ErrCodeBadPricingSwapRequest),
+			dryRun.GetSwapFail().GetFailureCode(),
+		)
+	})
+
+	t.Run("market utxos reserved", func(t *testing.T) {
+		svc, mockWallet := newTestService(t, networkFee, true)
+		swapRequest := newTestSwapRequest(t, svc, 70000)
+
+		utxos := mockWallet.account.utxos[marketAccount]
+		svc.wallet.LockUtxos(utxos, time.Now().Add(time.Minute).Unix())
+
+		dryRun, err := svc.TradeProposeDryRun(
+			context.Background(), market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		require.False(t, dryRun.IsAccepted())
+		require.True(t, strings.HasPrefix(
+			dryRun.GetReason(), "failed to complete swap request",
+		))
+		require.Zero(t, dryRun.GetNetworkFeeAmount())
+	})
+
+	t.Run("insufficient fee account funds", func(t *testing.T) {
+		svc, _ := newTestService(t, 1000000, true)
+		swapRequest := newTestSwapRequest(t, svc, 70000)
+
+		dryRun, err := svc.TradeProposeDryRun(
+			context.Background(), market{}, tradeType{}, swapRequest,
+		)
+		require.NoError(t, err)
+		require.False(t, dryRun.IsAccepted())
+		require.True(t, strings.HasPrefix(
+			dryRun.GetReason(), "failed to complete swap request",
+		))
+	})
+}
+
+func TestTradeProposeRetry(t *testing.T) {
+	domain.SwapParserManager = swapParser{swap_parser.NewService()}
+	ctx := context.Background()
+
+	svc, _ := newTestService(t, networkFee, true)
+	swapRequest := newTestSwapRequest(t, svc, 30000)
+
+	accept, fail, expiryTime, err := svc.TradePropose(
+		ctx, market{}, tradeType{}, swapRequest,
+	)
+	require.NoError(t, err)
+	require.Nil(t, fail)
+	require.NotNil(t, accept)
+
+	// The retry of the same swap request gets the stored response.
+	retryAccept, fail, retryExpiryTime, err := svc.TradePropose(
+		ctx, market{}, tradeType{}, swapRequest,
+	)
+	require.NoError(t, err)
+	require.Nil(t, fail)
+	require.Equal(t, accept.GetId(), retryAccept.GetId())
+	require.Equal(t, expiryTime, retryExpiryTime)
+
+	// A different swap request with the same id is rejected.
+	otherSwapRequest := *swapRequest
+	otherSwapRequest.amountR /= 2
+	_, _, _, err = svc.TradePropose(
+		ctx, market{}, tradeType{}, &otherSwapRequest,
+	)
+	require.ErrorIs(t, err, ErrSwapRequestIdInUse)
+
+	otherSwapRequest = *swapRequest
+	otherSwapRequest.feeAmount++
+	_, _, _, err = svc.TradePropose(
+		ctx, market{}, tradeType{}, &otherSwapRequest,
+	)
+	require.ErrorIs(t, err, ErrSwapRequestIdInUse)
+}
+
+func TestScheduleTradeExpiry(t *testing.T) {
+	domain.SwapParserManager = swapParser{swap_parser.NewService()}
+	ctx := context.Background()
+
+	tests := []struct {
+		name            string
+		status          int
+		expectedStatus  int
+		expectedUnlock  bool
+		expectedPending bool
+	}{
+		{
+			name:            "accepted",
+			status:          domain.TradeStatusCodeAccepted,
+			expectedStatus:  domain.TradeStatusCodeExpired,
+			expectedUnlock:  true,
+			expectedPending: false,
+		},
+		{
+			name:            "completed",
+			status:          domain.TradeStatusCodeCompleted,
+			expectedStatus:  domain.TradeStatusCodeCompleted,
+			expectedUnlock:  false,
+			expectedPending: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockWallet := newTestService(t, networkFee, true)
+			swapRequest := newTestSwapRequest(t, svc, 70000)
+
+			// The trade is stored apart from the trades of svc, not to race with
+			// the check for pending trades that svc runs at startup.
+			expirer := &Service{
+				wallet:                    svc.wallet,
+				repoManager:               inmemory.NewRepoManager(),
+				lock:                      &sync.Mutex{},
+				pendingTrades:             make(map[string]map[string]int64),
+				maxPendingTradesPerMarket: 1,
+			}
+
+			// The trade reserves all the market utxos.
+			utxos := mockWallet.account.utxos[marketAccount]
+			expiryTime := time.Now().Add(time.Second).Unix()
+			svc.wallet.LockUtxos(utxos, time.Now().Add(time.Hour).Unix())
+
+			trade := domain.NewTrade()
+			trade.MarketName = marketAccount
+			trade.SwapRequest = &domain.Swap{Id: swapRequest.id}
+			trade.ExpiryTime = expiryTime
+			trade.Status.Code = tt.status
+			err := expirer.repoManager.TradeRepository().AddTrade(ctx, trade)
+			require.NoError(t, err)
+			expirer.addPendingTrade(marketAccount, swapRequest.id, expiryTime)
+
+			expirer.scheduleTradeExpiry(trade.Id, utxos, expiryTime)
+
+			isStatus := func(code int) func() bool {
+				return func() bool {
+					trade, err := expirer.repoManager.TradeRepository().GetTradeById(
+						ctx, trade.Id,
+					)
+					require.NoError(t, err)
+					return trade.Status.Code == code
+				}
+			}
+			if tt.expectedStatus != tt.status {
+				require.Eventually(
+					t, isStatus(tt.expectedStatus), 3*time.Second, 50*time.Millisecond,
+				)
+			} else {
+				time.Sleep(time.Until(time.Unix(expiryTime, 0)) + time.Second)
+				require.True(t, isStatus(tt.expectedStatus)())
+			}
+
+			dryRun, err := svc.TradeProposeDryRun(
+				ctx, market{}, tradeType{}, swapRequest,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedUnlock, dryRun.IsAccepted())
+
+			expirer.lock.Lock()
+			_, ok := expirer.pendingTrades[marketAccount][swapRequest.id]
+			expirer.lock.Unlock()
+			require.Equal(t, tt.expectedPending, ok)
+		})
+	}
+}
+
+// newTestService returns a trade service for a market with a price of 1 and
+// an account owning 2 utxos of 50000 units of base and quote asset. The fee
+// account owns 3 LBTC utxos of 5000 sats, and the network fees of swap
+// transactions are always estimated to the given amount.
+func newTestService(
+	t *testing.T, feeAmount uint64, tradable bool,
+) (*Service, *mockWallet) {
+	mkt, err := domain.NewMarket(
+		baseAsset, quoteAsset, marketAccount, 25, 25, 0, 0, 8, 8,
+		domain.StrategyTypePluggable,
+	)
+	require.NoError(t, err)
+	err = mkt.ChangePrice(decimal.NewFromInt(1), decimal.NewFromInt(1))
+	require.NoError(t, err)
+	if tradable {
+		err = mkt.MakeTradable()
+		require.NoError(t, err)
+	}
+
+	repoManager := inmemory.NewRepoManager()
+	err = repoManager.MarketRepository().AddMarket(context.Background(), mkt)
+	require.NoError(t, err)
+
+	mockWallet := newMockWallet(t, feeAmount)
+	walletSvc, err := wallet.NewService(mockWallet)
+	require.NoError(t, err)
+	t.Cleanup(walletSvc.Close)
+
+	svc, err := NewService(
+		walletSvc, pubsub.NewService(nil), repoManager,
+		decimal.NewFromFloat(0.05), decimal.NewFromFloat(0.1), 60, 0, 0, 0, 0,
+	)
+	require.NoError(t, err)
+	return svc, mockWallet
+}
+
+// newTestSwapRequest returns a request to buy the given amount of base asset
+// at the current market price, with fees paid in quote asset.
+func newTestSwapRequest(
+	t *testing.T, svc *Service, amount uint64,
+) *swapRequest {
+	mkt, err := svc.repoManager.MarketRepository().GetMarketByName(
+		context.Background(), marketAccount,
+	)
+	require.NoError(t, err)
+	if !mkt.IsTradable() {
+		mkt.MakeTradable()
+	}
+
+	balance, err := svc.wallet.Account().GetBalance(
+		context.Background(), marketAccount,
+	)
+	require.NoError(t, err)
+	preview, err := tradePreview(
+		*mkt, balance, tradeType{}, quoteAsset, baseAsset, amount,
+	)
+	require.NoError(t, err)
+
+	return &swapRequest{
+		id:        "swap",
+		assetP:    quoteAsset,
+		amountP:   preview.GetAmount(),
+		assetR:    baseAsset,
+		amountR:   amount,
+		feeAsset:  quoteAsset,
+		feeAmount: preview.GetFeeAmount(),
+	}
+}
+
+// swapParser accepts any swap message, since the test ones don't come with a
+// transaction.
+type swapParser struct {
+	domain.SwapParser
+}
+
+func (swapParser) SerializeRequest(r domain.SwapRequest) ([]byte, int) {
+	msg, _ := proto.Marshal(&tdexv2.SwapRequest{
+		Id:          r.Id,
+		AssetP:      r.AssetP,
+		AmountP:     r.AmountP,
+		AssetR:      r.AssetR,
+		AmountR:     r.AmountR,
+		Transaction: r.Transaction,
+	})
+	return msg, -1
+}
+func (swapParser) SerializeAccept(
+	[]byte, string, []domain.UnblindedInput,
+) (string, []byte, int) {
+	return uuid.New().String(), []byte{}, -1
+}
+func (swapParser) DeserializeAccept([]byte) *domain.SwapAccept {
+	ptx, _ := psetv2.New(nil, nil, nil)
+	tx, _ := ptx.ToBase64()
+	return &domain.SwapAccept{Transaction: tx}
+}
+func (swapParser) SerializeComplete([]byte, string) (string, []byte, int) {
+	return uuid.New().String(), []byte{}, -1
+}
+func (swapParser) ParseSwapTransaction(
+	string,
+) (*domain.SwapTransactionDetails, int) {
+	return &domain.SwapTransactionDetails{}, -1
+}
+
+type market struct{}
+
+func (market) GetBaseAsset() string  { return baseAsset }
+func (market) GetQuoteAsset() string { return quoteAsset }
+
+type tradeType struct{}
+
+func (tradeType) IsBuy() bool  { return true }
+func (tradeType) IsSell() bool { return false }
+
+type swapRequest struct {
+	id                          string
+	assetP, assetR, feeAsset    string
+	amountP, amountR, feeAmount uint64
+}
+
+func (r *swapRequest) GetId() string                              { return r.id }
+func (r *swapRequest) GetAssetP() string                          { return r.assetP }
+func (r *swapRequest) GetAmountP() uint64                         { return r.amountP }
+func (r *swapRequest) GetAssetR() string                          { return r.assetR }
+func (r *swapRequest) GetAmountR() uint64                         { return r.amountR }
+func (r *swapRequest) GetTransaction() string                     { return "" }
+func (r *swapRequest) GetFeeAsset() string                        { return r.feeAsset }
+func (r *swapRequest) GetFeeAmount() uint64                       { return r.feeAmount }
+func (r *swapRequest) GetUnblindedInputs() []ports.UnblindedInput { return nil }
+
+type mockUtxo struct {
+	txid  string
+	asset string
+	value uint64
+}
+
+func (u mockUtxo) GetTxid() string                      { return u.txid }
+func (u mockUtxo) GetIndex() uint32                     { return 0 }
+func (u mockUtxo) GetAsset() string                     { return u.asset }
+func (u mockUtxo) GetValue() uint64                     { return u.value }
+func (u mockUtxo) GetScript() string                    { return "" }
+func (u mockUtxo) GetAssetBlinder() string              { return "" }
+func (u mockUtxo) GetValueBlinder() string              { return "" }
+func (u mockUtxo) GetConfirmedStatus() ports.UtxoStatus { return nil }
+func (u mockUtxo) GetSpentStatus() ports.UtxoStatus     { return nil }
+func (u mockUtxo) GetRedeemScript() string              { return "" }
+
+type balance uint64
+
+func (b balance) GetConfirmedBalance() uint64   { return uint64(b) }
+func (b balance) GetUnconfirmedBalance() uint64 { return 0 }
+func (b balance) GetLockedBalance() uint64      { return 0 }
+func (b balance) GetTotalBalance() uint64       { return uint64(b) }
+
+type mockWallet struct {
+	account     *mockAccount
+	transaction *mockTransaction
+	chTx        chan ports.WalletTxNotification
+	chUtxo      chan ports.WalletUtxoNotification
+}
+
+func newMockWallet(t *testing.T, feeAmount uint64) *mockWallet {
+	addresses := make(map[string][]string)
+	for _, account := range []string{marketAccount, domain.FeeAccount} {
+		addresses[account] = []string{newAddress(t)}
+	}
+	return &mockWallet{
+		account: &mockAccount{
+			t: t,
+			utxos: map[string][]ports.Utxo{
+				marketAccount: {
+					mockUtxo{txid(1), baseAsset, 50000},
+					mockUtxo{txid(2), baseAsset, 50000},
+					mockUtxo{txid(8), baseAsset, 50000},
+					mockUtxo{txid(3), quoteAsset, 50000},
+					mockUtxo{txid(4), quoteAsset, 50000},
+				},
+				domain.FeeAccount: {
+					mockUtxo{txid(5), lbtc, 5000},
+					mockUtxo{txid(6), lbtc, 5000},
+					mockUtxo{txid(7), lbtc, 5000},
+				},
+			},
+			addresses: addresses,
+		},
+		transaction: &mockTransaction{fee: feeAmount},
+		chTx:        make(chan ports.WalletTxNotification),
+		chUtxo:      make(chan ports.WalletUtxoNotification),
+	}
+}
+
+func (m *mockWallet) Wallet() ports.Wallet {
+	return mockWalletInfo{}
+}
+func (m *mockWallet) Account() ports.Account {
+	return m.account
+}
+func (m *mockWallet) Transaction() ports.Transaction {
+	return m.transaction
+}
+func (m *mockWallet) Notification() ports.Notification {
+	return m
+}
+func (m *mockWallet) GetTxNotifications() chan ports.WalletTxNotification {
+	return m.chTx
+}
+func (m *mockWallet) GetUtxoNotifications() chan ports.WalletUtxoNotification {
+	return m.chUtxo
+}
+func (m *mockWallet) Close() {
+	close(m.chTx)
+	close(m.chUtxo)
+}
+
+type mockWalletInfo struct {
+	ports.Wallet
+	ports.WalletInfo
+}
+
+func (m mockWalletInfo) Info(context.Context) (ports.WalletInfo, error) {
+	return m, nil
+}
+func (m mockWalletInfo) GetNativeAsset() string {
+	return lbtc
+}
+
+type mockAccount struct {
+	ports.Account
+	t         *testing.T
+	utxos     map[string][]ports.Utxo
+	addresses map[string][]string
+}
+
+func (m *mockAccount) GetBalance(
+	_ context.Context, account string,
+) (map[string]ports.Balance, error) {
+	amounts := make(map[string]uint64)
+	for _, u := range m.utxos[account] {
+		amounts[u.GetAsset()] += u.GetValue()
+	}
+	balances := make(map[string]ports.Balance)
+	for asset, amount := range amounts {
+		balances[asset] = balance(amount)
+	}
+	return balances, nil
+}
+func (m *mockAccount) ListUtxos(
+	_ context.Context, account string,
+) ([]ports.Utxo, []ports.Utxo, error) {
+	return m.utxos[account], nil, nil
+}
+func (m *mockAccount) ListAddresses(
+	_ context.Context, account string,
+) ([]string, error) {
+	return m.addresses[account], nil
+}
+func (m *mockAccount) DeriveAddresses(
+	_ context.Context, account string, num int,
+) ([]string, error) {
+	return m.derive(account, num), nil
+}
+func (m *mockAccount) DeriveChangeAddresses(
+	_ context.Context, account string, num int,
+) ([]string, error) {
+	return m.derive(account, num), nil
+}
+func (m *mockAccount) derive(account string, num int) []string {
+	addresses := make([]string, 0, num)
+	for i := 0; i < num; i++ {
+		addresses = append(addresses, newAddress(m.t))
+	}
+	m.addresses[account] = append(m.addresses[account], addresses...)
+	return addresses
+}
+
+type mockTransaction struct {
+	ports.Transaction
+	fee uint64
+}
+
+func (m *mockTransaction) EstimateFees(
+	context.Context, []ports.TxInput, []ports.TxOutput, uint64,
+) (uint64, error) {
+	return m.fee, nil
+}
+func (m *mockTransaction) UpdatePset(
+	_ context.Context, pset string, _ []ports.TxInput, _ []ports.TxOutput,
+) (string, error) {
+	return pset, nil
+}
+func (m *mockTransaction) BlindPset(
+	_ context.Context, pset string, _ []ports.UnblindedInput,
+) (string, error) {
+	return pset, nil
+}
+func (m *mockTransaction) BroadcastTransaction(
+	context.Context, string,
+) (string, error) {
+	return txid(0), nil
+}
+func (m *mockTransaction) SignPset(
+	_ context.Context, pset string, _ bool,
+) (string, error) {
+	return pset, nil
+}
+
+func txid(i int) string {
+	return fmt.Sprintf("%064x", i)
+}
+
+func newAddress(t *testing.T) string {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	blindKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr, err := payment.FromPublicKey(
+		key.PubKey(), &network.Regtest, blindKey.PubKey(),
+	).ConfidentialWitnessPubKeyHash()
+	require.NoError(t, err)
+	return addr
+}
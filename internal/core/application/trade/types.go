@@ -44,6 +44,9 @@ func (i marketInfo) GetBaseAssetPrecision() uint32 {
 func (i marketInfo) GetQuoteAssetPrecision() uint32 {
 	return uint32(i.Market.QuoteAssetPrecision)
 }
+func (i marketInfo) GetTradeExpiryTime() uint64 {
+	return i.Market.TradeExpiryTime
+}
 func (i marketInfo) GetPercentageFee() ports.MarketFee {
 	return marketFeeInfo(i.PercentageFee)
 }
@@ -102,6 +105,26 @@ func (i previewInfo) GetFeeAsset() string {
 	return i.PreviewInfo.FeeAsset
 }
 
+type tradeDryRunInfo struct {
+	accepted         bool
+	reason           string
+	swapFail         ports.SwapFail
+	networkFeeAmount uint64
+}
+
+func (i tradeDryRunInfo) IsAccepted() bool {
+	return i.accepted
+}
+func (i tradeDryRunInfo) GetReason() string {
+	return i.reason
+}
+func (i tradeDryRunInfo) GetSwapFail() ports.SwapFail {
+	return i.swapFail
+}
+func (i tradeDryRunInfo) GetNetworkFeeAmount() uint64 {
+	return i.networkFeeAmount
+}
+
 type tradeTypeInfo struct {
 	ports.TradeType
 }
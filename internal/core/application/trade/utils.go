@@ -4,6 +4,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
 )
 
 // isValidPrice checks that the amounts of the trade are valid by
@@ -97,3 +98,53 @@ func tradePreview(
 	}
 	return previewInfo{mkt, *preview}, nil
 }
+
+// validateSwapRequest returns the detailed error that makes the given swap
+// request invalid, if any.
+func validateSwapRequest(swapRequest ports.SwapRequest) error {
+	unblindedIns := make(
+		[]pkgswap.UnblindedInput, 0, len(swapRequest.GetUnblindedInputs()),
+	)
+	for _, in := range swapRequest.GetUnblindedInputs() {
+		unblindedIns = append(unblindedIns, pkgswap.UnblindedInput{
+			Index:         in.GetIndex(),
+			Asset:         in.GetAsset(),
+			Amount:        in.GetAmount(),
+			AssetBlinder:  in.GetAssetBlinder(),
+			AmountBlinder: in.GetAmountBlinder(),
+		})
+	}
+	_, err := pkgswap.Request(pkgswap.RequestOpts{
+		Id:              swapRequest.GetId(),
+		AssetToSend:     swapRequest.GetAssetP(),
+		AmountToSend:    swapRequest.GetAmountP(),
+		AssetToReceive:  swapRequest.GetAssetR(),
+		AmountToReceive: swapRequest.GetAmountR(),
+		Transaction:     swapRequest.GetTransaction(),
+		FeeAsset:        swapRequest.GetFeeAsset(),
+		FeeAmount:       swapRequest.GetFeeAmount(),
+		UnblindedInputs: unblindedIns,
+	})
+	return err
+}
+
+// isSameSwapRequest returns whether the given swap request has the same
+// content of the one of the given trade, meaning it's a retry of the same
+// proposal rather than a different one reusing its id.
+func isSameSwapRequest(trade *domain.Trade, swapRequest ports.SwapRequest) bool {
+	if trade.FeeAsset != swapRequest.GetFeeAsset() ||
+		trade.FeeAmount != swapRequest.GetFeeAmount() {
+		return false
+	}
+	// A swap request that failed to be serialized is stored with its
+	// transaction only.
+	if len(trade.SwapRequest.Message) <= 0 {
+		return trade.PsetBase64 == swapRequest.GetTransaction()
+	}
+	prev := trade.SwapRequestMessage()
+	return prev.GetAssetP() == swapRequest.GetAssetP() &&
+		prev.GetAmountP() == swapRequest.GetAmountP() &&
+		prev.GetAssetR() == swapRequest.GetAssetR() &&
+		prev.GetAmountR() == swapRequest.GetAmountR() &&
+		prev.GetTransaction() == swapRequest.GetTransaction()
+}
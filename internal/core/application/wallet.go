@@ -18,8 +18,14 @@ type WalletService interface {
 	) (string, error)
 	CompleteSwap(
 		account string, swapRequest ports.SwapRequest, msatsPerByte uint64,
-		feesToAdd bool,
+		feesToAdd bool, tradeExpiryTime uint64,
 	) (string, []ports.Utxo, int64, error)
+	CompleteSwapDryRun(
+		account string, swapRequest ports.SwapRequest, msatsPerByte uint64,
+		feesToAdd bool,
+	) (uint64, error)
+	LockUtxos(utxos []ports.Utxo, expiryTime int64)
+	UnlockUtxos(utxos []ports.Utxo)
 	RegisterHandlerForTxEvent(handler func(ports.WalletTxNotification) bool)
 	RegisterHandlerForUtxoEvent(handler func(ports.WalletUtxoNotification) bool)
 	Close()
@@ -5,21 +5,31 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	"github.com/vulpemventures/go-elements/address"
 	"github.com/vulpemventures/go-elements/elementsutil"
 	"github.com/vulpemventures/go-elements/network"
-	"github.com/vulpemventures/go-elements/psetv2"
 )
 
+// spendLockDuration is the duration of the reservation of the utxos selected
+// for a transaction that is broadcasted right away.
+const spendLockDuration = 5 * time.Minute
+
 type Service struct {
 	wallet     ports.WalletService
 	staticInfo ports.WalletInfo
 
 	txNotificationHandlers   txNotificationQueue
 	utxoNotificationHandlers utxoNotificationQueue
+
+	// locks keeps track of the utxos reserved for the transactions built by
+	// the daemon. The wallet applies its own lock to the utxos it selects, but
+	// its duration is fixed and it can't be released on demand, therefore
+	// utxos are selected and reserved here for as long as actually required.
+	locks utxoLocks
 }
 
 func NewService(wallet ports.WalletService) (*Service, error) {
@@ -38,7 +48,9 @@ func NewService(wallet ports.WalletService) (*Service, error) {
 		&sync.Mutex{}, make([]func(ports.WalletUtxoNotification) bool, 0),
 	}
 
-	svc := &Service{wallet, info, txHandlers, utxoHandlers}
+	locks := utxoLocks{&sync.Mutex{}, make(map[string]int64)}
+
+	svc := &Service{wallet, info, txHandlers, utxoHandlers, locks}
 	go svc.listenToTxNotifications()
 	go svc.listenToUtxoNotifications()
 
@@ -87,11 +99,25 @@ func (s *Service) SendToMany(
 	inputs := make([]ports.TxInput, 0)
 	outputs := append([]ports.TxOutput{}, outs...)
 
+	// Selected utxos are reserved until the tx is broadcasted, after which
+	// the wallet takes care of marking them as spent.
+	lockExpiryTime := time.Now().Add(spendLockDuration).Unix()
+	selectedUtxos := make([]ports.Utxo, 0)
+	broadcasted := false
+	defer func() {
+		if !broadcasted {
+			s.locks.unlockUtxos(selectedUtxos)
+		}
+	}()
+
 	for asset, amount := range totOutputAmountPerAsset(outs) {
-		utxos, change, _, err := txManager.SelectUtxos(ctx, account, asset, amount)
+		utxos, change, err := s.selectUtxos(
+			ctx, account, asset, amount, lockExpiryTime,
+		)
 		if err != nil {
 			return "", err
 		}
+		selectedUtxos = append(selectedUtxos, utxos...)
 		if change > 0 {
 			changeAmountPerAsset[asset] = change
 		}
@@ -136,12 +162,13 @@ func (s *Service) SendToMany(
 	// 150 is an over estimation of an extra confidential output (change).
 	dummyFeeAmount += 150
 	lbtc := s.staticInfo.GetNativeAsset()
-	feeUtxos, change, _, err := txManager.SelectUtxos(
-		ctx, domain.FeeAccount, lbtc, dummyFeeAmount,
+	feeUtxos, change, err := s.selectUtxos(
+		ctx, domain.FeeAccount, lbtc, dummyFeeAmount, lockExpiryTime,
 	)
 	if err != nil {
 		return "", err
 	}
+	selectedUtxos = append(selectedUtxos, feeUtxos...)
 
 	for _, u := range feeUtxos {
 		txid, _ := elementsutil.TxIDToBytes(u.GetTxid())
@@ -194,57 +221,120 @@ func (s *Service) SendToMany(
 	if err != nil {
 		return "", err
 	}
+	broadcasted = true
 	return txid, nil
 }
 
 func (s *Service) CompleteSwap(
 	account string, swapRequest ports.SwapRequest, msatsPerByte uint64,
-	feesToAdd bool,
+	feesToAdd bool, tradeExpiryTime uint64,
 ) (string, []ports.Utxo, int64, error) {
 	ctx := context.Background()
 	txManager := s.wallet.Transaction()
-	accountManager := s.wallet.Account()
-	inputs := make([]ports.TxInput, 0)
-	existingInputs := make([]ports.TxInput, 0)
-	existingOutputs := make([]ports.TxOutput, 0)
 
-	ptx, _ := psetv2.NewPsetFromBase64(swapRequest.GetTransaction())
-	for _, in := range ptx.Inputs {
-		var scriptSigSize, witnessSize int
-		if len(in.RedeemScript) > 0 {
-			// values for 2of2 native bare multisig inputs
-			scriptSigSize = 223
-		}
-		if len(in.WitnessScript) > 0 {
-			// values for 2of2 native or wrapped segwit multisig inputs
-			if scriptSigSize > 0 {
-				scriptSigSize = 35
-			}
-			witnessSize = 223
+	// The selected utxos are reserved for exactly tradeExpiryTime seconds, and
+	// are released earlier in case of failure.
+	expiryTime := time.Now().Add(
+		time.Duration(tradeExpiryTime) * time.Second,
+	).Unix()
+	swap, err := s.completeSwap(
+		ctx, account, swapRequest, msatsPerByte, feesToAdd, expiryTime,
+	)
+	if err != nil {
+		return "", nil, -1, err
+	}
+	completed := false
+	defer func() {
+		if !completed {
+			s.locks.unlockUtxos(swap.utxos)
 		}
-		existingInputs = append(existingInputs, input{
-			in.PreviousTxid, in.PreviousTxIndex, hex.EncodeToString(in.GetUtxo().Script),
-			scriptSigSize, witnessSize,
-		})
+	}()
+
+	pset, err := txManager.UpdatePset(
+		ctx, swapRequest.GetTransaction(), swap.inputs, swap.outputs,
+	)
+	if err != nil {
+		return "", nil, -1, err
 	}
-	for _, out := range ptx.Outputs {
-		existingOutputs = append(existingOutputs, output{
-			hex.EncodeToString(elementsutil.ReverseBytes(out.Asset)),
-			out.Value, hex.EncodeToString(out.Script), hex.EncodeToString(out.BlindingPubkey),
-		})
+
+	blindedPset, err := txManager.BlindPset(
+		ctx, pset, swapRequest.GetUnblindedInputs(),
+	)
+	if err != nil {
+		return "", nil, -1, err
+	}
+
+	signedPset, err := txManager.SignPset(ctx, blindedPset, false)
+	if err != nil {
+		return "", nil, -1, err
 	}
 
+	completed = true
+	return signedPset, swap.utxos, expiryTime, nil
+}
+
+// CompleteSwapDryRun goes through the same steps of CompleteSwap, without
+// locking, nor signing anything, and returns the estimated network fee amount
+// of the swap transaction.
+func (s *Service) CompleteSwapDryRun(
+	account string, swapRequest ports.SwapRequest, msatsPerByte uint64,
+	feesToAdd bool,
+) (uint64, error) {
+	swap, err := s.completeSwap(
+		context.Background(), account, swapRequest, msatsPerByte, feesToAdd, 0,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return swap.feeAmount, nil
+}
+
+// swapCompletion holds the inputs and outputs added to a swap request to
+// complete it, and the utxos spent by the inputs.
+type swapCompletion struct {
+	inputs    []ports.TxInput
+	outputs   []ports.TxOutput
+	utxos     []ports.Utxo
+	feeAmount uint64
+}
+
+// completeSwap selects the utxos of the given account and of the fee account
+// required to complete the swap request, and returns them along with the
+// inputs and outputs to add to its transaction. The selected utxos are
+// reserved until the given expiry time and new addresses are derived for the
+// outputs. With a zero expiry time, it's a dry run instead, in which case
+// nothing is reserved and existing addresses are used for the outputs.
+func (s *Service) completeSwap(
+	ctx context.Context, account string, swapRequest ports.SwapRequest,
+	msatsPerByte uint64, feesToAdd bool, expiryTime int64,
+) (*swapCompletion, error) {
+	commit := expiryTime > 0
+	txManager := s.wallet.Transaction()
+	inputs := make([]ports.TxInput, 0)
+	existingInputs, existingOutputs := swapRequestInsAndOuts(
+		swapRequest.GetTransaction(),
+	)
+
 	amountR := swapRequest.GetAmountR()
 	if swapRequest.GetFeeAsset() == swapRequest.GetAssetR() && !feesToAdd {
 		amountR -= swapRequest.GetFeeAmount()
 	}
 
-	utxos, change, unlockTime, err := txManager.SelectUtxos(
-		ctx, account, swapRequest.GetAssetR(), amountR,
+	selectedUtxos := make([]ports.Utxo, 0)
+	completed := false
+	defer func() {
+		if commit && !completed {
+			s.locks.unlockUtxos(selectedUtxos)
+		}
+	}()
+
+	utxos, change, err := s.selectUtxos(
+		ctx, account, swapRequest.GetAssetR(), amountR, expiryTime,
 	)
 	if err != nil {
-		return "", nil, -1, err
+		return nil, err
 	}
+	selectedUtxos = append(selectedUtxos, utxos...)
 
 	for _, u := range utxos {
 		txid, _ := elementsutil.TxIDToBytes(u.GetTxid())
@@ -258,30 +348,24 @@ func (s *Service) CompleteSwap(
 		})
 	}
 
-	addresses, err := accountManager.DeriveAddresses(ctx, account, 1)
+	script, blindKey, err := s.swapAddress(ctx, account, false, commit)
 	if err != nil {
-		return "", nil, -1, err
+		return nil, err
 	}
-	info, _ := address.FromConfidential(addresses[0])
 	amountP := swapRequest.GetAmountP()
 	if swapRequest.GetFeeAsset() == swapRequest.GetAssetP() && feesToAdd {
 		amountP += swapRequest.GetFeeAmount()
 	}
 	outputs := []ports.TxOutput{
-		output{
-			swapRequest.GetAssetP(), amountP,
-			hex.EncodeToString(info.Script), hex.EncodeToString(info.BlindingKey),
-		},
+		output{swapRequest.GetAssetP(), amountP, script, blindKey},
 	}
 	if change > 0 {
-		addresses, err := accountManager.DeriveChangeAddresses(ctx, account, 1)
+		script, blindKey, err := s.swapAddress(ctx, account, true, commit)
 		if err != nil {
-			return "", nil, -1, err
+			return nil, err
 		}
-		info, _ := address.FromConfidential(addresses[0])
 		outputs = append(outputs, output{
-			swapRequest.GetAssetR(), change, hex.EncodeToString(info.Script),
-			hex.EncodeToString(info.BlindingKey),
+			swapRequest.GetAssetR(), change, script, blindKey,
 		})
 	}
 
@@ -291,18 +375,19 @@ func (s *Service) CompleteSwap(
 		ctx, allInputs, allOutputs, msatsPerByte,
 	)
 	if err != nil {
-		return "", nil, -1, err
+		return nil, err
 	}
 
 	// 150 is an over estimation of an extra confidential output (change).
 	dummyFeeAmount += 150
 	lbtc := s.staticInfo.GetNativeAsset()
-	feeUtxos, change, _, err := txManager.SelectUtxos(
-		ctx, domain.FeeAccount, lbtc, dummyFeeAmount,
+	feeUtxos, change, err := s.selectUtxos(
+		ctx, domain.FeeAccount, lbtc, dummyFeeAmount, expiryTime,
 	)
 	if err != nil {
-		return "", nil, -1, err
+		return nil, fmt.Errorf("fee account: %s", err)
 	}
+	selectedUtxos = append(selectedUtxos, feeUtxos...)
 
 	for _, u := range feeUtxos {
 		txid, _ := elementsutil.TxIDToBytes(u.GetTxid())
@@ -310,17 +395,13 @@ func (s *Service) CompleteSwap(
 	}
 	feeAmount := dummyFeeAmount
 	if change > 0 {
-		addresses, err := accountManager.DeriveChangeAddresses(
-			ctx, domain.FeeAccount, 1,
+		script, blindKey, err := s.swapAddress(
+			ctx, domain.FeeAccount, true, commit,
 		)
 		if err != nil {
-			return "", nil, -1, err
+			return nil, err
 		}
-		info, _ := address.FromConfidential(addresses[0])
-		outputs = append(outputs, output{
-			lbtc, change, hex.EncodeToString(info.Script),
-			hex.EncodeToString(info.BlindingKey),
-		})
+		outputs = append(outputs, output{lbtc, change, script, blindKey})
 
 		allInputs := append(existingInputs, inputs...)
 		allOutputs := append(existingOutputs, outputs...)
@@ -328,7 +409,7 @@ func (s *Service) CompleteSwap(
 			ctx, allInputs, allOutputs, msatsPerByte,
 		)
 		if err != nil {
-			return "", nil, -1, err
+			return nil, err
 		}
 
 		changeOut := outputs[len(outputs)-1]
@@ -343,28 +424,52 @@ func (s *Service) CompleteSwap(
 
 	outputs = append(outputs, output{lbtc, feeAmount, "", ""})
 
-	pset, err := txManager.UpdatePset(
-		ctx, swapRequest.GetTransaction(), inputs, outputs,
-	)
-	if err != nil {
-		return "", nil, -1, err
-	}
+	completed = true
+	return &swapCompletion{inputs, outputs, selectedUtxos, feeAmount}, nil
+}
 
-	blindedPset, err := txManager.BlindPset(
-		ctx, pset, swapRequest.GetUnblindedInputs(),
-	)
+// swapAddress returns the script and blinding key of a new receive or change
+// address of the given account. Without commit, an existing address is used
+// instead to not derive any.
+func (s *Service) swapAddress(
+	ctx context.Context, account string, change, commit bool,
+) (string, string, error) {
+	accountManager := s.wallet.Account()
+
+	var addresses []string
+	var err error
+	switch {
+	case !commit:
+		addresses, err = accountManager.ListAddresses(ctx, account)
+	case change:
+		addresses, err = accountManager.DeriveChangeAddresses(ctx, account, 1)
+	default:
+		addresses, err = accountManager.DeriveAddresses(ctx, account, 1)
+	}
 	if err != nil {
-		return "", nil, -1, err
+		return "", "", err
+	}
+	if len(addresses) <= 0 {
+		return "", "", fmt.Errorf("no addresses found for account %s", account)
 	}
 
-	signedPset, err := txManager.SignPset(ctx, blindedPset, false)
+	info, err := address.FromConfidential(addresses[0])
 	if err != nil {
-		return "", nil, -1, err
+		return "", "", err
 	}
+	return hex.EncodeToString(info.Script),
+		hex.EncodeToString(info.BlindingKey), nil
+}
 
-	utxos = append(utxos, feeUtxos...)
+// LockUtxos reserves the given utxos until the given expiry time, so that
+// they are not selected for any other transaction built by the daemon.
+func (s *Service) LockUtxos(utxos []ports.Utxo, expiryTime int64) {
+	s.locks.lockUtxos(utxos, expiryTime)
+}
 
-	return signedPset, utxos, unlockTime, nil
+// UnlockUtxos releases the given utxos before their lock expires.
+func (s *Service) UnlockUtxos(utxos []ports.Utxo) {
+	s.locks.unlockUtxos(utxos)
 }
 
 func (s *Service) RegisterHandlerForTxEvent(
@@ -383,6 +488,20 @@ func (s *Service) Close() {
 	s.wallet.Close()
 }
 
+// selectUtxos selects the spendable utxos of the given account to cover the
+// target amount, skipping those reserved by the daemon. The selected utxos
+// are reserved until the given expiry time, if not zero.
+func (s *Service) selectUtxos(
+	ctx context.Context, account, asset string, amount uint64,
+	expiryTime int64,
+) ([]ports.Utxo, uint64, error) {
+	utxos, _, err := s.wallet.Account().ListUtxos(ctx, account)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.locks.selectUtxos(utxos, asset, amount, expiryTime)
+}
+
 func (s *Service) listenToTxNotifications() {
 	for notification := range s.wallet.Notification().GetTxNotifications() {
 		toRepeat := make([]func(ports.WalletTxNotification) bool, 0)
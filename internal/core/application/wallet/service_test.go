@@ -0,0 +1,286 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/vulpemventures/go-elements/network"
+	"github.com/vulpemventures/go-elements/payment"
+)
+
+const (
+	marketAccount = "market"
+	baseAsset     = "0000000000000000000000000000000000000000000000000000000000000001"
+)
+
+var lbtc = network.Regtest.AssetID
+
+func TestCompleteSwapDryRun(t *testing.T) {
+	t.Run("does not reserve utxos nor derive addresses", func(t *testing.T) {
+		mockWallet := newMockWallet(t, 1000)
+		svc, err := NewService(mockWallet)
+		require.NoError(t, err)
+		defer svc.Close()
+
+		swapRequest := newSwapRequest(7000)
+
+		for i := 0; i < 2; i++ {
+			feeAmount, err := svc.CompleteSwapDryRun(
+				marketAccount, swapRequest, 100, true,
+			)
+			require.NoError(t, err)
+			require.Equal(t, uint64(1000), feeAmount)
+		}
+		require.Zero(t, mockWallet.account.derived)
+
+		// The utxos are still available to actually complete the swap.
+		_, utxos, _, err := svc.CompleteSwap(
+			marketAccount, swapRequest, 100, true, 60,
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, utxos)
+		require.NotZero(t, mockWallet.account.derived)
+	})
+
+	t.Run("fails once utxos are reserved by a swap", func(t *testing.T) {
+		mockWallet := newMockWallet(t, 1000)
+		svc, err := NewService(mockWallet)
+		require.NoError(t, err)
+		defer svc.Close()
+
+		swapRequest := newSwapRequest(7000)
+
+		_, utxos, _, err := svc.CompleteSwap(
+			marketAccount, swapRequest, 100, true, 60,
+		)
+		require.NoError(t, err)
+
+		_, err = svc.CompleteSwapDryRun(marketAccount, swapRequest, 100, true)
+		require.Error(t, err)
+
+		// Once released, the utxos can be selected again.
+		svc.UnlockUtxos(utxos)
+		_, err = svc.CompleteSwapDryRun(marketAccount, swapRequest, 100, true)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails with insufficient fee account funds", func(t *testing.T) {
+		mockWallet := newMockWallet(t, 1000000)
+		svc, err := NewService(mockWallet)
+		require.NoError(t, err)
+		defer svc.Close()
+
+		swapRequest := newSwapRequest(7000)
+
+		_, err = svc.CompleteSwapDryRun(marketAccount, swapRequest, 100, true)
+		require.Error(t, err)
+		require.True(t, strings.HasPrefix(err.Error(), "fee account:"))
+
+		_, _, _, err = svc.CompleteSwap(
+			marketAccount, swapRequest, 100, true, 60,
+		)
+		require.Error(t, err)
+
+		// The market account utxos selected by the failed swap are released.
+		mockWallet.transaction.fee = 1000
+		_, err = svc.CompleteSwapDryRun(marketAccount, swapRequest, 100, true)
+		require.NoError(t, err)
+	})
+}
+
+func newSwapRequest(amountR uint64) swapRequest {
+	return swapRequest{
+		assetP: lbtc, amountP: 5000, assetR: baseAsset, amountR: amountR,
+		feeAsset: lbtc, feeAmount: 100,
+	}
+}
+
+type swapRequest struct {
+	assetP, assetR, feeAsset    string
+	amountP, amountR, feeAmount uint64
+}
+
+func (r swapRequest) GetId() string                              { return "swap" }
+func (r swapRequest) GetAssetP() string                          { return r.assetP }
+func (r swapRequest) GetAmountP() uint64                         { return r.amountP }
+func (r swapRequest) GetAssetR() string                          { return r.assetR }
+func (r swapRequest) GetAmountR() uint64                         { return r.amountR }
+func (r swapRequest) GetTransaction() string                     { return "" }
+func (r swapRequest) GetFeeAsset() string                        { return r.feeAsset }
+func (r swapRequest) GetFeeAmount() uint64                       { return r.feeAmount }
+func (r swapRequest) GetUnblindedInputs() []ports.UnblindedInput { return nil }
+
+type utxo struct {
+	txid  string
+	index uint32
+	asset string
+	value uint64
+}
+
+func (u utxo) GetTxid() string                      { return u.txid }
+func (u utxo) GetIndex() uint32                     { return u.index }
+func (u utxo) GetAsset() string                     { return u.asset }
+func (u utxo) GetValue() uint64                     { return u.value }
+func (u utxo) GetScript() string                    { return "" }
+func (u utxo) GetAssetBlinder() string              { return "" }
+func (u utxo) GetValueBlinder() string              { return "" }
+func (u utxo) GetConfirmedStatus() ports.UtxoStatus { return nil }
+func (u utxo) GetSpentStatus() ports.UtxoStatus     { return nil }
+func (u utxo) GetRedeemScript() string              { return "" }
+
+// mockWallet is a wallet service with a market account owning 2 utxos of
+// 5000 units of base asset and a fee account owning a single LBTC utxo of
+// 5000 sats. Fees are always estimated to the given amount.
+type mockWallet struct {
+	account     *mockAccount
+	transaction *mockTransaction
+	chTx        chan ports.WalletTxNotification
+	chUtxo      chan ports.WalletUtxoNotification
+}
+
+func newMockWallet(t *testing.T, feeAmount uint64) *mockWallet {
+	addresses := make(map[string][]string)
+	for _, account := range []string{marketAccount, domain.FeeAccount} {
+		addresses[account] = []string{newAddress(t)}
+	}
+	return &mockWallet{
+		account: &mockAccount{
+			t: t,
+			utxos: map[string][]ports.Utxo{
+				marketAccount: {
+					utxo{txid(1), 0, baseAsset, 5000},
+					utxo{txid(2), 0, baseAsset, 5000},
+				},
+				domain.FeeAccount: {
+					utxo{txid(3), 0, lbtc, 5000},
+				},
+			},
+			addresses: addresses,
+		},
+		transaction: &mockTransaction{fee: feeAmount},
+		chTx:        make(chan ports.WalletTxNotification),
+		chUtxo:      make(chan ports.WalletUtxoNotification),
+	}
+}
+
+func (m *mockWallet) Wallet() ports.Wallet {
+	return mockWalletInfo{}
+}
+func (m *mockWallet) Account() ports.Account {
+	return m.account
+}
+func (m *mockWallet) Transaction() ports.Transaction {
+	return m.transaction
+}
+func (m *mockWallet) Notification() ports.Notification {
+	return m
+}
+func (m *mockWallet) GetTxNotifications() chan ports.WalletTxNotification {
+	return m.chTx
+}
+func (m *mockWallet) GetUtxoNotifications() chan ports.WalletUtxoNotification {
+	return m.chUtxo
+}
+func (m *mockWallet) Close() {
+	close(m.chTx)
+	close(m.chUtxo)
+}
+
+type mockWalletInfo struct {
+	ports.Wallet
+	ports.WalletInfo
+}
+
+func (m mockWalletInfo) Info(context.Context) (ports.WalletInfo, error) {
+	return m, nil
+}
+func (m mockWalletInfo) GetNativeAsset() string {
+	return lbtc
+}
+
+type mockAccount struct {
+	ports.Account
+	t         *testing.T
+	utxos     map[string][]ports.Utxo
+	addresses map[string][]string
+	derived   int
+}
+
+func (m *mockAccount) ListUtxos(
+	_ context.Context, account string,
+) ([]ports.Utxo, []ports.Utxo, error) {
+	return m.utxos[account], nil, nil
+}
+func (m *mockAccount) ListAddresses(
+	_ context.Context, account string,
+) ([]string, error) {
+	return m.addresses[account], nil
+}
+func (m *mockAccount) DeriveAddresses(
+	_ context.Context, account string, num int,
+) ([]string, error) {
+	return m.derive(account, num), nil
+}
+func (m *mockAccount) DeriveChangeAddresses(
+	_ context.Context, account string, num int,
+) ([]string, error) {
+	return m.derive(account, num), nil
+}
+func (m *mockAccount) derive(account string, num int) []string {
+	addresses := make([]string, 0, num)
+	for i := 0; i < num; i++ {
+		addresses = append(addresses, newAddress(m.t))
+	}
+	m.addresses[account] = append(m.addresses[account], addresses...)
+	m.derived += num
+	return addresses
+}
+
+type mockTransaction struct {
+	ports.Transaction
+	fee uint64
+}
+
+func (m *mockTransaction) EstimateFees(
+	context.Context, []ports.TxInput, []ports.TxOutput, uint64,
+) (uint64, error) {
+	return m.fee, nil
+}
+func (m *mockTransaction) UpdatePset(
+	_ context.Context, pset string, _ []ports.TxInput, _ []ports.TxOutput,
+) (string, error) {
+	return pset, nil
+}
+func (m *mockTransaction) BlindPset(
+	_ context.Context, pset string, _ []ports.UnblindedInput,
+) (string, error) {
+	return pset, nil
+}
+func (m *mockTransaction) SignPset(
+	_ context.Context, pset string, _ bool,
+) (string, error) {
+	return pset, nil
+}
+
+func txid(i int) string {
+	return fmt.Sprintf("%064x", i)
+}
+
+func newAddress(t *testing.T) string {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	blindKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	addr, err := payment.FromPublicKey(
+		key.PubKey(), &network.Regtest, blindKey.PubKey(),
+	).ConfidentialWitnessPubKeyHash()
+	require.NoError(t, err)
+	return addr
+}
@@ -1,7 +1,9 @@
 package wallet
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	"github.com/vulpemventures/go-elements/elementsutil"
@@ -113,3 +115,71 @@ func (q *txNotificationQueue) pop() func(ports.WalletTxNotification) bool {
 	q.list = q.list[1:]
 	return handler
 }
+
+// utxoLocks keeps track of the utxos reserved by the daemon, mapped to the
+// unix time at which they are released.
+type utxoLocks struct {
+	lock *sync.Mutex
+	list map[string]int64
+}
+
+// selectUtxos selects, among the given ones, the utxos not reserved to cover
+// the target amount and, if the expiry time is not zero, reserves them.
+func (l *utxoLocks) selectUtxos(
+	utxos []ports.Utxo, asset string, amount uint64, expiryTime int64,
+) ([]ports.Utxo, uint64, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now().Unix()
+	unlocked := make([]ports.Utxo, 0, len(utxos))
+	for _, u := range utxos {
+		if t, ok := l.list[utxoKey(u)]; ok && t > now {
+			continue
+		}
+		unlocked = append(unlocked, u)
+	}
+
+	selected, change, err := coinSelect(unlocked, asset, amount)
+	if err != nil {
+		return nil, 0, err
+	}
+	if expiryTime > 0 {
+		l.lockList(selected, expiryTime)
+	}
+	return selected, change, nil
+}
+
+func (l *utxoLocks) lockUtxos(utxos []ports.Utxo, expiryTime int64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.lockList(utxos, expiryTime)
+}
+
+func (l *utxoLocks) unlockUtxos(utxos []ports.Utxo) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for _, u := range utxos {
+		delete(l.list, utxoKey(u))
+	}
+}
+
+// lockList reserves the given utxos, and drops the expired reservations.
+// It must be called with the lock held.
+func (l *utxoLocks) lockList(utxos []ports.Utxo, expiryTime int64) {
+	now := time.Now().Unix()
+	for key, t := range l.list {
+		if t <= now {
+			delete(l.list, key)
+		}
+	}
+	for _, u := range utxos {
+		l.list[utxoKey(u)] = expiryTime
+	}
+}
+
+func utxoKey(u ports.Utxo) string {
+	return fmt.Sprintf("%s:%d", u.GetTxid(), u.GetIndex())
+}
@@ -1,6 +1,14 @@
 package wallet
 
-import "github.com/tdex-network/tdex-daemon/internal/core/ports"
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	"github.com/vulpemventures/go-elements/psetv2"
+)
 
 func totOutputAmountPerAsset(outs []ports.TxOutput) map[string]uint64 {
 	tot := make(map[string]uint64)
@@ -9,3 +17,76 @@ func totOutputAmountPerAsset(outs []ports.TxOutput) map[string]uint64 {
 	}
 	return tot
 }
+
+// swapRequestInsAndOuts returns the inputs and outputs of the given swap
+// request transaction, used to estimate the fees of the swap transaction.
+func swapRequestInsAndOuts(tx string) ([]ports.TxInput, []ports.TxOutput) {
+	inputs := make([]ports.TxInput, 0)
+	outputs := make([]ports.TxOutput, 0)
+
+	ptx, _ := psetv2.NewPsetFromBase64(tx)
+	if ptx == nil {
+		return inputs, outputs
+	}
+
+	for _, in := range ptx.Inputs {
+		var scriptSigSize, witnessSize int
+		if len(in.RedeemScript) > 0 {
+			// values for 2of2 native bare multisig inputs
+			scriptSigSize = 223
+		}
+		if len(in.WitnessScript) > 0 {
+			// values for 2of2 native or wrapped segwit multisig inputs
+			if scriptSigSize > 0 {
+				scriptSigSize = 35
+			}
+			witnessSize = 223
+		}
+		var script string
+		if prevout := in.GetUtxo(); prevout != nil {
+			script = hex.EncodeToString(prevout.Script)
+		}
+		inputs = append(inputs, input{
+			in.PreviousTxid, in.PreviousTxIndex, script, scriptSigSize, witnessSize,
+		})
+	}
+	for _, out := range ptx.Outputs {
+		outputs = append(outputs, output{
+			hex.EncodeToString(elementsutil.ReverseBytes(out.Asset)),
+			out.Value, hex.EncodeToString(out.Script), hex.EncodeToString(out.BlindingPubkey),
+		})
+	}
+	return inputs, outputs
+}
+
+// coinSelect is a simple largest-first coin selection over the given utxos.
+func coinSelect(
+	utxos []ports.Utxo, asset string, amount uint64,
+) ([]ports.Utxo, uint64, error) {
+	candidates := make([]ports.Utxo, 0, len(utxos))
+	for _, u := range utxos {
+		if u.GetAsset() == asset {
+			candidates = append(candidates, u)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].GetValue() > candidates[j].GetValue()
+	})
+
+	selected := make([]ports.Utxo, 0)
+	total := uint64(0)
+	for _, u := range candidates {
+		if total >= amount {
+			break
+		}
+		selected = append(selected, u)
+		total += u.GetValue()
+	}
+	if total < amount {
+		return nil, 0, fmt.Errorf(
+			"insufficient funds: %d of asset %s available, %d required",
+			total, asset, amount,
+		)
+	}
+	return selected, total - amount, nil
+}
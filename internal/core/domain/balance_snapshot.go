@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// AssetBalance holds the balance of a wallet account for a certain asset.
+type AssetBalance struct {
+	Confirmed   uint64
+	Unconfirmed uint64
+	Locked      uint64
+}
+
+// BalanceSnapshot holds the balance per asset of a wallet account at a given
+// time.
+type BalanceSnapshot struct {
+	AccountName     string
+	Timestamp       int64
+	BalancePerAsset map[string]AssetBalance
+}
+
+// Cursor returns the cursor identifying the snapshot in the history of its
+// account as well as in that of all accounts.
+func (s BalanceSnapshot) Cursor() Cursor {
+	return Cursor{s.Timestamp, s.AccountName}
+}
+
+func (s BalanceSnapshot) Key() string {
+	buf := []byte(fmt.Sprintf("%s:%d", s.AccountName, s.Timestamp))
+	key := hex.EncodeToString(btcutil.Hash160(buf))
+	return key
+}
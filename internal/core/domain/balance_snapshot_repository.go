@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// BalanceSnapshotRepository is the abstraction for any kind of database
+// intended to persist the history of the balances of the wallet accounts.
+type BalanceSnapshotRepository interface {
+	// AddBalanceSnapshots adds the provided snapshots to the repository. Those
+	// already existing for the same account and timestamp won't be re-added.
+	AddBalanceSnapshots(
+		ctx context.Context, snapshots []BalanceSnapshot,
+	) (int, error)
+	// GetBalanceSnapshots returns at most limit snapshots of the given account,
+	// or of all accounts if empty, taken within the given time range, bounds
+	// included, that follow the cursor, sorted from the most recent. A zero
+	// endTime means no upper bound, a nil cursor returns the first page and a
+	// non-positive limit all the following snapshots.
+	GetBalanceSnapshots(
+		ctx context.Context, accountName string, startTime, endTime int64,
+		cursor *Cursor, limit int,
+	) ([]BalanceSnapshot, error)
+}
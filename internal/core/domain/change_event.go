@@ -0,0 +1,35 @@
+package domain
+
+// ChangeType is the kind of change made to a stored entity.
+type ChangeType int
+
+const (
+	ChangeCreated ChangeType = iota
+	ChangeUpdated
+	ChangeDeleted
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeCreated:
+		return "CREATED"
+	case ChangeUpdated:
+		return "UPDATED"
+	case ChangeDeleted:
+		return "DELETED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ChangeEvent describes a change committed to one of the repositories. Only
+// the field of the changed entity is set, with its state right after the
+// change. Deleted entities carry only their identifiers.
+type ChangeEvent struct {
+	Type       ChangeType
+	Timestamp  int64
+	Market     *Market
+	Trade      *Trade
+	Deposit    *Deposit
+	Withdrawal *Withdrawal
+}
@@ -25,6 +25,14 @@ const (
 	MinPercentageFee = 0
 	MaxPercentageFee = 9999
 
+	// MinTradeExpiryTime and MaxTradeExpiryTime define the range in seconds of
+	// a market's trade expiry time.
+	MinTradeExpiryTime = 10
+	MaxTradeExpiryTime = 3600
+	// DefaultTradeExpiryTime is the trade expiry time in seconds of the markets
+	// that don't define their own.
+	DefaultTradeExpiryTime = 120
+
 	FeeAccount              = "fee_account"
 	FeeFragmenterAccount    = "fee_fragmenter_account"
 	MarketFragmenterAccount = "market_fragmenter_account"
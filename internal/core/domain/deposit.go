@@ -15,6 +15,24 @@ type Deposit struct {
 	Timestamp         int64
 }
 
+// Cursor returns the cursor identifying the deposit in the list of those of
+// its account.
+func (d Deposit) Cursor() Cursor {
+	return Cursor{d.Timestamp, d.TxID}
+}
+
+// AllAccountsCursor returns the cursor identifying the deposit in the list of
+// those of all accounts, where the same transaction can be a deposit for more
+// than one account.
+func (d Deposit) AllAccountsCursor() Cursor {
+	return Cursor{d.Timestamp, fmt.Sprintf("%s:%s", d.TxID, d.AccountName)}
+}
+
+// Matches returns whether the deposit satisfies the given filter.
+func (d Deposit) Matches(filter TxFilter) bool {
+	return filter.match(d.TxID, d.TotAmountPerAsset, d.Timestamp)
+}
+
 func (d Deposit) Key() string {
 	buf := []byte(fmt.Sprintf("%s:%s", d.AccountName, d.TxID))
 	key := hex.EncodeToString(btcutil.Hash160(buf))
@@ -5,13 +5,33 @@ import "context"
 // DepositRepository is the abstraction for any kind of database intended to
 // persist Deposits.
 type DepositRepository interface {
-	// AddDeposits adds the provided deposits to the repository. Those already
-	// existing won't be re-added.
-	AddDeposits(ctx context.Context, deposits []Deposit) (int, error)
+	// AddDeposits adds the provided deposits to the repository and returns
+	// those actually added. Those already existing won't be re-added.
+	AddDeposits(ctx context.Context, deposits []Deposit) ([]Deposit, error)
 	// GetDepositsForAccount returns the deposits related to the given account.
 	GetDepositsForAccount(
 		ctx context.Context, accountName string, page Page,
 	) ([]Deposit, error)
+	// GetDepositsForAccountAfter returns at most limit deposits of the given
+	// account matching the filter that follow the cursor, sorted from the most
+	// recent. A nil cursor returns the first page, a non-positive limit all the
+	// following deposits.
+	GetDepositsForAccountAfter(
+		ctx context.Context, accountName string, filter TxFilter,
+		cursor *Cursor, limit int,
+	) ([]Deposit, error)
+	// GetDepositsTotalAmountPerAsset returns the total amount per asset of the
+	// deposits of the given account matching the filter.
+	GetDepositsTotalAmountPerAsset(
+		ctx context.Context, accountName string, filter TxFilter,
+	) (map[string]uint64, error)
+	// GetAllDepositsAfter returns at most limit deposits of all accounts
+	// matching the filter that follow the cursor, as returned by
+	// Deposit.AllAccountsCursor, sorted from the most recent. A nil cursor
+	// returns the first page, a non-positive limit all the following deposits.
+	GetAllDepositsAfter(
+		ctx context.Context, filter TxFilter, cursor *Cursor, limit int,
+	) ([]Deposit, error)
 	// GetAllDeposits returns all deposits related to all markets.
 	GetAllDeposits(ctx context.Context, page Page) ([]Deposit, error)
 }
@@ -43,6 +43,12 @@ var (
 	// ErrMarketUnknownStrategy is thrown when an invalid strategy is given at
 	// market creation.
 	ErrMarketUnknownStrategy = errors.New("unknown market strategy")
+	// ErrMarketInvalidTradeExpiryTime is thrown when the given trade expiry
+	// time is out of range.
+	ErrMarketInvalidTradeExpiryTime = fmt.Errorf(
+		"invalid market trade expiry time, must be either 0 or in range [%d, %d]",
+		MinTradeExpiryTime, MaxTradeExpiryTime,
+	)
 )
 
 // Trade errors
@@ -73,6 +73,9 @@ type Market struct {
 	StrategyType int
 	// Pluggable Price of the asset pair.
 	Price MarketPrice
+	// Duration in seconds of the lock on the utxos selected for accepted trades.
+	// If zero, the daemon's default is used.
+	TradeExpiryTime uint64
 }
 
 // NewMarket returns a new market with an account index, the asset pair and the
@@ -239,6 +242,17 @@ func (m *Market) ChangePrice(basePrice, quotePrice decimal.Decimal) error {
 	return nil
 }
 
+// ChangeTradeExpiryTime updates the duration of the lock on the utxos
+// selected for the market's accepted trades. Zero resets it to the default.
+func (m *Market) ChangeTradeExpiryTime(expiryTime int64) error {
+	if !isValidTradeExpiryTime(expiryTime) {
+		return ErrMarketInvalidTradeExpiryTime
+	}
+
+	m.TradeExpiryTime = uint64(expiryTime)
+	return nil
+}
+
 func (m *Market) ChangeAssetPrecision(
 	baseAssetPrecision, quoteAssetPrecision int,
 ) error {
@@ -504,6 +518,11 @@ func isValidPrecision(precision uint) bool {
 	return int(precision) >= 0 && precision <= 8
 }
 
+func isValidTradeExpiryTime(expiryTime int64) bool {
+	return expiryTime == 0 ||
+		(expiryTime >= MinTradeExpiryTime && expiryTime <= MaxTradeExpiryTime)
+}
+
 func isValidStrategy(strategy uint) bool {
 	return strategy >= StrategyTypeUndefined && strategy <= StrategyTypeBalanced
 }
@@ -361,6 +361,54 @@ func TestFailingChangeFixedFee(t *testing.T) {
 	}
 }
 
+func TestChangeTradeExpiryTime(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMarketTradable()
+	expiryTime := int64(300)
+	err := m.ChangeTradeExpiryTime(expiryTime)
+	require.NoError(t, err)
+	require.Equal(t, int(expiryTime), int(m.TradeExpiryTime))
+
+	err = m.ChangeTradeExpiryTime(0)
+	require.NoError(t, err)
+	require.Zero(t, m.TradeExpiryTime)
+}
+
+func TestFailingChangeTradeExpiryTime(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		expiryTime    int64
+		expectedError error
+	}{
+		{
+			name:          "negative_expiry_time",
+			expiryTime:    -1,
+			expectedError: domain.ErrMarketInvalidTradeExpiryTime,
+		},
+		{
+			name:          "expiry_time_too_low",
+			expiryTime:    domain.MinTradeExpiryTime - 1,
+			expectedError: domain.ErrMarketInvalidTradeExpiryTime,
+		},
+		{
+			name:          "expiry_time_too_high",
+			expiryTime:    domain.MaxTradeExpiryTime + 1,
+			expectedError: domain.ErrMarketInvalidTradeExpiryTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestMarket()
+			err := m.ChangeTradeExpiryTime(tt.expiryTime)
+			require.EqualError(t, err, tt.expectedError.Error())
+		})
+	}
+}
+
 func TestChangeMarketMarketPrice(t *testing.T) {
 	t.Parallel()
 
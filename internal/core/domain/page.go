@@ -4,3 +4,24 @@ type Page interface {
 	GetNumber() int64
 	GetSize() int64
 }
+
+// Cursor identifies a record in a list sorted by timestamp and id, both in
+// descending order. Unlike a page number, a cursor always points to the same
+// record, therefore the pages following it don't shift when new records are
+// added to the list.
+type Cursor struct {
+	Timestamp int64
+	Id        string
+}
+
+// Precedes returns whether the record identified by the cursor comes before
+// the other one in the list. A nil cursor precedes any record.
+func (c *Cursor) Precedes(other Cursor) bool {
+	if c == nil {
+		return true
+	}
+	if c.Timestamp != other.Timestamp {
+		return c.Timestamp > other.Timestamp
+	}
+	return c.Id > other.Id
+}
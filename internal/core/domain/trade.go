@@ -249,6 +249,16 @@ func (t *Trade) Expire() (bool, error) {
 	return true, nil
 }
 
+// Cursor returns the cursor identifying the trade in the list of those of its
+// market, sorted by the time of the swap request.
+func (t *Trade) Cursor() Cursor {
+	var timestamp int64
+	if t.SwapRequest != nil {
+		timestamp = t.SwapRequest.Timestamp
+	}
+	return Cursor{timestamp, t.Id}
+}
+
 // IsEmpty returns whether the Trade is empty.
 func (t *Trade) IsEmpty() bool {
 	return t.Status.Code == TradeStatusCodeUndefined
@@ -286,6 +296,20 @@ func (t *Trade) IsExpired() bool {
 		(t.ExpiryTime > 0 && time.Now().After(time.Unix(t.ExpiryTime, 0)))
 }
 
+// IsArchivable returns whether the trade was proposed before the given time
+// and then either failed or expired, meaning it can be removed from the trade
+// store without affecting the stats of its market.
+func (t *Trade) IsArchivable(before int64) bool {
+	if t.Cursor().Timestamp >= before {
+		return false
+	}
+	if t.Status.Failed || t.Status.Code == TradeStatusCodeExpired {
+		return true
+	}
+	return t.Status.Code < TradeStatusCodeCompleted &&
+		t.ExpiryTime > 0 && t.ExpiryTime < before
+}
+
 // ContainsSwap returns whether a swap identified by its id belongs to the
 // current trade.
 func (t *Trade) ContainsSwap(swapID string) bool {
@@ -18,11 +18,29 @@ type TradeRepository interface {
 	GetAllTradesByMarket(
 		ctx context.Context, marketName string, page Page,
 	) ([]Trade, error)
+	// GetTradesByMarketAfter returns at most limit trades of the given market
+	// that follow the cursor, sorted from the most recent. A nil cursor
+	// returns the first page.
+	GetTradesByMarketAfter(
+		ctx context.Context, marketName string, cursor *Cursor, limit int,
+	) ([]Trade, error)
+	// GetAllTradesAfter returns at most limit trades of all markets, proposed
+	// within the given time range, that follow the cursor, sorted from the most
+	// recent. Bounds are included, a zero endTime means no upper bound. A nil
+	// cursor returns the first page.
+	GetAllTradesAfter(
+		ctx context.Context, startTime, endTime int64, cursor *Cursor, limit int,
+	) ([]Trade, error)
 	// GetCompletedTradesByMarket returns all the Completed or Settled trades
 	// for the provided market identified by its name.
 	GetCompletedTradesByMarket(
 		ctx context.Context, marketName string, page Page,
 	) ([]Trade, error)
+	// GetTradeBySwapRequestId returns the most recent trade of the given market
+	// that contains the SwapRequest message matching the given id, if existing.
+	GetTradeBySwapRequestId(
+		ctx context.Context, marketName, swapRequestId string,
+	) (*Trade, error)
 	// GetTradeBySwapAcceptId returns the trade that contains the SwapAccept
 	// message matching the given id.
 	GetTradeBySwapAcceptId(
@@ -31,6 +49,14 @@ type TradeRepository interface {
 	// GetTradeByTxid returns the trade which transaction matches the given
 	// transaction id.
 	GetTradeByTxId(ctx context.Context, txid string) (*Trade, error)
+	// GetArchivableTrades returns at most limit trades that can be archived
+	// because they were proposed before the given time and then failed or
+	// expired.
+	GetArchivableTrades(
+		ctx context.Context, before int64, limit int,
+	) ([]Trade, error)
+	// DeleteTrades removes the trades with the given ids from the repository.
+	DeleteTrades(ctx context.Context, ids []string) error
 	// UpdateTrade allowa to commit multiple changes to the same trade in a
 	// transactional way.
 	UpdateTrade(
@@ -0,0 +1,49 @@
+package domain
+
+// TxFilter restricts the deposits or withdrawals returned by the repository.
+// Empty fields are ignored: the zero value matches every record.
+type TxFilter struct {
+	// Asset matches the records moving the given asset.
+	Asset string
+	// TxID matches the record with the given transaction id.
+	TxID string
+	// StartTime and EndTime match the records with a timestamp within the
+	// given range, bounds included. A zero EndTime means no upper bound.
+	StartTime int64
+	EndTime   int64
+}
+
+// IsEmpty returns whether the filter matches every record.
+func (f TxFilter) IsEmpty() bool {
+	return f == TxFilter{}
+}
+
+func (f TxFilter) match(
+	txid string, totAmountPerAsset map[string]uint64, timestamp int64,
+) bool {
+	if f.TxID != "" && f.TxID != txid {
+		return false
+	}
+	if f.Asset != "" {
+		if _, ok := totAmountPerAsset[f.Asset]; !ok {
+			return false
+		}
+	}
+	if timestamp < f.StartTime {
+		return false
+	}
+	return f.EndTime <= 0 || timestamp <= f.EndTime
+}
+
+// AddAmounts adds the given amounts of a record matching the filter to the
+// per-asset totals, restricted to the filtered asset, if any.
+func (f TxFilter) AddAmounts(
+	totals map[string]uint64, totAmountPerAsset map[string]uint64,
+) {
+	for asset, amount := range totAmountPerAsset {
+		if f.Asset != "" && f.Asset != asset {
+			continue
+		}
+		totals[asset] += amount
+	}
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "fmt"
+
 // Withdrawal holds info about txs with funds sent from a wallet account.
 type Withdrawal struct {
 	AccountName       string
@@ -7,3 +9,20 @@ type Withdrawal struct {
 	TotAmountPerAsset map[string]uint64
 	Timestamp         int64
 }
+
+// Cursor returns the cursor identifying the withdrawal in the list of those
+// of its account.
+func (w Withdrawal) Cursor() Cursor {
+	return Cursor{w.Timestamp, w.TxID}
+}
+
+// AllAccountsCursor returns the cursor identifying the withdrawal in the list
+// of those of all accounts.
+func (w Withdrawal) AllAccountsCursor() Cursor {
+	return Cursor{w.Timestamp, fmt.Sprintf("%s:%s", w.TxID, w.AccountName)}
+}
+
+// Matches returns whether the withdrawal satisfies the given filter.
+func (w Withdrawal) Matches(filter TxFilter) bool {
+	return filter.match(w.TxID, w.TotAmountPerAsset, w.Timestamp)
+}
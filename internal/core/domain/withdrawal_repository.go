@@ -5,14 +5,37 @@ import "context"
 // WithdrawalRepository is the abstraction for any kind of database intended to
 // persist Withdrawals.
 type WithdrawalRepository interface {
-	// AddWithdrawals adds the provided withdrawals to the repository. Those already
-	// existing won't be re-added.
-	AddWithdrawals(ctx context.Context, withdrawals []Withdrawal) (int, error)
+	// AddWithdrawals adds the provided withdrawals to the repository and
+	// returns those actually added. Those already existing won't be re-added.
+	AddWithdrawals(
+		ctx context.Context, withdrawals []Withdrawal,
+	) ([]Withdrawal, error)
 	// GetWithdrawalsForAccount returns the list with the withdrawals related to
 	// the given market.
 	GetWithdrawalsForAccount(
 		ctx context.Context, accountName string, page Page,
 	) ([]Withdrawal, error)
+	// GetWithdrawalsForAccountAfter returns at most limit withdrawals of the
+	// given account matching the filter that follow the cursor, sorted from the
+	// most recent. A nil cursor returns the first page, a non-positive limit all
+	// the following withdrawals.
+	GetWithdrawalsForAccountAfter(
+		ctx context.Context, accountName string, filter TxFilter,
+		cursor *Cursor, limit int,
+	) ([]Withdrawal, error)
+	// GetWithdrawalsTotalAmountPerAsset returns the total amount per asset of the
+	// withdrawals of the given account matching the filter.
+	GetWithdrawalsTotalAmountPerAsset(
+		ctx context.Context, accountName string, filter TxFilter,
+	) (map[string]uint64, error)
+	// GetAllWithdrawalsAfter returns at most limit withdrawals of all accounts
+	// matching the filter that follow the cursor, as returned by
+	// Withdrawal.AllAccountsCursor, sorted from the most recent. A nil cursor
+	// returns the first page, a non-positive limit all the following
+	// withdrawals.
+	GetAllWithdrawalsAfter(
+		ctx context.Context, filter TxFilter, cursor *Cursor, limit int,
+	) ([]Withdrawal, error)
 	// GetAllWithdrawals returns all withdrawals related to all markets.
 	GetAllWithdrawals(ctx context.Context, page Page) ([]Withdrawal, error)
 }
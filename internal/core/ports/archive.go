@@ -0,0 +1,15 @@
+package ports
+
+import "github.com/tdex-network/tdex-daemon/internal/core/domain"
+
+// TradeArchive stores the trades removed from the trade repository by the
+// retention policy.
+type TradeArchive interface {
+	// Archive durably stores the given trades before they get removed from the
+	// repository. The same trade might be archived more than once if the
+	// daemon stops before they're removed.
+	Archive(trades []domain.Trade) error
+	// Location returns a human readable reference to where the archived trades
+	// are stored.
+	Location() string
+}
@@ -0,0 +1,28 @@
+package ports
+
+import "io"
+
+const (
+	// BackupEntryBadger identifies a backup entry made with the badger backup
+	// format, restored by loading it into a new badger db.
+	BackupEntryBadger = "badger"
+	// BackupEntryFile identifies a backup entry restored as a plain file.
+	BackupEntryFile = "file"
+	// BackupEntryArchive identifies the trade archive file, restored as a plain
+	// file into the archive dir rather than into the db datadir.
+	BackupEntryArchive = "archive"
+)
+
+// BackupWriter is used by a Backupable to add the consistent snapshot of its
+// stores to a backup. Every entry is identified by the path of the store,
+// relative to the db datadir, or to the archive dir for the trade archive, and
+// by its kind.
+type BackupWriter interface {
+	WriteEntry(name, kind string, fn func(w io.Writer) error) error
+}
+
+// Backupable is implemented by the services whose stores can be backed up
+// while the daemon is running.
+type Backupable interface {
+	Backup(w BackupWriter) error
+}
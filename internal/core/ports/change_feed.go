@@ -0,0 +1,17 @@
+package ports
+
+import "github.com/tdex-network/tdex-daemon/internal/core/domain"
+
+// ChangeFeed is the internal bus through which the storage layer notifies
+// the changes committed to the repositories.
+type ChangeFeed interface {
+	// Publish notifies the given events to all subscribers, in order. It's
+	// meant to be called only once the changes have been committed.
+	Publish(events ...domain.ChangeEvent)
+	// Subscribe registers a handler called, in order and in a dedicated
+	// goroutine, for every published event, and returns the function to
+	// unsubscribe it.
+	Subscribe(name string, handler func(domain.ChangeEvent)) func()
+	// Close unsubscribes all handlers.
+	Close()
+}
@@ -1,6 +1,8 @@
 package ports
 
 import (
+	"context"
+
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 )
 
@@ -10,6 +12,14 @@ type RepoManager interface {
 	TradeRepository() domain.TradeRepository
 	DepositRepository() domain.DepositRepository
 	WithdrawalRepository() domain.WithdrawalRepository
+	BalanceSnapshotRepository() domain.BalanceSnapshotRepository
+
+	// RunReadTransaction runs fn within a read-only transaction, so that all
+	// the reads made through the repositories with the context passed to fn
+	// see the same snapshot of the stores.
+	RunReadTransaction(
+		ctx context.Context, fn func(ctx context.Context) error,
+	) error
 
 	Close()
 }
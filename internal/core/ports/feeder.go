@@ -1,12 +1,37 @@
 package ports
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+const (
+	// PriceAggregationMedian makes a composite price feed use the median of
+	// the prices of its sources.
+	PriceAggregationMedian = iota
+	// PriceAggregationVolumeWeighted makes a composite price feed use the
+	// average of the prices of its sources, weighted by their traded volume.
+	PriceAggregationVolumeWeighted
+)
 
 type PriceFeeder interface {
 	// AddPriceFeed adds a new price feed for the target market.
 	AddPriceFeed(
 		ctx context.Context, market Market, source, ticker string,
 	) (string, error)
+	// AddCompositePriceFeed adds a new price feed for the target market that
+	// combines the prices of the given sources.
+	AddCompositePriceFeed(
+		ctx context.Context, market Market,
+		sources []PriceSource, aggregation PriceAggregation,
+	) (string, error)
+	// AddDerivedPriceFeed adds a new price feed for the target market whose
+	// price is the product of the prices, or their inverse, of the given legs.
+	// The price of a leg is considered stale once older than maxAge seconds,
+	// defaulting to 60 if zero.
+	AddDerivedPriceFeed(
+		ctx context.Context, market Market, legs []PriceLeg, maxAge uint32,
+	) (string, error)
 	// StartFeed starts forwarding price feeds from the given source to the
 	// target market.
 	StartPriceFeed(ctx context.Context, id string) (chan PriceFeed, error)
@@ -14,12 +39,44 @@ type PriceFeeder interface {
 	StopPriceFeed(ctx context.Context, id string) error
 	// UpdatePriceFeed updates an existing price feed.
 	UpdatePriceFeed(ctx context.Context, id, source, ticker string) error
+	// UpdateCompositePriceFeed updates the sources and/or the aggregation
+	// settings of an existing price feed, making it composite if not already.
+	UpdateCompositePriceFeed(
+		ctx context.Context, id string,
+		sources []PriceSource, aggregation PriceAggregation,
+	) error
+	// UpdateDerivedPriceFeed updates the legs and/or the max age of their
+	// prices of an existing price feed, making it derived if not already.
+	// Nil legs and zero max age are left unchanged.
+	UpdateDerivedPriceFeed(
+		ctx context.Context, id string, legs []PriceLeg, maxAge uint32,
+	) error
+	// UpdatePriceFeedTransformation updates the settings applied to the prices
+	// of an existing price feed before updating the market price.
+	UpdatePriceFeedTransformation(
+		ctx context.Context, id string, transformation PriceTransformation,
+	) error
+	// PushPrice notifies the given price for a started price feed, if its
+	// source supports it.
+	PushPrice(ctx context.Context, id string, price MarketPrice) error
 	// RemovePriceFeed removes an existing price feed.
 	RemovePriceFeed(ctx context.Context, id string) error
 	// GetPriceFeed returns info about the target price feed.
 	GetPriceFeed(ctx context.Context, id string) (PriceFeedInfo, error)
 	// ListPriceFeeds returns the list of price feeds.
 	ListPriceFeeds(ctx context.Context) ([]PriceFeedInfo, error)
+	// AddAppliedPrice records the price applied to the market by the given
+	// price feed, along with the received tick that produced it.
+	AddAppliedPrice(
+		ctx context.Context, id, tickId string, price MarketPrice,
+	) error
+	// ListPriceTicks returns the prices received and/or applied by the given
+	// price feed within the given time range, most recent first. Zero times
+	// mean unbounded.
+	ListPriceTicks(
+		ctx context.Context, id string, tickType PriceTickType,
+		startTime, endTime time.Time, page Page,
+	) ([]PriceTick, error)
 	// ListSources returns the list of supported price sources.
 	ListSources(ctx context.Context) []string
 
@@ -0,0 +1,25 @@
+package ports
+
+// StoreStats describes the usage of a store on disk.
+type StoreStats interface {
+	// GetName returns the path of the store, relative to the db datadir.
+	GetName() string
+	// GetDiskUsage returns the size in bytes of the store on disk.
+	GetDiskUsage() int64
+	// GetKeyCount returns the number of keys of the store.
+	GetKeyCount() uint64
+	// GetLastGC returns the timestamp of the last garbage collection or
+	// compaction of the store, zero if never.
+	GetLastGC() int64
+}
+
+// Maintainable is implemented by the services whose stores can be inspected
+// and garbage collected while the daemon is running.
+type Maintainable interface {
+	// StoreStats returns the usage stats of every store.
+	StoreStats() ([]StoreStats, error)
+	// RunGC reclaims the disk space left unused by the stores. With compact,
+	// the stores are also rewritten entirely, which is slower but frees the
+	// most space.
+	RunGC(compact bool) error
+}
@@ -90,6 +90,7 @@ type MarketInfo interface {
 	GetPrice() MarketPrice
 	GetBaseAssetPrecision() uint32
 	GetQuoteAssetPrecision() uint32
+	GetTradeExpiryTime() uint64
 }
 
 type MarketReport interface {
@@ -160,6 +161,13 @@ type TradePreview interface {
 	GetFeeAsset() string
 }
 
+type TradeDryRun interface {
+	IsAccepted() bool
+	GetReason() string
+	GetSwapFail() SwapFail
+	GetNetworkFeeAmount() uint64
+}
+
 type TimeRange interface {
 	GetPredefinedPeriod() PredefinedPeriod
 	GetCustomPeriod() CustomPeriod
@@ -184,6 +192,7 @@ type CustomPeriod interface {
 type Page interface {
 	GetNumber() int64
 	GetSize() int64
+	GetToken() string
 }
 
 type TradeStatus interface {
@@ -239,6 +248,38 @@ type Withdrawal interface {
 	GetTimestamp() int64
 }
 
+// TxFilter restricts the listed deposits or withdrawals to those moving the
+// given asset, made within the given time range or with the given txid. Empty
+// fields are ignored.
+type TxFilter interface {
+	GetAsset() string
+	GetTxid() string
+	GetTimeRange() TimeRange
+}
+
+type LedgerEntryType interface {
+	IsTrade() bool
+	IsDeposit() bool
+	IsWithdrawal() bool
+}
+
+// BalanceSnapshot is the balance per asset of a wallet account recorded at a
+// given time.
+type BalanceSnapshot interface {
+	GetAccountName() string
+	GetTimestamp() int64
+	GetBalance() map[string]Balance
+}
+
+type LedgerEntry interface {
+	GetType() LedgerEntryType
+	GetAccountName() string
+	GetTimestamp() int64
+	GetTrade() Trade
+	GetDeposit() Deposit
+	GetWithdrawal() Withdrawal
+}
+
 type Webhook interface {
 	GetId() string
 	GetEvent() WebhookEvent
@@ -252,6 +293,9 @@ type WebhookEvent interface {
 	IsAccountLowBalance() bool
 	IsAccountWithdraw() bool
 	IsAccountDeposit() bool
+	IsTradeUpdated() bool
+	IsMarketUpdated() bool
+	IsPriceFeedDeviation() bool
 	IsAny() bool
 }
 
@@ -279,9 +323,108 @@ type PriceFeedInfo interface {
 	GetSource() string
 	GetTicker() string
 	IsStarted() bool
+	// GetSources returns the sources of a composite price feed, nil otherwise.
+	GetSources() []PriceSource
+	// GetAggregation returns how the prices of the sources of a composite
+	// price feed are combined, nil otherwise.
+	GetAggregation() PriceAggregation
+	// GetLegs returns the legs of a derived price feed, nil otherwise.
+	GetLegs() []PriceLeg
+	// GetLegsMaxAge returns the number of seconds after which the price of a
+	// leg of a derived price feed is considered stale, zero otherwise.
+	GetLegsMaxAge() uint32
+	// GetTransformation returns the settings applied to the prices of the feed
+	// before updating the market price.
+	GetTransformation() PriceTransformation
+	// GetHealth returns the runtime health of a started price feed, nil if
+	// the feed is stopped.
+	GetHealth() PriceFeedHealth
+}
+
+type PriceFeedHealth interface {
+	// GetLastPrice returns the last price received by the feed, nil if none
+	// was received yet.
+	GetLastPrice() MarketPrice
+	// GetLastUpdate returns the timestamp of the last price received by the
+	// feed, zero if none was received yet.
+	GetLastUpdate() int64
+	// GetUpdateRate returns the average number of prices received per minute
+	// since the feed was started.
+	GetUpdateRate() float64
+	// GetReconnections returns the number of times the connection with the
+	// sources of the feed has been restored.
+	GetReconnections() uint64
+	// GetLastError returns the last error occurred with the sources of the
+	// feed, if any.
+	GetLastError() string
+}
+
+type PriceTransformation interface {
+	// GetMarkupBps returns the markup, or the markdown if negative, in basis
+	// points applied to the quote price.
+	GetMarkupBps() int32
+	// GetMinUpdateInterval returns the min number of seconds between two
+	// updates of the market price. Prices received meanwhile are debounced,
+	// only the last one is applied once the interval has elapsed.
+	GetMinUpdateInterval() uint32
+	// IsRoundingEnabled returns whether prices are rounded to the precision of
+	// the market assets.
+	IsRoundingEnabled() bool
+}
+
+type PriceSource interface {
+	GetSource() string
+	GetTicker() string
+}
+
+// PriceLeg is one of the tickers a derived price feed is computed from.
+type PriceLeg interface {
+	PriceSource
+	// IsInverse returns whether the inverse of the ticker price is used.
+	IsInverse() bool
+}
+
+type PriceAggregation interface {
+	// GetMethod returns either PriceAggregationMedian or
+	// PriceAggregationVolumeWeighted.
+	GetMethod() int
+	// GetMaxDeviationBps returns the max distance in basis points from the
+	// median, above which the price of a source is discarded. Zero means no
+	// source is ever discarded.
+	GetMaxDeviationBps() uint32
+	// GetQuorum returns the min number of fresh and not discarded sources
+	// required to update the market price.
+	GetQuorum() uint32
+	// GetMaxAge returns the number of seconds after which the last price of a
+	// source is considered stale.
+	GetMaxAge() uint32
 }
 
 type PriceFeed interface {
 	GetMarket() Market
 	GetPrice() MarketPrice
+	// GetTickId returns the id of the received price tick that produced the
+	// price update.
+	GetTickId() string
+}
+
+// PriceTick is a price received by a price feed from one of its sources, or
+// applied by it to the market price.
+type PriceTick interface {
+	GetId() string
+	GetPriceFeedId() string
+	GetType() PriceTickType
+	// GetOriginId returns the id of the received tick an applied one was
+	// produced by, empty for received ticks.
+	GetOriginId() string
+	GetSource() string
+	GetTicker() string
+	GetPrice() MarketPrice
+	// GetTimestamp returns the time of the tick in unix milliseconds.
+	GetTimestamp() int64
+}
+
+type PriceTickType interface {
+	IsReceived() bool
+	IsApplied() bool
 }
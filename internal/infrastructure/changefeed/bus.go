@@ -0,0 +1,86 @@
+package changefeed
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// queueSize is the max number of events buffered for every subscriber.
+// Events are dropped for subscribers lagging further behind, so that a slow
+// one never blocks the writes to the repositories.
+const queueSize = 1024
+
+type subscriber struct {
+	name   string
+	events chan domain.ChangeEvent
+}
+
+type bus struct {
+	lock        *sync.RWMutex
+	subscribers map[int]*subscriber
+	nextId      int
+}
+
+// NewBus returns an in-memory ChangeFeed.
+func NewBus() ports.ChangeFeed {
+	return &bus{&sync.RWMutex{}, make(map[int]*subscriber), 0}
+}
+
+func (b *bus) Publish(events ...domain.ChangeEvent) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for _, event := range events {
+		for _, sub := range b.subscribers {
+			select {
+			case sub.events <- event:
+			default:
+				log.Warnf(
+					"change feed: dropped %s event for lagging subscriber %s",
+					event.Type, sub.name,
+				)
+			}
+		}
+	}
+}
+
+func (b *bus) Subscribe(
+	name string, handler func(domain.ChangeEvent),
+) func() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	id := b.nextId
+	b.nextId++
+	sub := &subscriber{name, make(chan domain.ChangeEvent, queueSize)}
+	b.subscribers[id] = sub
+
+	go func() {
+		for event := range sub.events {
+			handler(event)
+		}
+	}()
+
+	return func() {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+
+		if _, ok := b.subscribers[id]; ok {
+			delete(b.subscribers, id)
+			close(sub.events)
+		}
+	}
+}
+
+func (b *bus) Close() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for id, sub := range b.subscribers {
+		delete(b.subscribers, id)
+		close(sub.events)
+	}
+}
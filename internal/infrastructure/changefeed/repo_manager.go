@@ -0,0 +1,309 @@
+package changefeed
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// repoManager decorates a RepoManager so that its repositories publish to the
+// change feed the changes they commit. Events are published only after the
+// underlying write returns successfully, that is once the related
+// transaction is committed, therefore no event is ever published for a
+// change that's been rolled back.
+// Market price updates aren't published: they happen at every tick of the
+// price feeds and would flood the feed, delaying or dropping the events of
+// the other changes.
+type repoManager struct {
+	ports.RepoManager
+	feed ports.ChangeFeed
+
+	marketRepository     domain.MarketRepository
+	tradeRepository      domain.TradeRepository
+	depositRepository    domain.DepositRepository
+	withdrawalRepository domain.WithdrawalRepository
+}
+
+// NewRepoManager returns a RepoManager that publishes to the given feed the
+// changes committed to the markets, trades, deposits and withdrawals stored
+// by the given one.
+func NewRepoManager(
+	repo ports.RepoManager, feed ports.ChangeFeed,
+) ports.RepoManager {
+	return &repoManager{
+		RepoManager:          repo,
+		feed:                 feed,
+		marketRepository:     marketRepository{repo.MarketRepository(), feed},
+		tradeRepository:      tradeRepository{repo.TradeRepository(), feed},
+		depositRepository:    depositRepository{repo.DepositRepository(), feed},
+		withdrawalRepository: withdrawalRepository{repo.WithdrawalRepository(), feed},
+	}
+}
+
+func (r *repoManager) MarketRepository() domain.MarketRepository {
+	return r.marketRepository
+}
+
+func (r *repoManager) TradeRepository() domain.TradeRepository {
+	return r.tradeRepository
+}
+
+func (r *repoManager) DepositRepository() domain.DepositRepository {
+	return r.depositRepository
+}
+
+func (r *repoManager) WithdrawalRepository() domain.WithdrawalRepository {
+	return r.withdrawalRepository
+}
+
+// Close closes the decorated repo manager and then the feed, since no more
+// changes can be published afterwards.
+func (r *repoManager) Close() {
+	r.RepoManager.Close()
+	r.feed.Close()
+}
+
+// Backup makes the decorated repo manager included in backups, if supported.
+func (r *repoManager) Backup(w ports.BackupWriter) error {
+	if store, ok := r.RepoManager.(ports.Backupable); ok {
+		return store.Backup(w)
+	}
+	return nil
+}
+
+// StoreStats returns the usage stats of the stores of the decorated repo
+// manager, if supported.
+func (r *repoManager) StoreStats() ([]ports.StoreStats, error) {
+	if store, ok := r.RepoManager.(ports.Maintainable); ok {
+		return store.StoreStats()
+	}
+	return nil, nil
+}
+
+// RunGC runs the GC of the stores of the decorated repo manager, if
+// supported.
+func (r *repoManager) RunGC(compact bool) error {
+	if store, ok := r.RepoManager.(ports.Maintainable); ok {
+		return store.RunGC(compact)
+	}
+	return nil
+}
+
+type marketRepository struct {
+	domain.MarketRepository
+	feed ports.ChangeFeed
+}
+
+func (r marketRepository) AddMarket(
+	ctx context.Context, market *domain.Market,
+) error {
+	if err := r.MarketRepository.AddMarket(ctx, market); err != nil {
+		return err
+	}
+	r.publish(domain.ChangeCreated, *market)
+	return nil
+}
+
+func (r marketRepository) UpdateMarket(
+	ctx context.Context,
+	marketName string, updateFn func(m *domain.Market) (*domain.Market, error),
+) error {
+	// The update function may be called more than once in case of conflicts,
+	// only the result of the last call is committed.
+	var updated *domain.Market
+	if err := r.MarketRepository.UpdateMarket(
+		ctx, marketName, func(m *domain.Market) (*domain.Market, error) {
+			mkt, err := updateFn(m)
+			if err == nil && mkt != nil {
+				m := *mkt
+				updated = &m
+			}
+			return mkt, err
+		},
+	); err != nil {
+		return err
+	}
+	if updated != nil {
+		r.publish(domain.ChangeUpdated, *updated)
+	}
+	return nil
+}
+
+func (r marketRepository) OpenMarket(
+	ctx context.Context, marketName string,
+) error {
+	if err := r.MarketRepository.OpenMarket(ctx, marketName); err != nil {
+		return err
+	}
+	r.publishStored(ctx, marketName)
+	return nil
+}
+
+func (r marketRepository) CloseMarket(
+	ctx context.Context, marketName string,
+) error {
+	if err := r.MarketRepository.CloseMarket(ctx, marketName); err != nil {
+		return err
+	}
+	r.publishStored(ctx, marketName)
+	return nil
+}
+
+func (r marketRepository) DeleteMarket(
+	ctx context.Context, marketName string,
+) error {
+	if err := r.MarketRepository.DeleteMarket(ctx, marketName); err != nil {
+		return err
+	}
+	r.publish(domain.ChangeDeleted, domain.Market{Name: marketName})
+	return nil
+}
+
+// publishStored publishes the update of the given market with its state as
+// read right after the change.
+func (r marketRepository) publishStored(ctx context.Context, marketName string) {
+	market, err := r.MarketRepository.GetMarketByName(ctx, marketName)
+	if err != nil || market == nil {
+		log.WithError(err).Warnf(
+			"change feed: failed to read updated market %s", marketName,
+		)
+		return
+	}
+	r.publish(domain.ChangeUpdated, *market)
+}
+
+func (r marketRepository) publish(
+	changeType domain.ChangeType, market domain.Market,
+) {
+	r.feed.Publish(domain.ChangeEvent{
+		Type:      changeType,
+		Timestamp: time.Now().Unix(),
+		Market:    &market,
+	})
+}
+
+type tradeRepository struct {
+	domain.TradeRepository
+	feed ports.ChangeFeed
+}
+
+func (r tradeRepository) AddTrade(
+	ctx context.Context, trade *domain.Trade,
+) error {
+	if err := r.TradeRepository.AddTrade(ctx, trade); err != nil {
+		return err
+	}
+	r.publish(domain.ChangeCreated, *trade)
+	return nil
+}
+
+func (r tradeRepository) UpdateTrade(
+	ctx context.Context,
+	tradeId string, updateFn func(t *domain.Trade) (*domain.Trade, error),
+) error {
+	// The update function may be called more than once in case of conflicts,
+	// only the result of the last call is committed.
+	var updated *domain.Trade
+	if err := r.TradeRepository.UpdateTrade(
+		ctx, tradeId, func(t *domain.Trade) (*domain.Trade, error) {
+			trade, err := updateFn(t)
+			if err == nil && trade != nil {
+				t := *trade
+				updated = &t
+			}
+			return trade, err
+		},
+	); err != nil {
+		return err
+	}
+	if updated != nil {
+		r.publish(domain.ChangeUpdated, *updated)
+	}
+	return nil
+}
+
+func (r tradeRepository) DeleteTrades(
+	ctx context.Context, ids []string,
+) error {
+	if err := r.TradeRepository.DeleteTrades(ctx, ids); err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	events := make([]domain.ChangeEvent, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, domain.ChangeEvent{
+			Type:      domain.ChangeDeleted,
+			Timestamp: now,
+			Trade:     &domain.Trade{Id: id},
+		})
+	}
+	r.feed.Publish(events...)
+	return nil
+}
+
+func (r tradeRepository) publish(
+	changeType domain.ChangeType, trade domain.Trade,
+) {
+	r.feed.Publish(domain.ChangeEvent{
+		Type:      changeType,
+		Timestamp: time.Now().Unix(),
+		Trade:     &trade,
+	})
+}
+
+type depositRepository struct {
+	domain.DepositRepository
+	feed ports.ChangeFeed
+}
+
+func (r depositRepository) AddDeposits(
+	ctx context.Context, deposits []domain.Deposit,
+) ([]domain.Deposit, error) {
+	added, err := r.DepositRepository.AddDeposits(ctx, deposits)
+	if err != nil || len(added) <= 0 {
+		return added, err
+	}
+
+	now := time.Now().Unix()
+	events := make([]domain.ChangeEvent, 0, len(added))
+	for i := range added {
+		deposit := added[i]
+		events = append(events, domain.ChangeEvent{
+			Type:      domain.ChangeCreated,
+			Timestamp: now,
+			Deposit:   &deposit,
+		})
+	}
+	r.feed.Publish(events...)
+	return added, nil
+}
+
+type withdrawalRepository struct {
+	domain.WithdrawalRepository
+	feed ports.ChangeFeed
+}
+
+func (r withdrawalRepository) AddWithdrawals(
+	ctx context.Context, withdrawals []domain.Withdrawal,
+) ([]domain.Withdrawal, error) {
+	added, err := r.WithdrawalRepository.AddWithdrawals(ctx, withdrawals)
+	if err != nil || len(added) <= 0 {
+		return added, err
+	}
+
+	now := time.Now().Unix()
+	events := make([]domain.ChangeEvent, 0, len(added))
+	for i := range added {
+		withdrawal := added[i]
+		events = append(events, domain.ChangeEvent{
+			Type:       domain.ChangeCreated,
+			Timestamp:  now,
+			Withdrawal: &withdrawal,
+		})
+	}
+	r.feed.Publish(events...)
+	return added, nil
+}
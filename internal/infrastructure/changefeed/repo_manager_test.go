@@ -0,0 +1,184 @@
+package changefeed_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/changefeed"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+)
+
+var (
+	baseAsset  = "5ac9f65c0efcc4775e0baec4ec03abdde22473cd3cf33c0419ca290e0751b225"
+	quoteAsset = "0000000000000000000000000000000000000000000000000000000000000001"
+)
+
+func TestRepoManager(t *testing.T) {
+	feed := changefeed.NewBus()
+	repo := changefeed.NewRepoManager(inmemory.NewRepoManager(), feed)
+	defer repo.Close()
+
+	events := make(chan domain.ChangeEvent, 100)
+	unsubscribe := feed.Subscribe("test", func(e domain.ChangeEvent) {
+		events <- e
+	})
+	defer unsubscribe()
+
+	ctx := context.Background()
+
+	t.Run("market", func(t *testing.T) {
+		market, err := domain.NewMarket(
+			baseAsset, quoteAsset, "", 25, 25, 0, 0, 8, 8, 0,
+		)
+		require.NoError(t, err)
+
+		err = repo.MarketRepository().AddMarket(ctx, market)
+		require.NoError(t, err)
+		event := waitForEvent(t, events)
+		require.Equal(t, domain.ChangeCreated, event.Type)
+		require.NotNil(t, event.Market)
+		require.Equal(t, market.Name, event.Market.Name)
+
+		err = repo.MarketRepository().UpdateMarket(
+			ctx, market.Name, func(m *domain.Market) (*domain.Market, error) {
+				if err := m.ChangePercentageFee(50, 50); err != nil {
+					return nil, err
+				}
+				return m, nil
+			},
+		)
+		require.NoError(t, err)
+		event = waitForEvent(t, events)
+		require.Equal(t, domain.ChangeUpdated, event.Type)
+		require.NotNil(t, event.Market)
+		require.Equal(t, uint64(50), event.Market.PercentageFee.BaseAsset)
+
+		// Failing updates are not notified.
+		err = repo.MarketRepository().UpdateMarket(
+			ctx, market.Name, func(m *domain.Market) (*domain.Market, error) {
+				return nil, fmt.Errorf("failed")
+			},
+		)
+		require.Error(t, err)
+		requireNoEvent(t, events)
+
+		// Price updates are not notified.
+		err = repo.MarketRepository().UpdateMarketPrice(
+			ctx, market.Name, domain.MarketPrice{BasePrice: "10", QuotePrice: "0.1"},
+		)
+		require.NoError(t, err)
+		requireNoEvent(t, events)
+
+		err = repo.MarketRepository().DeleteMarket(ctx, market.Name)
+		require.NoError(t, err)
+		event = waitForEvent(t, events)
+		require.Equal(t, domain.ChangeDeleted, event.Type)
+		require.Equal(t, market.Name, event.Market.Name)
+	})
+
+	t.Run("trade", func(t *testing.T) {
+		trade := domain.NewTrade()
+		err := repo.TradeRepository().AddTrade(ctx, trade)
+		require.NoError(t, err)
+		event := waitForEvent(t, events)
+		require.Equal(t, domain.ChangeCreated, event.Type)
+		require.NotNil(t, event.Trade)
+		require.Equal(t, trade.Id, event.Trade.Id)
+
+		err = repo.TradeRepository().UpdateTrade(
+			ctx, trade.Id, func(t *domain.Trade) (*domain.Trade, error) {
+				t.TxId = "txid"
+				return t, nil
+			},
+		)
+		require.NoError(t, err)
+		event = waitForEvent(t, events)
+		require.Equal(t, domain.ChangeUpdated, event.Type)
+		require.Equal(t, "txid", event.Trade.TxId)
+
+		err = repo.TradeRepository().DeleteTrades(ctx, []string{trade.Id})
+		require.NoError(t, err)
+		event = waitForEvent(t, events)
+		require.Equal(t, domain.ChangeDeleted, event.Type)
+		require.Equal(t, trade.Id, event.Trade.Id)
+	})
+
+	t.Run("deposits", func(t *testing.T) {
+		deposits := []domain.Deposit{
+			{
+				AccountName:       domain.FeeAccount,
+				TxID:              "txid1",
+				TotAmountPerAsset: map[string]uint64{baseAsset: 100},
+				Timestamp:         time.Now().Unix(),
+			},
+		}
+		added, err := repo.DepositRepository().AddDeposits(ctx, deposits)
+		require.NoError(t, err)
+		require.Len(t, added, 1)
+		event := waitForEvent(t, events)
+		require.Equal(t, domain.ChangeCreated, event.Type)
+		require.NotNil(t, event.Deposit)
+		require.Equal(t, "txid1", event.Deposit.TxID)
+
+		// Deposits already stored are not notified again.
+		deposits = append(deposits, domain.Deposit{
+			AccountName:       domain.FeeAccount,
+			TxID:              "txid2",
+			TotAmountPerAsset: map[string]uint64{baseAsset: 100},
+			Timestamp:         time.Now().Unix(),
+		})
+		added, err = repo.DepositRepository().AddDeposits(ctx, deposits)
+		require.NoError(t, err)
+		require.Len(t, added, 1)
+		require.Equal(t, "txid2", added[0].TxID)
+		event = waitForEvent(t, events)
+		require.Equal(t, "txid2", event.Deposit.TxID)
+		requireNoEvent(t, events)
+	})
+
+	t.Run("withdrawals", func(t *testing.T) {
+		withdrawals := []domain.Withdrawal{
+			{
+				AccountName:       domain.FeeAccount,
+				TxID:              "txid1",
+				TotAmountPerAsset: map[string]uint64{baseAsset: 100},
+				Timestamp:         time.Now().Unix(),
+			},
+		}
+		added, err := repo.WithdrawalRepository().AddWithdrawals(ctx, withdrawals)
+		require.NoError(t, err)
+		require.Len(t, added, 1)
+		event := waitForEvent(t, events)
+		require.Equal(t, domain.ChangeCreated, event.Type)
+		require.NotNil(t, event.Withdrawal)
+
+		added, err = repo.WithdrawalRepository().AddWithdrawals(ctx, withdrawals)
+		require.NoError(t, err)
+		require.Empty(t, added)
+		requireNoEvent(t, events)
+	})
+}
+
+func waitForEvent(
+	t *testing.T, events chan domain.ChangeEvent,
+) domain.ChangeEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for change event")
+	}
+	return domain.ChangeEvent{}
+}
+
+func requireNoEvent(t *testing.T, events chan domain.ChangeEvent) {
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected %s change event", event.Type)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,198 @@
+package offlinewallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// ErrWalletNotAvailable is returned by every wallet operation, the daemon
+// never connects to ocean when running in read-only mode.
+var ErrWalletNotAvailable = fmt.Errorf("wallet not available in read-only mode")
+
+// service is a wallet service that is not backed by any wallet. It's used by
+// read-only replicas of the daemon, that serve only data from the datadir
+// and must never sign or broadcast transactions.
+type service struct {
+	lock                sync.Mutex
+	closed              bool
+	chTxNotifications   chan ports.WalletTxNotification
+	chUtxoNotifications chan ports.WalletUtxoNotification
+}
+
+func NewService() ports.WalletService {
+	return &service{
+		chTxNotifications:   make(chan ports.WalletTxNotification),
+		chUtxoNotifications: make(chan ports.WalletUtxoNotification),
+	}
+}
+
+func (s *service) Wallet() ports.Wallet {
+	return wallet{}
+}
+
+func (s *service) Account() ports.Account {
+	return account{}
+}
+
+func (s *service) Transaction() ports.Transaction {
+	return transaction{}
+}
+
+func (s *service) Notification() ports.Notification {
+	return s
+}
+
+func (s *service) GetTxNotifications() chan ports.WalletTxNotification {
+	return s.chTxNotifications
+}
+
+func (s *service) GetUtxoNotifications() chan ports.WalletUtxoNotification {
+	return s.chUtxoNotifications
+}
+
+// Close closes the notification channels, no notification is ever sent
+// through them.
+func (s *service) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	close(s.chTxNotifications)
+	close(s.chUtxoNotifications)
+	s.closed = true
+}
+
+type wallet struct{}
+
+func (wallet) GenSeed(context.Context) ([]string, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (wallet) InitWallet(context.Context, []string, string) error {
+	return ErrWalletNotAvailable
+}
+func (wallet) RestoreWallet(context.Context, []string, string) error {
+	return ErrWalletNotAvailable
+}
+func (wallet) Unlock(context.Context, string) error {
+	return ErrWalletNotAvailable
+}
+func (wallet) Lock(context.Context, string) error {
+	return ErrWalletNotAvailable
+}
+func (wallet) ChangePassword(context.Context, string, string) error {
+	return ErrWalletNotAvailable
+}
+func (wallet) Status(context.Context) (ports.WalletStatus, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (wallet) Auth(context.Context, string) (bool, error) {
+	return false, ErrWalletNotAvailable
+}
+
+// Info returns an empty info, the application services only need it at
+// startup to know about the network and the accounts of the wallet.
+func (wallet) Info(context.Context) (ports.WalletInfo, error) {
+	return walletInfo{}, nil
+}
+
+type account struct{}
+
+func (account) CreateAccount(
+	context.Context, string, bool,
+) (ports.WalletAccount, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (account) DeriveAddresses(context.Context, string, int) ([]string, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (account) DeriveChangeAddresses(
+	context.Context, string, int,
+) ([]string, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (account) ListAddresses(context.Context, string) ([]string, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (account) GetBalance(
+	context.Context, string,
+) (map[string]ports.Balance, error) {
+	return nil, ErrWalletNotAvailable
+}
+func (account) ListUtxos(
+	context.Context, string,
+) ([]ports.Utxo, []ports.Utxo, error) {
+	return nil, nil, ErrWalletNotAvailable
+}
+func (account) DeleteAccount(context.Context, string) error {
+	return ErrWalletNotAvailable
+}
+
+type transaction struct{}
+
+func (transaction) GetTransaction(context.Context, string) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+func (transaction) EstimateFees(
+	context.Context, []ports.TxInput, []ports.TxOutput, uint64,
+) (uint64, error) {
+	return 0, ErrWalletNotAvailable
+}
+func (transaction) SelectUtxos(
+	context.Context, string, string, uint64,
+) ([]ports.Utxo, uint64, int64, error) {
+	return nil, 0, 0, ErrWalletNotAvailable
+}
+func (transaction) CreatePset(
+	context.Context, []ports.TxInput, []ports.TxOutput,
+) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+func (transaction) UpdatePset(
+	context.Context, string, []ports.TxInput, []ports.TxOutput,
+) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+func (transaction) BlindPset(
+	context.Context, string, []ports.UnblindedInput,
+) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+func (transaction) SignPset(context.Context, string, bool) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+func (transaction) Transfer(
+	context.Context, string, []ports.TxOutput, uint64,
+) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+func (transaction) BroadcastTransaction(
+	context.Context, string,
+) (string, error) {
+	return "", ErrWalletNotAvailable
+}
+
+type walletInfo struct{}
+
+func (walletInfo) GetNetwork() string {
+	return ""
+}
+func (walletInfo) GetNativeAsset() string {
+	return ""
+}
+func (walletInfo) GetRootPath() string {
+	return ""
+}
+func (walletInfo) GetBirthdayBlockHash() string {
+	return ""
+}
+func (walletInfo) GetBirthdayBlockHeight() uint32 {
+	return 0
+}
+func (walletInfo) GetAccounts() []ports.WalletAccount {
+	return nil
+}
@@ -0,0 +1,133 @@
+package pricefeeder
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+var bpsDenominator = decimal.NewFromInt(10000)
+
+type sourcePrice struct {
+	price     decimal.Decimal
+	volume    decimal.Decimal
+	timestamp time.Time
+}
+
+// aggregator keeps track of the last price received from every source of a
+// composite price feed and combines them into a single one.
+type aggregator struct {
+	cfg Aggregation
+
+	lock   *sync.Mutex
+	prices map[PriceSource]sourcePrice
+}
+
+func newAggregator(cfg Aggregation) *aggregator {
+	return &aggregator{
+		cfg:    cfg,
+		lock:   &sync.Mutex{},
+		prices: make(map[PriceSource]sourcePrice),
+	}
+}
+
+// update records the given price for the source and returns the resulting
+// aggregated price. The returned bool is false if there are not enough fresh
+// and consistent sources to agree on a price.
+func (a *aggregator) update(
+	src PriceSource, price pricefeeder.Price, now time.Time,
+) (pricefeeder.Price, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.prices[src] = sourcePrice{price.QuotePrice, price.Volume, now}
+	return a.aggregate(now)
+}
+
+func (a *aggregator) aggregate(now time.Time) (pricefeeder.Price, bool) {
+	maxAge := time.Duration(a.cfg.MaxAge) * time.Second
+	fresh := make([]sourcePrice, 0, len(a.prices))
+	for _, p := range a.prices {
+		if now.Sub(p.timestamp) > maxAge {
+			continue
+		}
+		fresh = append(fresh, p)
+	}
+	if len(fresh) <= 0 || len(fresh) < int(a.cfg.Quorum) {
+		return pricefeeder.Price{}, false
+	}
+
+	// Discard the prices too distant from the median.
+	median := medianPrice(fresh)
+	prices := fresh
+	if a.cfg.MaxDeviationBps > 0 {
+		maxDeviation := decimal.NewFromInt(int64(a.cfg.MaxDeviationBps)).
+			Div(bpsDenominator)
+		prices = make([]sourcePrice, 0, len(fresh))
+		for _, p := range fresh {
+			deviation := p.price.Sub(median).Abs().Div(median)
+			if deviation.GreaterThan(maxDeviation) {
+				continue
+			}
+			prices = append(prices, p)
+		}
+		if len(prices) <= 0 || len(prices) < int(a.cfg.Quorum) {
+			return pricefeeder.Price{}, false
+		}
+	}
+
+	quotePrice := medianPrice(prices)
+	if a.cfg.Method == ports.PriceAggregationVolumeWeighted {
+		if p, ok := volumeWeightedPrice(prices); ok {
+			quotePrice = p
+		}
+	}
+	if quotePrice.IsZero() {
+		return pricefeeder.Price{}, false
+	}
+
+	volume := decimal.Zero
+	for _, p := range prices {
+		volume = volume.Add(p.volume)
+	}
+
+	return pricefeeder.Price{
+		BasePrice:  decimal.NewFromInt(1).Div(quotePrice).Round(8),
+		QuotePrice: quotePrice,
+		Volume:     volume,
+	}, true
+}
+
+func medianPrice(prices []sourcePrice) decimal.Decimal {
+	sorted := make([]decimal.Decimal, 0, len(prices))
+	for _, p := range prices {
+		sorted = append(sorted, p.price)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).Div(decimal.NewFromInt(2))
+}
+
+// volumeWeightedPrice returns the average of the given prices weighted by
+// their volume. The returned bool is false if no source provided a volume.
+func volumeWeightedPrice(prices []sourcePrice) (decimal.Decimal, bool) {
+	totVolume, weightedSum := decimal.Zero, decimal.Zero
+	for _, p := range prices {
+		totVolume = totVolume.Add(p.volume)
+		weightedSum = weightedSum.Add(p.price.Mul(p.volume))
+	}
+	if totVolume.IsZero() {
+		return decimal.Zero, false
+	}
+	return weightedSum.Div(totVolume), true
+}
@@ -0,0 +1,439 @@
+package pricefeeder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+var (
+	krakenBtc   = PriceSource{krakenSource, "XBT/USDT"}
+	bitfinexBtc = PriceSource{bitfinexSource, "BTCUST"}
+	coinbaseBtc = PriceSource{coinbaseSource, "BTC-USD"}
+)
+
+type sourceTick struct {
+	source PriceSource
+	price  string
+	volume string
+	// age is the number of seconds elapsed since the tick was received.
+	age int
+}
+
+func TestAggregator(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           Aggregation
+		ticks         []sourceTick
+		expectedOk    bool
+		expectedPrice string
+	}{
+		{
+			name: "median of odd number of sources",
+			cfg:  Aggregation{Quorum: 2, MaxAge: 60},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 0},
+				{bitfinexBtc, "102", "0", 0},
+				{coinbaseBtc, "101", "0", 0},
+			},
+			expectedOk:    true,
+			expectedPrice: "101",
+		},
+		{
+			name: "median of even number of sources",
+			cfg:  Aggregation{Quorum: 2, MaxAge: 60},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 0},
+				{bitfinexBtc, "102", "0", 0},
+			},
+			expectedOk:    true,
+			expectedPrice: "101",
+		},
+		{
+			name: "outlier is discarded",
+			cfg:  Aggregation{Quorum: 2, MaxAge: 60, MaxDeviationBps: 100},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 0},
+				{bitfinexBtc, "100.4", "0", 0},
+				{coinbaseBtc, "110", "0", 0},
+			},
+			expectedOk:    true,
+			expectedPrice: "100.2",
+		},
+		{
+			name: "volume weighted",
+			cfg: Aggregation{
+				Method: ports.PriceAggregationVolumeWeighted, Quorum: 2, MaxAge: 60,
+			},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "3", 0},
+				{bitfinexBtc, "104", "1", 0},
+			},
+			expectedOk:    true,
+			expectedPrice: "101",
+		},
+		{
+			name: "volume weighted without volumes falls back to median",
+			cfg: Aggregation{
+				Method: ports.PriceAggregationVolumeWeighted, Quorum: 2, MaxAge: 60,
+			},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 0},
+				{bitfinexBtc, "104", "0", 0},
+			},
+			expectedOk:    true,
+			expectedPrice: "102",
+		},
+		{
+			name: "quorum not reached",
+			cfg:  Aggregation{Quorum: 2, MaxAge: 60},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 0},
+			},
+			expectedOk: false,
+		},
+		{
+			name: "stale sources do not count for quorum",
+			cfg:  Aggregation{Quorum: 2, MaxAge: 60},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 120},
+				{bitfinexBtc, "101", "0", 0},
+			},
+			expectedOk: false,
+		},
+		{
+			name: "discarded outliers do not count for quorum",
+			cfg:  Aggregation{Quorum: 3, MaxAge: 60, MaxDeviationBps: 100},
+			ticks: []sourceTick{
+				{krakenBtc, "100", "0", 0},
+				{bitfinexBtc, "100.4", "0", 0},
+				{coinbaseBtc, "110", "0", 0},
+			},
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			agg := newAggregator(tt.cfg)
+			now := time.Now()
+
+			for _, tick := range tt.ticks {
+				agg.update(
+					tick.source,
+					pricefeeder.Price{
+						QuotePrice: decimal.RequireFromString(tick.price),
+						Volume:     decimal.RequireFromString(tick.volume),
+					},
+					now.Add(-time.Duration(tick.age)*time.Second),
+				)
+			}
+			// Ticks may have been received in the past, therefore the aggregated
+			// price is evaluated at the current time.
+			price, ok := agg.aggregate(now)
+
+			require.Equal(t, tt.expectedOk, ok)
+			if !tt.expectedOk {
+				return
+			}
+			expectedPrice := decimal.RequireFromString(tt.expectedPrice)
+			require.True(
+				t, expectedPrice.Equal(price.QuotePrice),
+				fmt.Sprintf("expected %s, got %s", expectedPrice, price.QuotePrice),
+			)
+			require.True(
+				t, decimal.NewFromInt(1).Div(expectedPrice).Round(8).
+					Equal(price.BasePrice),
+			)
+		})
+	}
+}
+
+func TestCompositePriceFeed(t *testing.T) {
+	sources := map[string]*mockPriceFeeder{
+		"mockA": newMockPriceFeeder(),
+		"mockB": newMockPriceFeeder(),
+		"mockC": newMockPriceFeeder(),
+	}
+	for name, src := range sources {
+		src := src
+		feederFactory[name] = func() (pricefeeder.PriceFeeder, error) {
+			return src, nil
+		}
+	}
+	defer func() {
+		for name := range sources {
+			delete(feederFactory, name)
+		}
+	}()
+
+	svc := NewService(newMockStore(), 0)
+	market := Market{"base", "quote"}
+
+	id, err := svc.AddCompositePriceFeed(
+		context.Background(), market,
+		[]ports.PriceSource{
+			PriceSource{"mockA", "T"},
+			PriceSource{"mockB", "T"},
+			PriceSource{"mockC", "T"},
+		},
+		Aggregation{MaxDeviationBps: 100},
+	)
+	require.NoError(t, err)
+
+	feed, err := svc.GetPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, CompositeSource, feed.GetSource())
+	require.Len(t, feed.GetSources(), 3)
+	require.Equal(t, uint32(2), feed.GetAggregation().GetQuorum())
+	require.Equal(t, uint32(defaultMaxAge), feed.GetAggregation().GetMaxAge())
+
+	ch, err := svc.StartPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	for _, src := range sources {
+		require.Len(t, src.ListSubscriptions(), 1)
+	}
+
+	// The first price is not enough to reach the quorum.
+	sources["mockA"].sendPrice("T", "100")
+	sources["mockB"].sendPrice("T", "101")
+	priceFeed := <-ch
+	require.True(t, decimal.NewFromFloat(100.5).Equal(priceFeed.GetPrice().GetQuotePrice()))
+	require.Equal(t, market, priceFeed.GetMarket())
+
+	// The outlier is discarded.
+	sources["mockC"].sendPrice("T", "150")
+	priceFeed = <-ch
+	require.True(t, decimal.NewFromFloat(100.5).Equal(priceFeed.GetPrice().GetQuotePrice()))
+
+	err = svc.StopPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	_, ok := <-ch
+	require.False(t, ok)
+	for _, src := range sources {
+		require.Empty(t, src.ListSubscriptions())
+		require.True(t, src.isStopped())
+	}
+
+	svc.Close()
+}
+
+type mockPriceFeeder struct {
+	lock    *sync.Mutex
+	markets map[string]pricefeeder.Market
+	stopped bool
+	feedCh  chan pricefeeder.PriceFeed
+}
+
+func newMockPriceFeeder() *mockPriceFeeder {
+	return &mockPriceFeeder{
+		lock:    &sync.Mutex{},
+		markets: make(map[string]pricefeeder.Market),
+		feedCh:  make(chan pricefeeder.PriceFeed),
+	}
+}
+
+func (m *mockPriceFeeder) Start() chan pricefeeder.PriceFeed {
+	return m.feedCh
+}
+
+func (m *mockPriceFeeder) Stop() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.stopped = true
+	close(m.feedCh)
+}
+
+func (m *mockPriceFeeder) SubscribeMarkets(markets []pricefeeder.Market) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, mkt := range markets {
+		m.markets[mkt.Ticker] = mkt
+	}
+	return nil
+}
+
+func (m *mockPriceFeeder) UnsubscribeMarkets(markets []pricefeeder.Market) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, mkt := range markets {
+		delete(m.markets, mkt.Ticker)
+	}
+	return nil
+}
+
+func (m *mockPriceFeeder) ListSubscriptions() []pricefeeder.Market {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	markets := make([]pricefeeder.Market, 0, len(m.markets))
+	for _, mkt := range m.markets {
+		markets = append(markets, mkt)
+	}
+	return markets
+}
+
+func (m *mockPriceFeeder) sendPrice(ticker, price string) {
+	quotePrice := decimal.RequireFromString(price)
+	m.feedCh <- pricefeeder.PriceFeed{
+		Market: pricefeeder.Market{Ticker: ticker},
+		Price: pricefeeder.Price{
+			BasePrice:  decimal.NewFromInt(1).Div(quotePrice).Round(8),
+			QuotePrice: quotePrice,
+		},
+	}
+}
+
+func (m *mockPriceFeeder) isStopped() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.stopped
+}
+
+type mockStore struct {
+	lock  *sync.Mutex
+	feeds map[string]PriceFeedInfo
+	ticks map[string]PriceTick
+}
+
+func newMockStore() PriceFeedStore {
+	return &mockStore{
+		&sync.Mutex{}, make(map[string]PriceFeedInfo), make(map[string]PriceTick),
+	}
+}
+
+func (m *mockStore) AddPriceFeed(_ context.Context, info PriceFeedInfo) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.feeds[info.ID] = info
+	return nil
+}
+
+func (m *mockStore) GetPriceFeed(
+	_ context.Context, id string,
+) (*PriceFeedInfo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	info, ok := m.feeds[id]
+	if !ok {
+		return nil, fmt.Errorf("price feed not found")
+	}
+	return &info, nil
+}
+
+func (m *mockStore) UpdatePriceFeed(
+	_ context.Context, id string,
+	updateFn func(priceFeed *PriceFeedInfo) (*PriceFeedInfo, error),
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	info, ok := m.feeds[id]
+	if !ok {
+		return fmt.Errorf("price feed not found")
+	}
+	updated, err := updateFn(&info)
+	if err != nil {
+		return err
+	}
+	m.feeds[id] = *updated
+	return nil
+}
+
+func (m *mockStore) RemovePriceFeed(_ context.Context, id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.feeds, id)
+	return nil
+}
+
+func (m *mockStore) GetAllPriceFeeds(
+	_ context.Context,
+) ([]PriceFeedInfo, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	feeds := make([]PriceFeedInfo, 0, len(m.feeds))
+	for _, info := range m.feeds {
+		feeds = append(feeds, info)
+	}
+	return feeds, nil
+}
+
+func (m *mockStore) AddPriceTicks(_ context.Context, ticks []PriceTick) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, tick := range ticks {
+		m.ticks[tick.ID] = tick
+	}
+	return nil
+}
+
+func (m *mockStore) GetPriceTick(
+	_ context.Context, id string,
+) (*PriceTick, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	tick, ok := m.ticks[id]
+	if !ok {
+		return nil, fmt.Errorf("price tick not found")
+	}
+	return &tick, nil
+}
+
+// GetPriceTicks filters only by price feed and type, enough for tests.
+func (m *mockStore) GetPriceTicks(
+	_ context.Context, filter PriceTickFilter,
+) ([]PriceTick, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	ticks := make([]PriceTick, 0)
+	for _, tick := range m.ticks {
+		if tick.PriceFeedID != filter.PriceFeedID {
+			continue
+		}
+		if filter.Type > 0 && tick.Type != filter.Type {
+			continue
+		}
+		ticks = append(ticks, tick)
+	}
+	return ticks, nil
+}
+
+func (m *mockStore) DeletePriceTicks(
+	_ context.Context, priceFeedID string, before time.Time,
+) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for id, tick := range m.ticks {
+		if priceFeedID != "" && tick.PriceFeedID != priceFeedID {
+			continue
+		}
+		if !before.IsZero() && !tick.Timestamp.Before(before) {
+			continue
+		}
+		delete(m.ticks, id)
+	}
+	return nil
+}
+
+func (m *mockStore) Close() {}
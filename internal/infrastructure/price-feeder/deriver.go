@@ -0,0 +1,67 @@
+package pricefeeder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+// deriver keeps track of the last price received for every leg of a derived
+// price feed and multiplies them, or their inverse, into a cross rate.
+type deriver struct {
+	legs   []PriceLeg
+	maxAge time.Duration
+
+	lock   *sync.Mutex
+	prices map[PriceSource]sourcePrice
+}
+
+// newDeriver returns a deriver for the given legs. The last price of a leg is
+// considered stale once older than maxAge seconds.
+func newDeriver(legs []PriceLeg, maxAge uint32) *deriver {
+	return &deriver{
+		legs:   legs,
+		maxAge: time.Duration(maxAge) * time.Second,
+		lock:   &sync.Mutex{},
+		prices: make(map[PriceSource]sourcePrice),
+	}
+}
+
+// update records the given price for the leg and returns the resulting cross
+// rate. The returned bool is false until the price of every leg is known, and
+// whenever the one of any leg is stale.
+func (d *deriver) update(
+	src PriceSource, price pricefeeder.Price, now time.Time,
+) (pricefeeder.Price, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if !price.QuotePrice.IsPositive() {
+		return pricefeeder.Price{}, false
+	}
+	d.prices[src] = sourcePrice{price: price.QuotePrice, timestamp: now}
+
+	quotePrice := decimal.NewFromInt(1)
+	for _, leg := range d.legs {
+		legPrice, ok := d.prices[leg.PriceSource]
+		if !ok || now.Sub(legPrice.timestamp) > d.maxAge {
+			return pricefeeder.Price{}, false
+		}
+		if leg.Inverse {
+			quotePrice = quotePrice.Div(legPrice.price)
+		} else {
+			quotePrice = quotePrice.Mul(legPrice.price)
+		}
+	}
+	quotePrice = quotePrice.Round(8)
+	if quotePrice.IsZero() {
+		return pricefeeder.Price{}, false
+	}
+
+	return pricefeeder.Price{
+		BasePrice:  decimal.NewFromInt(1).Div(quotePrice).Round(8),
+		QuotePrice: quotePrice,
+	}, true
+}
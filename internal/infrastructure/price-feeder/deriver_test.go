@@ -0,0 +1,171 @@
+package pricefeeder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+func TestDeriver(t *testing.T) {
+	btcUsd := PriceSource{krakenSource, "XBT/USD"}
+	eurUsd := PriceSource{krakenSource, "EUR/USD"}
+
+	tests := []struct {
+		name          string
+		legs          []PriceLeg
+		prices        map[PriceSource]string
+		expectedOk    bool
+		expectedPrice string
+	}{
+		{
+			name: "cross rate",
+			legs: []PriceLeg{{btcUsd, false}, {eurUsd, true}},
+			prices: map[PriceSource]string{
+				btcUsd: "30000",
+				eurUsd: "1.2",
+			},
+			expectedOk:    true,
+			expectedPrice: "25000",
+		},
+		{
+			name: "inverse",
+			legs: []PriceLeg{{btcUsd, true}},
+			prices: map[PriceSource]string{
+				btcUsd: "25000",
+			},
+			expectedOk:    true,
+			expectedPrice: "0.00004",
+		},
+		{
+			name: "missing leg price",
+			legs: []PriceLeg{{btcUsd, false}, {eurUsd, true}},
+			prices: map[PriceSource]string{
+				btcUsd: "30000",
+			},
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDeriver(tt.legs, defaultMaxAge)
+
+			var price pricefeeder.Price
+			var ok bool
+			for src, p := range tt.prices {
+				price, ok = d.update(src, pricefeeder.Price{
+					QuotePrice: decimal.RequireFromString(p),
+				}, time.Now())
+			}
+
+			require.Equal(t, tt.expectedOk, ok)
+			if !tt.expectedOk {
+				return
+			}
+			expectedPrice := decimal.RequireFromString(tt.expectedPrice)
+			require.True(t, expectedPrice.Equal(price.QuotePrice))
+			require.True(
+				t, decimal.NewFromInt(1).Div(expectedPrice).Round(8).
+					Equal(price.BasePrice),
+			)
+		})
+	}
+}
+
+func TestDeriverStaleLeg(t *testing.T) {
+	btcUsd := PriceSource{krakenSource, "XBT/USD"}
+	eurUsd := PriceSource{krakenSource, "EUR/USD"}
+	d := newDeriver([]PriceLeg{{btcUsd, false}, {eurUsd, true}}, 60)
+
+	update := func(src PriceSource, price string, now time.Time) bool {
+		_, ok := d.update(src, pricefeeder.Price{
+			QuotePrice: decimal.RequireFromString(price),
+		}, now)
+		return ok
+	}
+
+	now := time.Now()
+	require.False(t, update(eurUsd, "1.2", now))
+	require.True(t, update(btcUsd, "30000", now.Add(time.Minute)))
+
+	// The price of the EUR/USD leg is stale, the cross rate isn't produced
+	// until it's updated again.
+	require.False(t, update(btcUsd, "30100", now.Add(time.Minute+time.Second)))
+	require.False(t, update(btcUsd, "30200", now.Add(2*time.Minute)))
+	require.True(t, update(eurUsd, "1.21", now.Add(2*time.Minute)))
+}
+
+func TestDerivedPriceFeed(t *testing.T) {
+	src := newMockPriceFeeder()
+	feederFactory["mock"] = func() (pricefeeder.PriceFeeder, error) {
+		return src, nil
+	}
+	defer delete(feederFactory, "mock")
+
+	svc := NewService(newMockStore(), 0)
+	market := Market{"base", "quote"}
+
+	_, err := svc.AddDerivedPriceFeed(
+		context.Background(), market,
+		[]ports.PriceLeg{PriceLeg{PriceSource{"mock", "BTCUSD"}, false}}, 0,
+	)
+	require.Error(t, err)
+
+	id, err := svc.AddDerivedPriceFeed(
+		context.Background(), market,
+		[]ports.PriceLeg{
+			PriceLeg{PriceSource{"mock", "BTCUSD"}, false},
+			PriceLeg{PriceSource{"mock", "EURUSD"}, true},
+		}, 0,
+	)
+	require.NoError(t, err)
+
+	feed, err := svc.GetPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, DerivedSource, feed.GetSource())
+	require.Len(t, feed.GetLegs(), 2)
+	require.Nil(t, feed.GetSources())
+	require.Equal(t, uint32(defaultMaxAge), feed.GetLegsMaxAge())
+
+	// The max age of the legs can be updated alone.
+	err = svc.UpdateDerivedPriceFeed(context.Background(), id, nil, 3600)
+	require.NoError(t, err)
+	feed, err = svc.GetPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, feed.GetLegs(), 2)
+	require.Equal(t, uint32(3600), feed.GetLegsMaxAge())
+
+	ch, err := svc.StartPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, src.ListSubscriptions(), 2)
+
+	src.sendPrice("BTCUSD", "30000")
+	src.sendPrice("EURUSD", "1.2")
+	priceFeed := <-ch
+	require.True(
+		t, decimal.NewFromInt(25000).Equal(priceFeed.GetPrice().GetQuotePrice()),
+	)
+
+	err = svc.StopPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.True(t, src.isStopped())
+
+	// A derived price feed can be turned back into a plain one.
+	err = svc.UpdatePriceFeed(context.Background(), id, "", "BTCUSD")
+	require.Error(t, err)
+	err = svc.UpdatePriceFeed(context.Background(), id, "mock", "BTCUSD")
+	require.NoError(t, err)
+
+	feed, err = svc.GetPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Equal(t, "mock", feed.GetSource())
+	require.Nil(t, feed.GetLegs())
+
+	svc.Close()
+}
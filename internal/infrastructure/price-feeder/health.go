@@ -0,0 +1,97 @@
+package pricefeeder
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+// Health is the runtime state of a started price feed. It's never persisted.
+type Health struct {
+	LastPrice  *pricefeeder.Price
+	LastUpdate time.Time
+	// UpdateRate is the average number of prices per minute since the feed
+	// was started.
+	UpdateRate float64
+	// Reconnections and LastError refer to the sources of the feed, that might
+	// be shared with other feeds.
+	Reconnections uint64
+	LastError     string
+}
+
+func (h Health) GetLastPrice() ports.MarketPrice {
+	if h.LastPrice == nil {
+		return nil
+	}
+	return priceFeedInfo{price: *h.LastPrice}
+}
+
+func (h Health) GetLastUpdate() int64 {
+	if h.LastUpdate.IsZero() {
+		return 0
+	}
+	return h.LastUpdate.Unix()
+}
+
+func (h Health) GetUpdateRate() float64 {
+	return h.UpdateRate
+}
+
+func (h Health) GetReconnections() uint64 {
+	return h.Reconnections
+}
+
+func (h Health) GetLastError() string {
+	return h.LastError
+}
+
+// feedHealth keeps track of the prices forwarded by a started price feed.
+type feedHealth struct {
+	lock       *sync.Mutex
+	startTime  time.Time
+	updates    uint64
+	lastPrice  *pricefeeder.Price
+	lastUpdate time.Time
+}
+
+func newFeedHealth(now time.Time) *feedHealth {
+	return &feedHealth{lock: &sync.Mutex{}, startTime: now}
+}
+
+func (h *feedHealth) update(price pricefeeder.Price, now time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.updates++
+	h.lastPrice = &price
+	h.lastUpdate = now
+}
+
+// snapshot returns the health of the feed, merged with the one of its
+// sources. The last error is the most recent among those of the sources.
+func (h *feedHealth) snapshot(
+	sources []pricefeeder.Health, now time.Time,
+) Health {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	health := Health{
+		LastPrice:  h.lastPrice,
+		LastUpdate: h.lastUpdate,
+	}
+	if elapsed := now.Sub(h.startTime).Minutes(); elapsed > 0 {
+		health.UpdateRate = float64(h.updates) / elapsed
+	}
+
+	var lastErrorTime time.Time
+	for _, src := range sources {
+		health.Reconnections += src.Reconnections
+		if src.LastError != "" && src.LastErrorTime.After(lastErrorTime) {
+			health.LastError = src.LastError
+			lastErrorTime = src.LastErrorTime
+		}
+	}
+	return health
+}
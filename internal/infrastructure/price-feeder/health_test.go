@@ -0,0 +1,107 @@
+package pricefeeder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+func TestFeedHealth(t *testing.T) {
+	now := time.Now()
+	health := newFeedHealth(now)
+
+	snapshot := health.snapshot(nil, now)
+	require.Nil(t, snapshot.GetLastPrice())
+	require.Zero(t, snapshot.GetLastUpdate())
+	require.Zero(t, snapshot.GetUpdateRate())
+
+	for i := 1; i <= 3; i++ {
+		health.update(pricefeeder.Price{
+			BasePrice:  decimal.RequireFromString("0.5"),
+			QuotePrice: decimal.NewFromInt(2),
+		}, now.Add(time.Duration(i)*time.Second))
+	}
+
+	snapshot = health.snapshot([]pricefeeder.Health{
+		{
+			Reconnections: 1,
+			LastError:     "old error",
+			LastErrorTime: now.Add(-time.Minute),
+		},
+		{
+			Reconnections: 2,
+			LastError:     "new error",
+			LastErrorTime: now,
+		},
+		{},
+	}, now.Add(time.Minute))
+	require.True(t, decimal.NewFromInt(2).Equal(
+		snapshot.GetLastPrice().GetQuotePrice(),
+	))
+	require.Equal(t, now.Add(3*time.Second).Unix(), snapshot.GetLastUpdate())
+	require.Equal(t, float64(3), snapshot.GetUpdateRate())
+	require.Equal(t, uint64(3), snapshot.GetReconnections())
+	require.Equal(t, "new error", snapshot.GetLastError())
+}
+
+func TestPriceFeedHealth(t *testing.T) {
+	src := &mockHealthReporter{newMockPriceFeeder(), pricefeeder.NewHealthTracker()}
+	feederFactory["mock"] = func() (pricefeeder.PriceFeeder, error) {
+		return src, nil
+	}
+	defer delete(feederFactory, "mock")
+
+	svc := NewService(newMockStore(), 0)
+	id, err := svc.AddPriceFeed(
+		context.Background(), Market{"base", "quote"}, "mock", "BTCUSD",
+	)
+	require.NoError(t, err)
+
+	feed, err := svc.GetPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Nil(t, feed.GetHealth())
+
+	ch, err := svc.StartPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+
+	src.sendPrice("BTCUSD", "30000")
+	<-ch
+	src.health.AddReconnection()
+	src.health.SetError(errors.New("connection dropped"))
+
+	feeds, err := svc.ListPriceFeeds(context.Background())
+	require.NoError(t, err)
+	require.Len(t, feeds, 1)
+
+	health := feeds[0].GetHealth()
+	require.NotNil(t, health)
+	require.True(t, decimal.NewFromInt(30000).Equal(
+		health.GetLastPrice().GetQuotePrice(),
+	))
+	require.NotZero(t, health.GetLastUpdate())
+	require.Equal(t, uint64(1), health.GetReconnections())
+	require.Equal(t, "connection dropped", health.GetLastError())
+
+	err = svc.StopPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+
+	feed, err = svc.GetPriceFeed(context.Background(), id)
+	require.NoError(t, err)
+	require.Nil(t, feed.GetHealth())
+
+	svc.Close()
+}
+
+type mockHealthReporter struct {
+	*mockPriceFeeder
+	health *pricefeeder.HealthTracker
+}
+
+func (m *mockHealthReporter) Health() pricefeeder.Health {
+	return m.health.Health()
+}
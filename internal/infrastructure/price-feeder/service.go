@@ -4,18 +4,45 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+	binancefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/binance"
 	bitfinexfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/bitfinex"
+	bitstampfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/bitstamp"
 	coinbasefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/coinbase"
 	krakenfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/kraken"
+	restfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/rest"
+	staticfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/static"
 )
 
 const (
 	krakenSource   = "kraken"
 	bitfinexSource = "bitfinex"
 	coinbaseSource = "coinbase"
+	binanceSource  = "binance"
+	bitstampSource = "bitstamp"
+	restSource     = "rest"
+	staticSource   = "static"
+	// CompositeSource is the source of the price feeds combining the prices
+	// of multiple sources.
+	CompositeSource = "composite"
+	// DerivedSource is the source of the price feeds computed from the prices
+	// of other tickers.
+	DerivedSource = "derived"
+
+	// defaultMaxAge is the default number of seconds after which the last
+	// price of a source of a composite price feed, or of a leg of a derived
+	// one, is considered stale. The sources send again their unchanged prices
+	// within pricefeeder.DefaultRefreshInterval, so that a quiet market isn't
+	// mistaken for a dead source.
+	defaultMaxAge = 60
+	// defaultTicksPageSize is the number of ticks returned when listing those
+	// of a price feed without a page.
+	defaultTicksPageSize = 100
 )
 
 var (
@@ -23,28 +50,74 @@ var (
 		krakenSource:   krakenfeeder.NewService,
 		bitfinexSource: bitfinexfeeder.NewService,
 		coinbaseSource: coinbasefeeder.NewService,
+		binanceSource:  binancefeeder.NewService,
+		bitstampSource: bitstampfeeder.NewService,
+		restSource:     restfeeder.NewService,
+		staticSource:   staticfeeder.NewService,
+	}
+
+	// tickerValidators validates the tickers of the sources not simply
+	// referring to a market of an exchange, or requiring a specific format
+	// for it.
+	tickerValidators = map[string]func(ticker string) error{
+		binanceSource:  binancefeeder.ValidateTicker,
+		bitstampSource: bitstampfeeder.ValidateTicker,
+		restSource: func(ticker string) error {
+			_, err := restfeeder.ParseTicker(ticker)
+			return err
+		},
+		staticSource: func(ticker string) error {
+			_, err := staticfeeder.ParseTicker(ticker)
+			return err
+		},
 	}
 )
 
 type service struct {
 	store PriceFeedStore
+	ticks *tickLog
+
+	lock    *sync.Mutex
+	sources map[string]pricefeeder.PriceFeeder
 
-	lock           *sync.Mutex
-	sources        map[string]pricefeeder.PriceFeeder
-	feedChBySource map[string]chan ports.PriceFeed
+	feedsLock     *sync.RWMutex
+	activeFeeds   map[string]*activeFeed
+	feedsBySource map[PriceSource]map[string]struct{}
+}
 
-	feedsLock   *sync.RWMutex
-	activeFeeds map[string]struct{}
+// activeFeed is a started price feed, receiving the prices of its sources
+// through the internal routing table of the service.
+type activeFeed struct {
+	market   Market
+	sources  []PriceSource
+	combiner priceCombiner
+	health   *feedHealth
+	ch       chan ports.PriceFeed
 }
 
-func NewService(store PriceFeedStore) ports.PriceFeeder {
+// priceCombiner computes the price of a composite or derived price feed from
+// the ones of its sources. The returned bool is false if the price can't be
+// computed yet.
+type priceCombiner interface {
+	update(
+		src PriceSource, price pricefeeder.Price, now time.Time,
+	) (pricefeeder.Price, bool)
+}
+
+// NewService returns a price feeder persisting the price feeds and their
+// ticks to the given store. Ticks older than tickRetention are periodically
+// deleted, while they're kept forever if it's zero.
+func NewService(
+	store PriceFeedStore, tickRetention time.Duration,
+) ports.PriceFeeder {
 	return &service{
-		store:          store,
-		lock:           &sync.Mutex{},
-		sources:        make(map[string]pricefeeder.PriceFeeder),
-		feedChBySource: make(map[string]chan ports.PriceFeed),
-		feedsLock:      &sync.RWMutex{},
-		activeFeeds:    make(map[string]struct{}),
+		store:         store,
+		ticks:         newTickLog(store, tickRetention),
+		lock:          &sync.Mutex{},
+		sources:       make(map[string]pricefeeder.PriceFeeder),
+		feedsLock:     &sync.RWMutex{},
+		activeFeeds:   make(map[string]*activeFeed),
+		feedsBySource: make(map[PriceSource]map[string]struct{}),
 	}
 }
 
@@ -63,6 +136,38 @@ func (s *service) AddPriceFeed(
 	return priceFeed.GetId(), nil
 }
 
+func (s *service) AddCompositePriceFeed(
+	ctx context.Context, market ports.Market,
+	sources []ports.PriceSource, aggregation ports.PriceAggregation,
+) (string, error) {
+	priceFeed, err := NewCompositePriceFeedInfo(market, sources, aggregation)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.store.AddPriceFeed(ctx, *priceFeed); err != nil {
+		return "", err
+	}
+
+	return priceFeed.GetId(), nil
+}
+
+func (s *service) AddDerivedPriceFeed(
+	ctx context.Context, market ports.Market,
+	legs []ports.PriceLeg, maxAge uint32,
+) (string, error) {
+	priceFeed, err := NewDerivedPriceFeedInfo(market, legs, maxAge)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.store.AddPriceFeed(ctx, *priceFeed); err != nil {
+		return "", err
+	}
+
+	return priceFeed.GetId(), nil
+}
+
 func (s *service) StartPriceFeed(
 	ctx context.Context, id string,
 ) (chan ports.PriceFeed, error) {
@@ -75,13 +180,14 @@ func (s *service) StartPriceFeed(
 		return nil, fmt.Errorf("price feed already started")
 	}
 
-	feederSvc, feedCh, err := s.getPriceFeederBySource(feed.Source)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := feederSvc.SubscribeMarkets(feed.toMarketList()); err != nil {
-		return nil, err
+	sources := feed.priceSources()
+	for i, src := range sources {
+		if err := s.subscribe(
+			feed.Market, src, feed.Headers[src.Ticker],
+		); err != nil {
+			s.unsubscribe(feed.Market, sources[:i])
+			return nil, err
+		}
 	}
 
 	feed.Started = true
@@ -90,12 +196,11 @@ func (s *service) StartPriceFeed(
 			return feed, nil
 		},
 	); err != nil {
+		s.unsubscribe(feed.Market, sources)
 		return nil, err
 	}
 
-	s.addActiveFeed(feed.ID)
-
-	return feedCh, nil
+	return s.addActiveFeed(*feed), nil
 }
 
 func (s *service) StopPriceFeed(ctx context.Context, id string) error {
@@ -117,22 +222,9 @@ func (s *service) StopPriceFeed(ctx context.Context, id string) error {
 		return err
 	}
 
-	feederSvc, _, err := s.getPriceFeederBySource(feed.Source)
-	if err != nil {
-		return err
-	}
-
-	if err := feederSvc.UnsubscribeMarkets(feed.toMarketList()); err != nil {
-		return err
-	}
-
-	// If there are no subscriptions for this price source, let's close the
-	// connection.
-	if len(feederSvc.ListSubscriptions()) <= 0 {
-		s.removePriceFeederBySource(feed.Source)
-	}
-
-	s.removeActiveFeed(id)
+	// Sources are unsubscribed only if not used by other active feeds.
+	unusedSources := s.removeActiveFeed(id)
+	s.unsubscribe(feed.Market, unusedSources)
 
 	return nil
 }
@@ -151,25 +243,147 @@ func (s *service) UpdatePriceFeed(
 
 	return s.store.UpdatePriceFeed(
 		ctx, id, func(priceFeed *PriceFeedInfo) (*PriceFeedInfo, error) {
-			if len(ticker) > 0 {
-				priceFeed.Ticker = ticker
+			// A composite or derived feed can be turned into a plain one only by
+			// providing both source and ticker.
+			if priceFeed.isComposite() || priceFeed.isDerived() {
+				if source == "" || ticker == "" {
+					return nil, fmt.Errorf(
+						"both price source and market ticker are required to " +
+							"update a composite or derived price feed",
+					)
+				}
+				priceFeed.setPlain()
 			}
 			if len(source) > 0 {
 				priceFeed.Source = source
 			}
+			// The headers of the current ticker are kept only if not replaced.
+			if len(ticker) <= 0 {
+				if err := validatePriceSource(
+					priceFeed.Source, priceFeed.Ticker,
+				); err != nil {
+					return nil, err
+				}
+				return priceFeed, nil
+			}
+
+			src, headers, err := newPriceSource(priceFeed.Source, ticker)
+			if err != nil {
+				return nil, err
+			}
+			priceFeed.Ticker = src.Ticker
+			priceFeed.Headers = nil
+			priceFeed.addHeaders(src.Ticker, headers)
+			return priceFeed, nil
+		},
+	)
+}
+
+func (s *service) UpdateCompositePriceFeed(
+	ctx context.Context, id string,
+	sources []ports.PriceSource, aggregation ports.PriceAggregation,
+) error {
+	if sources == nil && aggregation == nil {
+		return fmt.Errorf("missing price sources and/or aggregation")
+	}
+
+	return s.store.UpdatePriceFeed(
+		ctx, id, func(priceFeed *PriceFeedInfo) (*PriceFeedInfo, error) {
+			if !priceFeed.isComposite() && sources == nil {
+				return nil, fmt.Errorf(
+					"price sources are required to make a price feed composite",
+				)
+			}
+			if err := priceFeed.setComposite(sources, aggregation); err != nil {
+				return nil, err
+			}
+			return priceFeed, nil
+		},
+	)
+}
+
+func (s *service) UpdateDerivedPriceFeed(
+	ctx context.Context, id string, legs []ports.PriceLeg, maxAge uint32,
+) error {
+	if legs == nil && maxAge == 0 {
+		return fmt.Errorf("missing price legs and/or max age")
+	}
+
+	return s.store.UpdatePriceFeed(
+		ctx, id, func(priceFeed *PriceFeedInfo) (*PriceFeedInfo, error) {
+			if !priceFeed.isDerived() && legs == nil {
+				return nil, fmt.Errorf(
+					"price legs are required to make a price feed derived",
+				)
+			}
+			if err := priceFeed.setDerived(legs, maxAge); err != nil {
+				return nil, err
+			}
+			return priceFeed, nil
+		},
+	)
+}
+
+func (s *service) UpdatePriceFeedTransformation(
+	ctx context.Context, id string, transformation ports.PriceTransformation,
+) error {
+	t, err := newTransformation(transformation)
+	if err != nil {
+		return err
+	}
+
+	return s.store.UpdatePriceFeed(
+		ctx, id, func(priceFeed *PriceFeedInfo) (*PriceFeedInfo, error) {
+			priceFeed.Transformation = t
 			return priceFeed, nil
 		},
 	)
 }
 
+func (s *service) PushPrice(
+	ctx context.Context, id string, price ports.MarketPrice,
+) error {
+	feed, err := s.store.GetPriceFeed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if feed.isComposite() || feed.isDerived() {
+		return fmt.Errorf("prices can't be pushed to composite or derived feeds")
+	}
+	if !s.isActiveFeed(id) {
+		return fmt.Errorf("price feed must be started to push prices")
+	}
+
+	s.lock.Lock()
+	svc := s.sources[feed.Source]
+	s.lock.Unlock()
+
+	pusher, ok := svc.(pricefeeder.PricePusher)
+	if !ok {
+		return fmt.Errorf("price source %s doesn't support pushes", feed.Source)
+	}
+	return pusher.PushPrice(feed.Ticker, pricefeeder.Price{
+		BasePrice:  price.GetBasePrice(),
+		QuotePrice: price.GetQuotePrice(),
+	})
+}
+
 func (s *service) RemovePriceFeed(ctx context.Context, id string) error {
-	return s.store.RemovePriceFeed(ctx, id)
+	if err := s.store.RemovePriceFeed(ctx, id); err != nil {
+		return err
+	}
+	return s.ticks.remove(ctx, id)
 }
 
 func (s *service) GetPriceFeed(
 	ctx context.Context, id string,
 ) (ports.PriceFeedInfo, error) {
-	return s.store.GetPriceFeed(ctx, id)
+	priceFeed, err := s.store.GetPriceFeed(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	priceFeed.health = s.getHealth(id)
+	return priceFeed, nil
 }
 
 func (s *service) ListPriceFeeds(
@@ -182,11 +396,85 @@ func (s *service) ListPriceFeeds(
 
 	list := make([]ports.PriceFeedInfo, 0, len(priceFeeds))
 	for _, priceFeed := range priceFeeds {
+		priceFeed.health = s.getHealth(priceFeed.ID)
 		list = append(list, priceFeed)
 	}
 	return list, nil
 }
 
+func (s *service) AddAppliedPrice(
+	ctx context.Context, id, tickId string, price ports.MarketPrice,
+) error {
+	if tickId == "" {
+		return fmt.Errorf("missing price tick id")
+	}
+	if price == nil {
+		return fmt.Errorf("missing price")
+	}
+	origin, err := s.ticks.get(ctx, tickId)
+	if err != nil {
+		return err
+	}
+	if origin.PriceFeedID != id {
+		return fmt.Errorf("price tick doesn't belong to price feed")
+	}
+
+	tick := newPriceTick(
+		id, PriceTickApplied, PriceSource{origin.Source, origin.Ticker},
+		pricefeeder.Price{
+			BasePrice:  price.GetBasePrice(),
+			QuotePrice: price.GetQuotePrice(),
+		},
+		time.Now(),
+	)
+	tick.OriginID = origin.ID
+	s.ticks.add(tick)
+	return nil
+}
+
+func (s *service) ListPriceTicks(
+	ctx context.Context, id string, tickType ports.PriceTickType,
+	startTime, endTime time.Time, page ports.Page,
+) ([]ports.PriceTick, error) {
+	if id == "" {
+		return nil, fmt.Errorf("missing price feed id")
+	}
+
+	filter := PriceTickFilter{
+		PriceFeedID: id,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+	if tickType != nil {
+		if tickType.IsReceived() {
+			filter.Type = PriceTickReceived
+		}
+		if tickType.IsApplied() {
+			filter.Type = PriceTickApplied
+		}
+	}
+	filter.Limit = defaultTicksPageSize
+	if page != nil && page.GetSize() > 0 {
+		filter.Limit = int(page.GetSize())
+	}
+	if page != nil && page.GetNumber() > 1 {
+		filter.Offset = int(page.GetNumber()-1) * filter.Limit
+	}
+
+	// The ticks not yet written are flushed for them to be included.
+	s.ticks.flush()
+	ticks, err := s.store.GetPriceTicks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]ports.PriceTick, 0, len(ticks))
+	for _, tick := range ticks {
+		list = append(list, tick)
+	}
+	return list, nil
+}
+
 func (s *service) ListSources(ctx context.Context) []string {
 	supportedSources := make([]string, 0, len(feederFactory))
 	for src := range feederFactory {
@@ -196,71 +484,225 @@ func (s *service) ListSources(ctx context.Context) []string {
 	return supportedSources
 }
 
+// Backup adds a consistent snapshot of the internal store to the given backup,
+// if supported by the store.
+func (s *service) Backup(w ports.BackupWriter) error {
+	store, ok := s.store.(ports.Backupable)
+	if !ok {
+		return nil
+	}
+	return store.Backup(w)
+}
+
 func (s *service) Close() {
-	s.store.Close()
-	for _, ch := range s.feedChBySource {
-		close(ch)
+	s.lock.Lock()
+	for source, svc := range s.sources {
+		svc.Stop()
+		delete(s.sources, source)
 	}
+	s.lock.Unlock()
+
+	s.feedsLock.Lock()
+	for id, feed := range s.activeFeeds {
+		close(feed.ch)
+		delete(s.activeFeeds, id)
+	}
+	s.feedsBySource = make(map[PriceSource]map[string]struct{})
+	s.feedsLock.Unlock()
+
+	s.ticks.close()
+	s.store.Close()
 }
 
-func (s *service) getPriceFeederBySource(
-	source string,
-) (pricefeeder.PriceFeeder, chan ports.PriceFeed, error) {
+// subscribe subscribes to the ticker of the given source, connecting to the
+// latter if needed.
+func (s *service) subscribe(
+	market Market, src PriceSource, headers map[string]string,
+) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	if svc, ok := s.sources[source]; ok {
-		ch := s.feedChBySource[source]
-		return svc, ch, nil
-	}
+	svc, ok := s.sources[src.Source]
+	if !ok {
+		svcFactory, ok := feederFactory[src.Source]
+		if !ok {
+			return fmt.Errorf("unknown price source %s", src.Source)
+		}
 
-	svcFactory := feederFactory[source]
+		var err error
+		svc, err = svcFactory()
+		if err != nil {
+			return err
+		}
 
-	svc, err := svcFactory()
-	if err != nil {
-		return nil, nil, err
+		s.sources[src.Source] = svc
+		go s.forwardPriceFeeds(src.Source, svc.Start())
 	}
 
-	s.sources[source] = svc
-	extCh := svc.Start()
-	intCh := make(chan ports.PriceFeed, 20)
-	go func(extCh chan pricefeeder.PriceFeed, intCh chan ports.PriceFeed) {
-		for feed := range extCh {
-			intCh <- priceFeedInfo(feed)
-		}
-	}(extCh, intCh)
-	s.feedChBySource[source] = intCh
-	return svc, intCh, nil
+	return svc.SubscribeMarkets([]pricefeeder.Market{
+		{
+			BaseAsset:  market.BaseAsset,
+			QuoteAsset: market.QuoteAsset,
+			Ticker:     src.Ticker,
+			Headers:    headers,
+		},
+	})
 }
 
-func (s *service) removePriceFeederBySource(source string) {
+// unsubscribe unsubscribes from the tickers of the given sources, closing the
+// connection with any source left without subscriptions.
+func (s *service) unsubscribe(market Market, sources []PriceSource) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	svc, ok := s.sources[source]
-	if !ok {
-		return
+	for _, src := range sources {
+		svc, ok := s.sources[src.Source]
+		if !ok {
+			continue
+		}
+
+		if err := svc.UnsubscribeMarkets([]pricefeeder.Market{
+			{
+				BaseAsset:  market.BaseAsset,
+				QuoteAsset: market.QuoteAsset,
+				Ticker:     src.Ticker,
+			},
+		}); err != nil {
+			log.WithError(err).Warnf(
+				"failed to unsubscribe from %s ticker %s", src.Source, src.Ticker,
+			)
+		}
+
+		if len(svc.ListSubscriptions()) <= 0 {
+			svc.Stop()
+			delete(s.sources, src.Source)
+		}
 	}
+}
+
+// forwardPriceFeeds routes the prices received from the given source to the
+// active feeds subscribed to their tickers until the source is stopped.
+func (s *service) forwardPriceFeeds(
+	source string, ch chan pricefeeder.PriceFeed,
+) {
+	for feed := range ch {
+		s.dispatch(PriceSource{source, feed.Market.Ticker}, feed.Price)
+	}
+}
+
+// dispatch records the price received from the given source as a tick of
+// each active feed subscribed to it, then notifies the feeds. Ticks are
+// written to the store asynchronously.
+func (s *service) dispatch(src PriceSource, price pricefeeder.Price) {
+	s.feedsLock.RLock()
+	defer s.feedsLock.RUnlock()
 
-	svc.Stop()
-	close(s.feedChBySource[source])
+	now := time.Now()
+	ids := make([]string, 0, len(s.feedsBySource[src]))
+	ticks := make([]PriceTick, 0, len(s.feedsBySource[src]))
+	for id := range s.feedsBySource[src] {
+		ids = append(ids, id)
+		ticks = append(ticks, newPriceTick(id, PriceTickReceived, src, price, now))
+	}
+	s.ticks.add(ticks...)
+
+	for i, id := range ids {
+		feed := s.activeFeeds[id]
+
+		feedPrice := price
+		if feed.combiner != nil {
+			var ok bool
+			feedPrice, ok = feed.combiner.update(src, price, now)
+			if !ok {
+				log.Debugf("not enough sources to update price feed %s", id)
+				continue
+			}
+		}
 
-	delete(s.sources, source)
-	delete(s.feedChBySource, source)
+		feed.health.update(feedPrice, now)
+		feed.ch <- priceFeedInfo{feed.market, feedPrice, ticks[i].ID}
+	}
 }
 
-func (s *service) addActiveFeed(id string) {
+func (s *service) addActiveFeed(info PriceFeedInfo) chan ports.PriceFeed {
 	s.feedsLock.Lock()
 	defer s.feedsLock.Unlock()
 
-	s.activeFeeds[id] = struct{}{}
+	feed := &activeFeed{
+		market:  info.Market,
+		sources: info.priceSources(),
+		health:  newFeedHealth(time.Now()),
+		ch:      make(chan ports.PriceFeed, 20),
+	}
+	if info.isComposite() {
+		feed.combiner = newAggregator(*info.Aggregation)
+	}
+	if info.isDerived() {
+		feed.combiner = newDeriver(info.Legs, info.legsMaxAge())
+	}
+
+	s.activeFeeds[info.ID] = feed
+	for _, src := range feed.sources {
+		if _, ok := s.feedsBySource[src]; !ok {
+			s.feedsBySource[src] = make(map[string]struct{})
+		}
+		s.feedsBySource[src][info.ID] = struct{}{}
+	}
+	return feed.ch
 }
 
-func (s *service) removeActiveFeed(id string) {
+// removeActiveFeed removes the feed from the routing table and closes its
+// channel. It returns the sources of the feed not used by any other one.
+func (s *service) removeActiveFeed(id string) []PriceSource {
 	s.feedsLock.Lock()
 	defer s.feedsLock.Unlock()
 
+	feed, ok := s.activeFeeds[id]
+	if !ok {
+		return nil
+	}
+
+	unusedSources := make([]PriceSource, 0)
+	for _, src := range feed.sources {
+		delete(s.feedsBySource[src], id)
+		if len(s.feedsBySource[src]) <= 0 {
+			delete(s.feedsBySource, src)
+			unusedSources = append(unusedSources, src)
+		}
+	}
+
+	close(feed.ch)
 	delete(s.activeFeeds, id)
+	return unusedSources
+}
+
+// getHealth returns the health of the given feed and its sources, nil if the
+// feed is not active.
+func (s *service) getHealth(id string) *Health {
+	s.feedsLock.RLock()
+	feed, ok := s.activeFeeds[id]
+	s.feedsLock.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	s.lock.Lock()
+	sources := make([]pricefeeder.Health, 0, len(feed.sources))
+	visited := make(map[string]struct{})
+	for _, src := range feed.sources {
+		if _, ok := visited[src.Source]; ok {
+			continue
+		}
+		visited[src.Source] = struct{}{}
+
+		if reporter, ok := s.sources[src.Source].(pricefeeder.HealthReporter); ok {
+			sources = append(sources, reporter.Health())
+		}
+	}
+	s.lock.Unlock()
+
+	health := feed.health.snapshot(sources, time.Now())
+	return &health
 }
 
 func (s *service) isActiveFeed(id string) bool {
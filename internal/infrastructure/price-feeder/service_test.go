@@ -5,10 +5,13 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	pricefeeder "github.com/tdex-network/tdex-daemon/internal/infrastructure/price-feeder"
@@ -30,7 +33,7 @@ func TestPriceFeedService(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, store)
 
-	priceFeedSvc := pricefeeder.NewService(store)
+	priceFeedSvc := pricefeeder.NewService(store, 0)
 
 	wg := &sync.WaitGroup{}
 	wg.Add(6)
@@ -103,6 +106,180 @@ func TestPriceFeedService(t *testing.T) {
 	wg.Wait()
 }
 
+func TestPushPrice(t *testing.T) {
+	store, err := pricefeederstore.NewPriceFeedStore("", nil)
+	require.NoError(t, err)
+
+	priceFeedSvc := pricefeeder.NewService(store, 0)
+	defer priceFeedSvc.Close()
+
+	market := randomMarket()
+	id, err := priceFeedSvc.AddPriceFeed(ctx, market, "static", "BTC/USDT")
+	require.NoError(t, err)
+
+	price := pushedPrice{decimal.Zero, decimal.NewFromInt(20000)}
+	err = priceFeedSvc.PushPrice(ctx, id, price)
+	require.Error(t, err)
+
+	feedCh, err := priceFeedSvc.StartPriceFeed(ctx, id)
+	require.NoError(t, err)
+
+	err = priceFeedSvc.PushPrice(ctx, id, price)
+	require.NoError(t, err)
+
+	feed := <-feedCh
+	require.Equal(t, market, feed.GetMarket())
+	require.True(t, price.quotePrice.Equal(feed.GetPrice().GetQuotePrice()))
+	require.True(t, decimal.RequireFromString("0.00005").Equal(
+		feed.GetPrice().GetBasePrice(),
+	))
+
+	// Sources not supporting pushes are rejected.
+	_, err = priceFeedSvc.AddPriceFeed(ctx, market, "static", `{"price": 0}`)
+	require.Error(t, err)
+	id, err = priceFeedSvc.AddPriceFeed(
+		ctx, randomMarket(), "rest",
+		`{"url": "http://localhost:1", "quote_price_path": "price"}`,
+	)
+	require.NoError(t, err)
+	_, err = priceFeedSvc.StartPriceFeed(ctx, id)
+	require.NoError(t, err)
+	err = priceFeedSvc.PushPrice(ctx, id, price)
+	require.Error(t, err)
+}
+
+func TestPriceTicks(t *testing.T) {
+	store, err := pricefeederstore.NewPriceFeedStore("", nil)
+	require.NoError(t, err)
+
+	priceFeedSvc := pricefeeder.NewService(store, 0)
+	defer priceFeedSvc.Close()
+
+	id, err := priceFeedSvc.AddPriceFeed(ctx, randomMarket(), "static", "BTC/USDT")
+	require.NoError(t, err)
+	feedCh, err := priceFeedSvc.StartPriceFeed(ctx, id)
+	require.NoError(t, err)
+
+	price := pushedPrice{decimal.Zero, decimal.NewFromInt(20000)}
+	err = priceFeedSvc.PushPrice(ctx, id, price)
+	require.NoError(t, err)
+
+	feed := <-feedCh
+	require.NotEmpty(t, feed.GetTickId())
+
+	appliedPrice := pushedPrice{
+		decimal.RequireFromString("0.00004975"), decimal.NewFromInt(20100),
+	}
+	err = priceFeedSvc.AddAppliedPrice(ctx, "", feed.GetTickId(), appliedPrice)
+	require.Error(t, err)
+	err = priceFeedSvc.AddAppliedPrice(ctx, id, "", appliedPrice)
+	require.Error(t, err)
+	err = priceFeedSvc.AddAppliedPrice(ctx, id, feed.GetTickId(), appliedPrice)
+	require.NoError(t, err)
+
+	ticks, err := priceFeedSvc.ListPriceTicks(
+		ctx, id, nil, time.Time{}, time.Time{}, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, ticks, 2)
+
+	applied, received := ticks[0], ticks[1]
+	require.True(t, applied.GetType().IsApplied())
+	require.Equal(t, received.GetId(), applied.GetOriginId())
+	require.Equal(t, "static", applied.GetSource())
+	require.Equal(t, "BTC/USDT", applied.GetTicker())
+	require.True(t, appliedPrice.quotePrice.Equal(
+		applied.GetPrice().GetQuotePrice(),
+	))
+	require.True(t, received.GetType().IsReceived())
+	require.Equal(t, feed.GetTickId(), received.GetId())
+	require.True(t, price.quotePrice.Equal(received.GetPrice().GetQuotePrice()))
+
+	// Ticks outside of the time range are filtered out.
+	ticks, err = priceFeedSvc.ListPriceTicks(
+		ctx, id, nil, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour),
+		nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, ticks)
+
+	// Ticks are deleted together with their price feed.
+	err = priceFeedSvc.StopPriceFeed(ctx, id)
+	require.NoError(t, err)
+	err = priceFeedSvc.RemovePriceFeed(ctx, id)
+	require.NoError(t, err)
+	ticks, err = priceFeedSvc.ListPriceTicks(
+		ctx, id, nil, time.Time{}, time.Time{}, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, ticks)
+}
+
+func TestRestPriceFeedHeaders(t *testing.T) {
+	const apiKey = "secret"
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Api-Key") != apiKey {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"price": "20000"}`)
+		},
+	))
+	defer server.Close()
+
+	store, err := pricefeederstore.NewPriceFeedStore("", nil)
+	require.NoError(t, err)
+
+	priceFeedSvc := pricefeeder.NewService(store, 0)
+	defer priceFeedSvc.Close()
+
+	ticker := fmt.Sprintf(
+		`{"url": "%s", "quote_price_path": "price", "headers": {"X-Api-Key": "%s"}}`,
+		server.URL, apiKey,
+	)
+	id, err := priceFeedSvc.AddPriceFeed(ctx, randomMarket(), "rest", ticker)
+	require.NoError(t, err)
+
+	// Headers are never exposed.
+	priceFeed, err := priceFeedSvc.GetPriceFeed(ctx, id)
+	require.NoError(t, err)
+	require.NotContains(t, priceFeed.GetTicker(), apiKey)
+	priceFeeds, err := priceFeedSvc.ListPriceFeeds(ctx)
+	require.NoError(t, err)
+	require.Len(t, priceFeeds, 1)
+	require.NotContains(t, priceFeeds[0].GetTicker(), apiKey)
+
+	// Headers are still added to the requests.
+	feedCh, err := priceFeedSvc.StartPriceFeed(ctx, id)
+	require.NoError(t, err)
+	feed := <-feedCh
+	require.True(t, decimal.NewFromInt(20000).Equal(
+		feed.GetPrice().GetQuotePrice(),
+	))
+
+	ticks, err := priceFeedSvc.ListPriceTicks(
+		ctx, id, nil, time.Time{}, time.Time{}, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, ticks, 1)
+	require.NotContains(t, ticks[0].GetTicker(), apiKey)
+}
+
+type pushedPrice struct {
+	basePrice  decimal.Decimal
+	quotePrice decimal.Decimal
+}
+
+func (p pushedPrice) GetBasePrice() decimal.Decimal {
+	return p.basePrice
+}
+
+func (p pushedPrice) GetQuotePrice() decimal.Decimal {
+	return p.quotePrice
+}
+
 func randomMarket() ports.Market {
 	return pricefeeder.Market{
 		BaseAsset:  randomAsset(),
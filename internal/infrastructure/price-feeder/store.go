@@ -2,6 +2,7 @@ package pricefeeder
 
 import (
 	"context"
+	"time"
 )
 
 type PriceFeedStore interface {
@@ -18,5 +19,20 @@ type PriceFeedStore interface {
 	RemovePriceFeed(ctx context.Context, id string) error
 	// GetAllPriceFeeds returns all price feeds of all markets.
 	GetAllPriceFeeds(ctx context.Context) ([]PriceFeedInfo, error)
+	// AddPriceTicks adds the given ticks to the log of the prices received
+	// and applied by the price feeds.
+	AddPriceTicks(ctx context.Context, ticks []PriceTick) error
+	// GetPriceTick returns the tick with the given ID.
+	GetPriceTick(ctx context.Context, id string) (*PriceTick, error)
+	// GetPriceTicks returns the ticks matching the given filter, most recent
+	// first.
+	GetPriceTicks(
+		ctx context.Context, filter PriceTickFilter,
+	) ([]PriceTick, error)
+	// DeletePriceTicks deletes the ticks of the given price feed, or of all
+	// feeds if empty, older than the given time, or all of them if zero.
+	DeletePriceTicks(
+		ctx context.Context, priceFeedID string, before time.Time,
+	) error
 	Close()
 }
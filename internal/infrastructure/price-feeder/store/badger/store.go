@@ -3,16 +3,27 @@ package pricefeederstore
 import (
 	"context"
 	"fmt"
+	"io"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/dgraph-io/badger/v3/options"
 	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	pricefeeder "github.com/tdex-network/tdex-daemon/internal/infrastructure/price-feeder"
 	"github.com/timshannon/badgerhold/v4"
 )
 
+// feederDir is the name of the directory of the store, relative to the base
+// data dir.
+const feederDir = "feeder"
+
+// deleteBatchSize is the max number of price ticks deleted within a single
+// transaction.
+const deleteBatchSize = 1000
+
 type priceFeedStore struct {
 	store *badgerhold.Store
 }
@@ -22,7 +33,7 @@ func NewPriceFeedStore(
 ) (pricefeeder.PriceFeedStore, error) {
 	var priceFeederDir string
 	if len(baseDbDir) > 0 {
-		priceFeederDir = filepath.Join(baseDbDir, "feeder")
+		priceFeederDir = filepath.Join(baseDbDir, feederDir)
 	}
 
 	store, err := createDb(priceFeederDir, logger)
@@ -111,10 +122,137 @@ func (p *priceFeedStore) GetAllPriceFeeds(
 	return priceFeeds, nil
 }
 
+func (p *priceFeedStore) AddPriceTicks(
+	ctx context.Context, ticks []pricefeeder.PriceTick,
+) error {
+	return p.store.Badger().Update(func(tx *badger.Txn) error {
+		for i := range ticks {
+			if err := p.store.TxInsert(tx, ticks[i].ID, &ticks[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (p *priceFeedStore) GetPriceTick(
+	ctx context.Context, id string,
+) (*pricefeeder.PriceTick, error) {
+	var tick pricefeeder.PriceTick
+	if err := p.store.Get(id, &tick); err != nil {
+		if err == badgerhold.ErrNotFound {
+			return nil, fmt.Errorf("price tick not found")
+		}
+		return nil, err
+	}
+
+	return &tick, nil
+}
+
+func (p *priceFeedStore) GetPriceTicks(
+	ctx context.Context, filter pricefeeder.PriceTickFilter,
+) ([]pricefeeder.PriceTick, error) {
+	query := badgerhold.Where("PriceFeedID").Eq(filter.PriceFeedID).
+		Index("PriceFeedID")
+	if filter.Type > 0 {
+		query = query.And("Type").Eq(filter.Type)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.And("Timestamp").Ge(filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.And("Timestamp").Le(filter.EndTime)
+	}
+
+	// Sort, skip and limit are ignored by badgerhold for queries by index
+	// only, therefore are applied here to the ticks of the price feed.
+	var ticks []pricefeeder.PriceTick
+	if err := p.store.Find(&ticks, query); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(ticks, func(i, j int) bool {
+		return ticks[i].Timestamp.After(ticks[j].Timestamp)
+	})
+	if filter.Offset >= len(ticks) {
+		return nil, nil
+	}
+	ticks = ticks[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(ticks) {
+		ticks = ticks[:filter.Limit]
+	}
+	return ticks, nil
+}
+
+func (p *priceFeedStore) DeletePriceTicks(
+	ctx context.Context, priceFeedID string, before time.Time,
+) error {
+	var query *badgerhold.Query
+	if priceFeedID != "" {
+		query = badgerhold.Where("PriceFeedID").Eq(priceFeedID).
+			Index("PriceFeedID")
+		if !before.IsZero() {
+			query = query.And("Timestamp").Lt(before)
+		}
+	} else {
+		if before.IsZero() {
+			query = badgerhold.Where("ID").Ne("")
+		} else {
+			query = badgerhold.Where("Timestamp").Lt(before).Index("Timestamp")
+		}
+	}
+	query = query.Limit(deleteBatchSize)
+
+	// Ticks are deleted in batches to not exceed the max size of a badger
+	// transaction. The limit is ignored for queries by index only, in which
+	// case all matching ticks are returned at once.
+	for {
+		var ticks []pricefeeder.PriceTick
+		if err := p.store.Find(&ticks, query); err != nil {
+			return err
+		}
+		if len(ticks) <= 0 {
+			return nil
+		}
+
+		for len(ticks) > 0 {
+			batch := ticks
+			if len(batch) > deleteBatchSize {
+				batch = batch[:deleteBatchSize]
+			}
+			ticks = ticks[len(batch):]
+
+			if err := p.store.Badger().Update(func(tx *badger.Txn) error {
+				for _, tick := range batch {
+					if err := p.store.TxDelete(
+						tx, tick.ID, pricefeeder.PriceTick{},
+					); err != nil && err != badgerhold.ErrNotFound {
+						return err
+					}
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (p *priceFeedStore) Close() {
 	p.store.Close()
 }
 
+// Backup adds a consistent snapshot of the store to the given backup.
+func (p *priceFeedStore) Backup(w ports.BackupWriter) error {
+	db := p.store.Badger()
+	return w.WriteEntry(
+		feederDir, ports.BackupEntryBadger, func(w io.Writer) error {
+			_, err := db.Backup(w, 0)
+			return err
+		},
+	)
+}
+
 func (p *priceFeedStore) findPriceFeed(
 	ctx context.Context, query *badgerhold.Query,
 ) (*pricefeeder.PriceFeedInfo, error) {
@@ -5,8 +5,10 @@ import (
 	"crypto/rand"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	pricefeeder "github.com/tdex-network/tdex-daemon/internal/infrastructure/price-feeder"
 	pricefeederstore "github.com/tdex-network/tdex-daemon/internal/infrastructure/price-feeder/store/badger"
@@ -17,6 +19,7 @@ func TestPriceFeedStore(t *testing.T) {
 	t.Run("GetPriceFeed", testGetPriceFeed())
 	t.Run("UpdatePriceFeed", testUpdatePriceFeed())
 	t.Run("GetAll", testGetAll())
+	t.Run("PriceTicks", testPriceTicks())
 }
 
 func testAddAndDeletePriceFeed() func(*testing.T) {
@@ -121,6 +124,99 @@ func testGetAll() func(*testing.T) {
 	}
 }
 
+func testPriceTicks() func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.Background()
+
+		store, err := pricefeederstore.NewPriceFeedStore("", nil)
+		require.NoError(t, err)
+
+		now := time.Now()
+		feedId := uuid.New().String()
+		received := createTestPriceTick(
+			feedId, pricefeeder.PriceTickReceived, now.Add(-2*time.Hour),
+		)
+		applied := createTestPriceTick(
+			feedId, pricefeeder.PriceTickApplied, now.Add(-time.Hour),
+		)
+		applied.OriginID = received.ID
+		other := createTestPriceTick(
+			uuid.New().String(), pricefeeder.PriceTickReceived, now,
+		)
+
+		err = store.AddPriceTicks(
+			ctx, []pricefeeder.PriceTick{received, applied, other},
+		)
+		require.NoError(t, err)
+
+		tick, err := store.GetPriceTick(ctx, applied.ID)
+		require.NoError(t, err)
+		require.Equal(t, received.ID, tick.OriginID)
+		require.True(t, applied.QuotePrice.Equal(tick.QuotePrice))
+
+		_, err = store.GetPriceTick(ctx, uuid.New().String())
+		require.Error(t, err)
+
+		ticks, err := store.GetPriceTicks(
+			ctx, pricefeeder.PriceTickFilter{PriceFeedID: feedId},
+		)
+		require.NoError(t, err)
+		require.Len(t, ticks, 2)
+		require.Equal(t, applied.ID, ticks[0].ID)
+		require.Equal(t, received.ID, ticks[1].ID)
+
+		ticks, err = store.GetPriceTicks(ctx, pricefeeder.PriceTickFilter{
+			PriceFeedID: feedId,
+			Type:        pricefeeder.PriceTickReceived,
+		})
+		require.NoError(t, err)
+		require.Len(t, ticks, 1)
+		require.Equal(t, received.ID, ticks[0].ID)
+
+		ticks, err = store.GetPriceTicks(ctx, pricefeeder.PriceTickFilter{
+			PriceFeedID: feedId,
+			StartTime:   now.Add(-90 * time.Minute),
+			EndTime:     now,
+		})
+		require.NoError(t, err)
+		require.Len(t, ticks, 1)
+		require.Equal(t, applied.ID, ticks[0].ID)
+
+		ticks, err = store.GetPriceTicks(ctx, pricefeeder.PriceTickFilter{
+			PriceFeedID: feedId,
+			Offset:      1,
+			Limit:       1,
+		})
+		require.NoError(t, err)
+		require.Len(t, ticks, 1)
+		require.Equal(t, received.ID, ticks[0].ID)
+
+		// Only the ticks older than the given time are deleted.
+		err = store.DeletePriceTicks(ctx, "", now.Add(-90*time.Minute))
+		require.NoError(t, err)
+		_, err = store.GetPriceTick(ctx, received.ID)
+		require.Error(t, err)
+		ticks, err = store.GetPriceTicks(
+			ctx, pricefeeder.PriceTickFilter{PriceFeedID: feedId},
+		)
+		require.NoError(t, err)
+		require.Len(t, ticks, 1)
+		require.Equal(t, applied.ID, ticks[0].ID)
+
+		// Only the ticks of the given price feed are deleted.
+		err = store.DeletePriceTicks(ctx, feedId, time.Time{})
+		require.NoError(t, err)
+		ticks, err = store.GetPriceTicks(
+			ctx, pricefeeder.PriceTickFilter{PriceFeedID: feedId},
+		)
+		require.NoError(t, err)
+		require.Empty(t, ticks)
+		tick, err = store.GetPriceTick(ctx, other.ID)
+		require.NoError(t, err)
+		require.NotNil(t, tick)
+	}
+}
+
 func createTestPriceFeed() *pricefeeder.PriceFeedInfo {
 	return &pricefeeder.PriceFeedInfo{
 		ID: uuid.New().String(),
@@ -134,6 +230,21 @@ func createTestPriceFeed() *pricefeeder.PriceFeedInfo {
 	}
 }
 
+func createTestPriceTick(
+	feedId string, tickType int, timestamp time.Time,
+) pricefeeder.PriceTick {
+	return pricefeeder.PriceTick{
+		ID:          uuid.New().String(),
+		PriceFeedID: feedId,
+		Type:        tickType,
+		Source:      "kraken",
+		Ticker:      "XBT/USD",
+		BasePrice:   decimal.RequireFromString("0.00005"),
+		QuotePrice:  decimal.NewFromInt(20000),
+		Timestamp:   timestamp,
+	}
+}
+
 func randAsset() string {
 	b := make([]byte, 32)
 	_, _ = rand.Read(b)
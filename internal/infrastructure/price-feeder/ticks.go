@@ -0,0 +1,159 @@
+package pricefeeder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// tickFlushInterval is the max time a price tick is kept in memory before
+	// being written to the store.
+	tickFlushInterval = time.Second
+	// tickBatchSize is the number of pending price ticks that triggers a write
+	// to the store before the flush interval expires.
+	tickBatchSize = 500
+	// tickPruneInterval is the time between deletions of the price ticks older
+	// than the retention period.
+	tickPruneInterval = time.Hour
+)
+
+// tickLog buffers the price ticks of the price feeds and writes them to the
+// store in batches, so that routing a price to the feeds never waits for the
+// store. It also periodically deletes the ticks older than the retention
+// period, if any.
+type tickLog struct {
+	store     PriceFeedStore
+	retention time.Duration
+
+	lock    *sync.Mutex
+	pending []PriceTick
+
+	// flushLock serializes the writes to the store, so that pending ticks are
+	// written only once and in order.
+	flushLock *sync.Mutex
+	flushCh   chan struct{}
+	quit      chan struct{}
+	done      chan struct{}
+}
+
+func newTickLog(store PriceFeedStore, retention time.Duration) *tickLog {
+	l := &tickLog{
+		store:     store,
+		retention: retention,
+		lock:      &sync.Mutex{},
+		flushLock: &sync.Mutex{},
+		flushCh:   make(chan struct{}, 1),
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go l.run()
+	return l
+}
+
+// add enqueues the given ticks to be written to the store.
+func (l *tickLog) add(ticks ...PriceTick) {
+	l.lock.Lock()
+	l.pending = append(l.pending, ticks...)
+	full := len(l.pending) >= tickBatchSize
+	l.lock.Unlock()
+
+	if full {
+		select {
+		case l.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// get returns the tick with the given id, whether already written to the store
+// or not.
+func (l *tickLog) get(ctx context.Context, id string) (*PriceTick, error) {
+	l.lock.Lock()
+	for _, tick := range l.pending {
+		if tick.ID == id {
+			l.lock.Unlock()
+			return &tick, nil
+		}
+	}
+	l.lock.Unlock()
+
+	return l.store.GetPriceTick(ctx, id)
+}
+
+// flush writes the pending ticks to the store. These are left in the queue
+// until written, so that they can always be retrieved with get.
+func (l *tickLog) flush() {
+	l.flushLock.Lock()
+	defer l.flushLock.Unlock()
+
+	l.lock.Lock()
+	ticks := make([]PriceTick, len(l.pending))
+	copy(ticks, l.pending)
+	l.lock.Unlock()
+
+	if len(ticks) <= 0 {
+		return
+	}
+
+	if err := l.store.AddPriceTicks(context.Background(), ticks); err != nil {
+		log.WithError(err).Warnf("failed to record %d price ticks", len(ticks))
+	}
+
+	l.lock.Lock()
+	l.pending = l.pending[len(ticks):]
+	l.lock.Unlock()
+}
+
+// remove deletes all the ticks of the given price feed.
+func (l *tickLog) remove(ctx context.Context, priceFeedID string) error {
+	l.flush()
+	return l.store.DeletePriceTicks(ctx, priceFeedID, time.Time{})
+}
+
+// prune deletes the ticks older than the retention period, if any.
+func (l *tickLog) prune() {
+	if l.retention <= 0 {
+		return
+	}
+
+	before := time.Now().Add(-l.retention)
+	if err := l.store.DeletePriceTicks(
+		context.Background(), "", before,
+	); err != nil {
+		log.WithError(err).Warn("failed to prune price ticks")
+	}
+}
+
+func (l *tickLog) run() {
+	defer close(l.done)
+
+	flushTicker := time.NewTicker(tickFlushInterval)
+	defer flushTicker.Stop()
+	pruneTicker := time.NewTicker(tickPruneInterval)
+	defer pruneTicker.Stop()
+
+	l.prune()
+
+	for {
+		select {
+		case <-l.quit:
+			l.flush()
+			return
+		case <-flushTicker.C:
+			l.flush()
+		case <-l.flushCh:
+			l.flush()
+		case <-pruneTicker.C:
+			l.prune()
+		}
+	}
+}
+
+// close writes the pending ticks to the store and stops the log.
+func (l *tickLog) close() {
+	close(l.quit)
+	<-l.done
+}
@@ -2,11 +2,13 @@ package pricefeeder
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+	restfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/rest"
 )
 
 type PriceFeedInfo struct {
@@ -15,6 +17,24 @@ type PriceFeedInfo struct {
 	Source  string
 	Ticker  string
 	Started bool
+	// Sources and Aggregation are defined only for composite price feeds, in
+	// which case Source is CompositeSource and Ticker is empty.
+	Sources     []PriceSource
+	Aggregation *Aggregation
+	// Legs and LegsMaxAge are defined only for derived price feeds, in which
+	// case Source is DerivedSource and Ticker is empty. LegsMaxAge is the
+	// number of seconds after which the price of a leg is considered stale.
+	Legs       []PriceLeg
+	LegsMaxAge uint32
+	// Transformation is applied to the prices before updating the market.
+	Transformation Transformation
+	// Headers are the request headers of the rest sources, by ticker. They're
+	// split from the tickers when added, so that these can be logged and
+	// returned without exposing secrets like API keys.
+	Headers map[string]map[string]string
+
+	// health is set only for the started price feeds returned by the service.
+	health *Health
 }
 
 func (p PriceFeedInfo) GetId() string {
@@ -37,6 +57,106 @@ func (p PriceFeedInfo) IsStarted() bool {
 	return p.Started
 }
 
+func (p PriceFeedInfo) GetSources() []ports.PriceSource {
+	if !p.isComposite() {
+		return nil
+	}
+	sources := make([]ports.PriceSource, 0, len(p.Sources))
+	for _, src := range p.Sources {
+		sources = append(sources, src)
+	}
+	return sources
+}
+
+func (p PriceFeedInfo) GetAggregation() ports.PriceAggregation {
+	if !p.isComposite() {
+		return nil
+	}
+	return *p.Aggregation
+}
+
+func (p PriceFeedInfo) GetLegs() []ports.PriceLeg {
+	if !p.isDerived() {
+		return nil
+	}
+	legs := make([]ports.PriceLeg, 0, len(p.Legs))
+	for _, leg := range p.Legs {
+		legs = append(legs, leg)
+	}
+	return legs
+}
+
+func (p PriceFeedInfo) GetLegsMaxAge() uint32 {
+	if !p.isDerived() {
+		return 0
+	}
+	return p.legsMaxAge()
+}
+
+func (p PriceFeedInfo) GetTransformation() ports.PriceTransformation {
+	return p.Transformation
+}
+
+func (p PriceFeedInfo) GetHealth() ports.PriceFeedHealth {
+	if p.health == nil {
+		return nil
+	}
+	return *p.health
+}
+
+func (p PriceFeedInfo) isComposite() bool {
+	return p.Aggregation != nil
+}
+
+func (p PriceFeedInfo) isDerived() bool {
+	return len(p.Legs) > 0
+}
+
+// legsMaxAge returns the max age of the prices of the legs, defaulting to
+// defaultMaxAge for the derived feeds stored before it could be configured.
+func (p PriceFeedInfo) legsMaxAge() uint32 {
+	if p.LegsMaxAge == 0 {
+		return defaultMaxAge
+	}
+	return p.LegsMaxAge
+}
+
+// priceSources returns the sources the price feed subscribes to, that is
+// just one for plain feeds.
+func (p PriceFeedInfo) priceSources() []PriceSource {
+	if p.isComposite() {
+		return p.Sources
+	}
+	if p.isDerived() {
+		sources := make([]PriceSource, 0, len(p.Legs))
+		for _, leg := range p.Legs {
+			sources = append(sources, leg.PriceSource)
+		}
+		return sources
+	}
+	return []PriceSource{{p.Source, p.Ticker}}
+}
+
+// setPlain makes the price feed use only the given source and ticker.
+func (p *PriceFeedInfo) setPlain() {
+	p.Sources = nil
+	p.Aggregation = nil
+	p.Legs = nil
+	p.LegsMaxAge = 0
+	p.Headers = nil
+}
+
+// addHeaders sets the request headers of the given ticker, if any.
+func (p *PriceFeedInfo) addHeaders(ticker string, headers map[string]string) {
+	if len(headers) <= 0 {
+		return
+	}
+	if p.Headers == nil {
+		p.Headers = make(map[string]map[string]string)
+	}
+	p.Headers[ticker] = headers
+}
+
 type Market struct {
 	BaseAsset  string
 	QuoteAsset string
@@ -50,56 +170,464 @@ func (m Market) GetQuoteAsset() string {
 	return m.QuoteAsset
 }
 
+type PriceSource struct {
+	Source string
+	Ticker string
+}
+
+func (p PriceSource) GetSource() string {
+	return p.Source
+}
+
+func (p PriceSource) GetTicker() string {
+	return p.Ticker
+}
+
+type PriceLeg struct {
+	PriceSource
+	Inverse bool
+}
+
+func (l PriceLeg) IsInverse() bool {
+	return l.Inverse
+}
+
+type Transformation struct {
+	MarkupBps         int32
+	MinUpdateInterval uint32
+	Rounding          bool
+}
+
+func (t Transformation) GetMarkupBps() int32 {
+	return t.MarkupBps
+}
+
+func (t Transformation) GetMinUpdateInterval() uint32 {
+	return t.MinUpdateInterval
+}
+
+func (t Transformation) IsRoundingEnabled() bool {
+	return t.Rounding
+}
+
+func newTransformation(t ports.PriceTransformation) (Transformation, error) {
+	if t == nil {
+		return Transformation{}, fmt.Errorf("missing price transformation")
+	}
+	// A markdown of 100% or more would make the price zero or negative.
+	if t.GetMarkupBps() <= -10000 {
+		return Transformation{}, fmt.Errorf(
+			"markdown must be lower than 10000 basis points",
+		)
+	}
+	return Transformation{
+		MarkupBps:         t.GetMarkupBps(),
+		MinUpdateInterval: t.GetMinUpdateInterval(),
+		Rounding:          t.IsRoundingEnabled(),
+	}, nil
+}
+
+type Aggregation struct {
+	Method          int
+	MaxDeviationBps uint32
+	Quorum          uint32
+	MaxAge          uint32
+}
+
+func (a Aggregation) GetMethod() int {
+	return a.Method
+}
+
+func (a Aggregation) GetMaxDeviationBps() uint32 {
+	return a.MaxDeviationBps
+}
+
+func (a Aggregation) GetQuorum() uint32 {
+	return a.Quorum
+}
+
+func (a Aggregation) GetMaxAge() uint32 {
+	return a.MaxAge
+}
+
 func NewPriceFeedInfo(market ports.Market, source, ticker string) (*PriceFeedInfo, error) {
 	if market == nil {
 		return nil, fmt.Errorf("missing market")
 	}
-	if len(source) <= 0 {
-		return nil, fmt.Errorf("missing price source")
+	src, headers, err := newPriceSource(source, ticker)
+	if err != nil {
+		return nil, err
 	}
-	if _, ok := feederFactory[source]; !ok {
-		return nil, fmt.Errorf("unknown price source")
+
+	priceFeed := &PriceFeedInfo{
+		ID: uuid.New().String(),
+		Market: Market{
+			BaseAsset:  market.GetBaseAsset(),
+			QuoteAsset: market.GetQuoteAsset(),
+		},
+		Source:  src.Source,
+		Ticker:  src.Ticker,
+		Started: false,
 	}
-	if len(ticker) <= 0 {
-		return nil, fmt.Errorf("missing market ticker")
+	priceFeed.addHeaders(src.Ticker, headers)
+	return priceFeed, nil
+}
+
+// NewCompositePriceFeedInfo returns a new price feed combining the prices of
+// the given sources. A zero quorum defaults to the majority of the sources, a
+// zero max age to defaultMaxAge.
+func NewCompositePriceFeedInfo(
+	market ports.Market,
+	sources []ports.PriceSource, aggregation ports.PriceAggregation,
+) (*PriceFeedInfo, error) {
+	if market == nil {
+		return nil, fmt.Errorf("missing market")
+	}
+	if sources == nil {
+		return nil, fmt.Errorf("missing price sources")
 	}
 
-	return &PriceFeedInfo{
+	priceFeed := &PriceFeedInfo{
 		ID: uuid.New().String(),
 		Market: Market{
 			BaseAsset:  market.GetBaseAsset(),
 			QuoteAsset: market.GetQuoteAsset(),
 		},
-		Source:  source,
-		Ticker:  ticker,
-		Started: false,
-	}, nil
+	}
+	if err := priceFeed.setComposite(sources, aggregation); err != nil {
+		return nil, err
+	}
+	return priceFeed, nil
 }
 
-func (p *PriceFeedInfo) toMarketList() []pricefeeder.Market {
-	return []pricefeeder.Market{
-		{
-			BaseAsset:  p.Market.BaseAsset,
-			QuoteAsset: p.Market.QuoteAsset,
-			Ticker:     p.Ticker,
+// setComposite makes the price feed composite with the given sources and
+// aggregation settings, each left unchanged if nil.
+func (p *PriceFeedInfo) setComposite(
+	sources []ports.PriceSource, aggregation ports.PriceAggregation,
+) error {
+	priceSources := p.Sources
+	priceHeaders := p.Headers
+	if sources != nil {
+		priceHeaders = nil
+		if len(sources) < 2 {
+			return fmt.Errorf("composite price feed requires at least 2 sources")
+		}
+
+		priceSources = make([]PriceSource, 0, len(sources))
+		for _, src := range sources {
+			if src == nil {
+				return fmt.Errorf("missing price source")
+			}
+			priceSource, headers, err := newPriceSource(
+				src.GetSource(), src.GetTicker(),
+			)
+			if err != nil {
+				return err
+			}
+
+			if len(headers) > 0 {
+				if priceHeaders == nil {
+					priceHeaders = make(map[string]map[string]string)
+				}
+				priceHeaders[priceSource.Ticker] = headers
+			}
+			for _, s := range priceSources {
+				if s == priceSource {
+					return fmt.Errorf(
+						"duplicated price source %s %s", s.Source, s.Ticker,
+					)
+				}
+			}
+			priceSources = append(priceSources, priceSource)
+		}
+	}
+	if len(priceSources) <= 0 {
+		return fmt.Errorf("missing price sources")
+	}
+
+	var agg Aggregation
+	if p.Aggregation != nil {
+		agg = *p.Aggregation
+	}
+	if aggregation != nil {
+		agg = Aggregation{
+			Method:          aggregation.GetMethod(),
+			MaxDeviationBps: aggregation.GetMaxDeviationBps(),
+			Quorum:          aggregation.GetQuorum(),
+			MaxAge:          aggregation.GetMaxAge(),
+		}
+	}
+	if agg.Method != ports.PriceAggregationMedian &&
+		agg.Method != ports.PriceAggregationVolumeWeighted {
+		return fmt.Errorf("unknown price aggregation method")
+	}
+	if agg.Quorum == 0 {
+		agg.Quorum = uint32(len(priceSources)/2 + 1)
+	}
+	if int(agg.Quorum) > len(priceSources) {
+		return fmt.Errorf(
+			"quorum must not be greater than the number of price sources",
+		)
+	}
+	if agg.MaxAge == 0 {
+		agg.MaxAge = defaultMaxAge
+	}
+
+	p.setPlain()
+	p.Source = CompositeSource
+	p.Ticker = ""
+	p.Sources = priceSources
+	p.Aggregation = &agg
+	p.Headers = priceHeaders
+	return nil
+}
+
+// NewDerivedPriceFeedInfo returns a new price feed whose price is the product
+// of the prices, or their inverse, of the given legs. A zero max age of the
+// prices of the legs defaults to defaultMaxAge.
+func NewDerivedPriceFeedInfo(
+	market ports.Market, legs []ports.PriceLeg, maxAge uint32,
+) (*PriceFeedInfo, error) {
+	if legs == nil {
+		return nil, fmt.Errorf("missing price legs")
+	}
+	if market == nil {
+		return nil, fmt.Errorf("missing market")
+	}
+
+	priceFeed := &PriceFeedInfo{
+		ID: uuid.New().String(),
+		Market: Market{
+			BaseAsset:  market.GetBaseAsset(),
+			QuoteAsset: market.GetQuoteAsset(),
 		},
 	}
+	if err := priceFeed.setDerived(legs, maxAge); err != nil {
+		return nil, err
+	}
+	return priceFeed, nil
 }
 
-type priceFeedInfo pricefeeder.PriceFeed
+// setDerived makes the price feed derived from the given legs, with the given
+// max age of their prices. Nil legs and zero max age are left unchanged.
+func (p *PriceFeedInfo) setDerived(legs []ports.PriceLeg, maxAge uint32) error {
+	if maxAge == 0 {
+		maxAge = p.LegsMaxAge
+	}
+	if maxAge == 0 {
+		maxAge = defaultMaxAge
+	}
+	if legs == nil {
+		if !p.isDerived() {
+			return fmt.Errorf("missing price legs")
+		}
+		p.LegsMaxAge = maxAge
+		return nil
+	}
+	if len(legs) <= 0 {
+		return fmt.Errorf("missing price legs")
+	}
 
-func (i priceFeedInfo) GetMarket() ports.Market {
-	return Market{
-		BaseAsset:  i.Market.BaseAsset,
-		QuoteAsset: i.Market.QuoteAsset,
+	priceLegs := make([]PriceLeg, 0, len(legs))
+	priceHeaders := make(map[string]map[string]string)
+	for _, leg := range legs {
+		if leg == nil {
+			return fmt.Errorf("missing price leg")
+		}
+		priceSource, headers, err := newPriceSource(
+			leg.GetSource(), leg.GetTicker(),
+		)
+		if err != nil {
+			return err
+		}
+		if len(headers) > 0 {
+			priceHeaders[priceSource.Ticker] = headers
+		}
+
+		priceLeg := PriceLeg{priceSource, leg.IsInverse()}
+		for _, l := range priceLegs {
+			if l.PriceSource == priceLeg.PriceSource {
+				return fmt.Errorf(
+					"duplicated price leg %s %s", l.Source, l.Ticker,
+				)
+			}
+		}
+		priceLegs = append(priceLegs, priceLeg)
+	}
+	// A single leg makes sense only to invert the price of a ticker.
+	if len(priceLegs) == 1 && !priceLegs[0].Inverse {
+		return fmt.Errorf(
+			"derived price feed requires at least 2 legs or an inverse one",
+		)
+	}
+
+	p.setPlain()
+	p.Source = DerivedSource
+	p.Ticker = ""
+	p.Legs = priceLegs
+	p.LegsMaxAge = maxAge
+	if len(priceHeaders) > 0 {
+		p.Headers = priceHeaders
 	}
+	return nil
+}
+
+// newPriceSource validates the given source and ticker. The request headers
+// of a rest ticker, if any, are removed from it and returned apart.
+func newPriceSource(
+	source, ticker string,
+) (PriceSource, map[string]string, error) {
+	if err := validatePriceSource(source, ticker); err != nil {
+		return PriceSource{}, nil, err
+	}
+	if source != restSource {
+		return PriceSource{source, ticker}, nil, nil
+	}
+
+	ticker, headers, err := restfeeder.SplitHeaders(ticker)
+	if err != nil {
+		return PriceSource{}, nil, fmt.Errorf("invalid %s ticker: %s", source, err)
+	}
+	return PriceSource{source, ticker}, headers, nil
+}
+
+func validatePriceSource(source, ticker string) error {
+	if len(source) <= 0 {
+		return fmt.Errorf("missing price source")
+	}
+	if _, ok := feederFactory[source]; !ok {
+		return fmt.Errorf("unknown price source")
+	}
+	if len(ticker) <= 0 {
+		return fmt.Errorf("missing market ticker")
+	}
+	if validateTicker, ok := tickerValidators[source]; ok {
+		if err := validateTicker(ticker); err != nil {
+			return fmt.Errorf("invalid %s ticker: %s", source, err)
+		}
+	}
+	return nil
+}
+
+const (
+	// PriceTickReceived is the type of the prices received by a price feed
+	// from its sources.
+	PriceTickReceived = iota + 1
+	// PriceTickApplied is the type of the prices applied by a price feed to
+	// its market.
+	PriceTickApplied
+)
+
+// PriceTick is a price received or applied by a price feed. Applied ticks
+// refer to the received one that produced them, and share its source and
+// ticker.
+type PriceTick struct {
+	ID          string
+	PriceFeedID string `badgerhold:"index"`
+	Type        int
+	OriginID    string
+	Source      string
+	Ticker      string
+	BasePrice   decimal.Decimal
+	QuotePrice  decimal.Decimal
+	Timestamp   time.Time `badgerhold:"index"`
+}
+
+func (t PriceTick) GetId() string {
+	return t.ID
+}
+
+func (t PriceTick) GetPriceFeedId() string {
+	return t.PriceFeedID
+}
+
+func (t PriceTick) GetType() ports.PriceTickType {
+	return priceTickType(t.Type)
+}
+
+func (t PriceTick) GetOriginId() string {
+	return t.OriginID
+}
+
+func (t PriceTick) GetSource() string {
+	return t.Source
+}
+
+func (t PriceTick) GetTicker() string {
+	return t.Ticker
+}
+
+func (t PriceTick) GetPrice() ports.MarketPrice {
+	return t
+}
+
+func (t PriceTick) GetBasePrice() decimal.Decimal {
+	return t.BasePrice
+}
+
+func (t PriceTick) GetQuotePrice() decimal.Decimal {
+	return t.QuotePrice
+}
+
+func (t PriceTick) GetTimestamp() int64 {
+	return t.Timestamp.UnixNano() / int64(time.Millisecond)
+}
+
+type priceTickType int
+
+func (t priceTickType) IsReceived() bool {
+	return t == PriceTickReceived
+}
+
+func (t priceTickType) IsApplied() bool {
+	return t == PriceTickApplied
+}
+
+// PriceTickFilter matches the ticks of a price feed. A zero Type matches any
+// type, zero times mean unbounded and a zero Limit means no limit.
+type PriceTickFilter struct {
+	PriceFeedID string
+	Type        int
+	StartTime   time.Time
+	EndTime     time.Time
+	Offset      int
+	Limit       int
+}
+
+func newPriceTick(
+	priceFeedID string, tickType int, src PriceSource,
+	price pricefeeder.Price, now time.Time,
+) PriceTick {
+	return PriceTick{
+		ID:          uuid.New().String(),
+		PriceFeedID: priceFeedID,
+		Type:        tickType,
+		Source:      src.Source,
+		Ticker:      src.Ticker,
+		BasePrice:   price.BasePrice,
+		QuotePrice:  price.QuotePrice,
+		Timestamp:   now,
+	}
+}
+
+type priceFeedInfo struct {
+	market Market
+	price  pricefeeder.Price
+	tickId string
+}
+
+func (i priceFeedInfo) GetMarket() ports.Market {
+	return i.market
 }
 func (i priceFeedInfo) GetBasePrice() decimal.Decimal {
-	return i.Price.BasePrice
+	return i.price.BasePrice
 }
 func (i priceFeedInfo) GetQuotePrice() decimal.Decimal {
-	return i.Price.QuotePrice
+	return i.price.QuotePrice
 }
 func (i priceFeedInfo) GetPrice() ports.MarketPrice {
 	return i
 }
+func (i priceFeedInfo) GetTickId() string {
+	return i.tickId
+}
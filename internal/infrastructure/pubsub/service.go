@@ -3,6 +3,7 @@ package pubsub
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"sort"
 	"time"
@@ -15,6 +16,9 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// DBFile is the name of the file of the internal store in the db datadir.
+const DBFile = "pubsub.db"
+
 type service struct {
 	store      store
 	httpClient *client
@@ -39,6 +43,41 @@ func (ws *service) Store() ports.PubSubStore {
 	return ws.store
 }
 
+// Backup adds a consistent snapshot of the internal store to the given backup,
+// if supported by the secure store.
+func (ws *service) Backup(w ports.BackupWriter) error {
+	store, ok := ws.store.store.(interface{ Backup(w io.Writer) error })
+	if !ok {
+		return nil
+	}
+	return w.WriteEntry(DBFile, ports.BackupEntryFile, store.Backup)
+}
+
+// StoreStats returns the usage stats of the internal store, if supported by
+// the secure store.
+func (ws *service) StoreStats() ([]ports.StoreStats, error) {
+	store, ok := ws.store.store.(securestore.CompactableStorage)
+	if !ok {
+		return nil, nil
+	}
+	stats, err := store.Stats()
+	if err != nil {
+		return nil, err
+	}
+	return []ports.StoreStats{storeStats{stats}}, nil
+}
+
+// RunGC compacts the internal store, if supported by the secure store.
+// Compaction is the only way to reclaim the disk space left unused by the
+// store, therefore nothing is done unless compact is true.
+func (ws *service) RunGC(compact bool) error {
+	store, ok := ws.store.store.(securestore.CompactableStorage)
+	if !ok || !compact {
+		return nil
+	}
+	return store.Compact()
+}
+
 func (ws *service) Subscribe(topic, endpoint, secret string) (string, error) {
 	sub, err := NewSubscription(topic, endpoint, secret)
 	if err != nil {
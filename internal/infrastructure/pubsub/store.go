@@ -83,3 +83,23 @@ func (s store) Close() error {
 func (s store) db() securestore.SecureStorage {
 	return s.store
 }
+
+type storeStats struct {
+	securestore.StorageStats
+}
+
+func (s storeStats) GetName() string {
+	return DBFile
+}
+func (s storeStats) GetDiskUsage() int64 {
+	return s.DiskUsage
+}
+func (s storeStats) GetKeyCount() uint64 {
+	return s.KeyCount
+}
+func (s storeStats) GetLastGC() int64 {
+	if s.LastCompaction.Unix() <= 0 {
+		return 0
+	}
+	return s.LastCompaction.Unix()
+}
@@ -0,0 +1,113 @@
+package dbbadger
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+type balanceSnapshotRepositoryImpl struct {
+	store *badgerhold.Store
+}
+
+// NewBalanceSnapshotRepositoryImpl initialize a badger implementation of the
+// domain.BalanceSnapshotRepository
+func NewBalanceSnapshotRepositoryImpl(
+	store *badgerhold.Store,
+) domain.BalanceSnapshotRepository {
+	return balanceSnapshotRepositoryImpl{store}
+}
+
+func (r balanceSnapshotRepositoryImpl) AddBalanceSnapshots(
+	ctx context.Context, snapshots []domain.BalanceSnapshot,
+) (int, error) {
+	count := 0
+	for _, snapshot := range snapshots {
+		done, err := r.insertBalanceSnapshot(ctx, snapshot)
+		if err != nil {
+			return -1, err
+		}
+		if done {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r balanceSnapshotRepositoryImpl) GetBalanceSnapshots(
+	ctx context.Context, accountName string, startTime, endTime int64,
+	cursor *domain.Cursor, limit int,
+) ([]domain.BalanceSnapshot, error) {
+	// An empty account name is the owner of the history of all accounts.
+	snapshots := make([]domain.BalanceSnapshot, 0)
+	if err := runTx(ctx, r.store.Badger(), false, func(tx *badger.Txn) error {
+		return balanceSnapshotCursorIndex.iterate(
+			tx, accountName, cursor, startTime, endTime,
+			func(key string) (bool, error) {
+				var snapshot domain.BalanceSnapshot
+				if err := r.store.TxGet(tx, key, &snapshot); err != nil {
+					if err == badgerhold.ErrNotFound {
+						return true, nil
+					}
+					return false, err
+				}
+				snapshots = append(snapshots, snapshot)
+				return limit <= 0 || len(snapshots) < limit, nil
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+func (r balanceSnapshotRepositoryImpl) insertBalanceSnapshot(
+	ctx context.Context, snapshot domain.BalanceSnapshot,
+) (bool, error) {
+	key := snapshot.Key()
+	err := runTx(ctx, r.store.Badger(), true, func(tx *badger.Txn) error {
+		if err := r.store.TxInsert(tx, key, &snapshot); err != nil {
+			return err
+		}
+		for _, owner := range []string{snapshot.AccountName, allAccounts} {
+			if err := balanceSnapshotCursorIndex.add(
+				tx, owner, snapshot.Cursor(), key,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if err == badgerhold.ErrKeyExists {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// buildCursorIndex indexes the snapshots stored before the introduction of
+// the cursor index.
+func (r balanceSnapshotRepositoryImpl) buildCursorIndex() error {
+	return balanceSnapshotCursorIndex.build(r.store, r.forEachCursor)
+}
+
+// forEachCursor calls fn with the owners, cursor and key of every stored
+// snapshot.
+func (r balanceSnapshotRepositoryImpl) forEachCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return r.store.ForEach(
+		&badgerhold.Query{}, func(snapshot *domain.BalanceSnapshot) error {
+			for _, owner := range []string{snapshot.AccountName, allAccounts} {
+				if err := fn(owner, snapshot.Cursor(), snapshot.Key()); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
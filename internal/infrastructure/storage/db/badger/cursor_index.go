@@ -0,0 +1,215 @@
+package dbbadger
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+// cursorIndex is a secondary index of the records of a type, grouped by owner
+// (market or account) and sorted by cursor. Every entry maps the key
+// <prefix><owner>0x00<timestamp><id> to the key of the indexed record, so that
+// pages of records can be fetched with a seek rather than by loading and
+// sorting all of them.
+type cursorIndex struct {
+	prefix string
+}
+
+// cursorIndexer is implemented by the repositories whose records are paged
+// with a cursor index.
+type cursorIndexer interface {
+	buildCursorIndex() error
+}
+
+// allAccounts and allMarkets are the owner of the cursor index entries of the
+// records of all accounts and markets.
+const (
+	allAccounts = ""
+	allMarkets  = ""
+)
+
+var (
+	tradeCursorIndex      = cursorIndex{"_cursor:trade:"}
+	depositCursorIndex    = cursorIndex{"_cursor:deposit:"}
+	withdrawalCursorIndex = cursorIndex{"_cursor:withdrawal:"}
+	// Balance snapshots are indexed both by account and, with an empty owner,
+	// all together, the latter being used to get the history of all accounts.
+	balanceSnapshotCursorIndex = cursorIndex{"_cursor:balancesnapshot:"}
+	// Trades, deposits and withdrawals are indexed also all together, with an
+	// empty owner, to list those of all markets and accounts. Deposits and
+	// withdrawals are sorted by the cursor returned by AllAccountsCursor.
+	allTradesCursorIndex      = cursorIndex{"_cursor:alltrades:"}
+	allDepositsCursorIndex    = cursorIndex{"_cursor:alldeposits:"}
+	allWithdrawalsCursorIndex = cursorIndex{"_cursor:allwithdrawals:"}
+	// Trades are indexed also by market and swap request id, the owner being
+	// the pair returned by swapRequestOwner, to find the trades of a retried
+	// swap request without scanning all of them.
+	tradeSwapRequestIndex = cursorIndex{"_cursor:tradeswaprequest:"}
+)
+
+func (i cursorIndex) ownerPrefix(owner string) []byte {
+	prefix := make([]byte, 0, len(i.prefix)+len(owner)+1)
+	prefix = append(prefix, i.prefix...)
+	prefix = append(prefix, owner...)
+	return append(prefix, 0)
+}
+
+func (i cursorIndex) key(owner string, cursor domain.Cursor) []byte {
+	key := i.ownerPrefix(owner)
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, uint64(cursor.Timestamp))
+	key = append(key, timestamp...)
+	return append(key, cursor.Id...)
+}
+
+// builtKey is the key marking the index as built for all existing records.
+func (i cursorIndex) builtKey() []byte {
+	return []byte("_cursorbuilt:" + i.prefix)
+}
+
+func (i cursorIndex) add(
+	tx *badger.Txn, owner string, cursor domain.Cursor, recordKey string,
+) error {
+	return tx.Set(i.key(owner, cursor), []byte(recordKey))
+}
+
+func (i cursorIndex) remove(
+	tx *badger.Txn, owner string, cursor domain.Cursor,
+) error {
+	return tx.Delete(i.key(owner, cursor))
+}
+
+// iterate calls fn with the keys of the records of the given owner that come
+// after the cursor, from the most recent to the oldest, until fn returns false.
+// Records more recent than endTime or older than startTime are skipped
+// without being visited, if these are greater than zero.
+func (i cursorIndex) iterate(
+	tx *badger.Txn, owner string, cursor *domain.Cursor,
+	startTime, endTime int64, fn func(recordKey string) (bool, error),
+) error {
+	prefix := i.ownerPrefix(owner)
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = true
+	opts.Prefix = prefix
+	it := tx.NewIterator(opts)
+	defer it.Close()
+
+	// In reverse mode, Seek moves to the greatest key lower or equal to the
+	// given one.
+	var seekKey, cursorKey []byte
+	if cursor != nil {
+		cursorKey = i.key(owner, *cursor)
+		seekKey = cursorKey
+	}
+	if endTime > 0 && (cursor == nil || cursor.Timestamp > endTime) {
+		seekKey = i.key(owner, domain.Cursor{Timestamp: endTime + 1})
+	}
+	if seekKey == nil {
+		seekKey = append(append([]byte{}, prefix...), 0xff)
+	}
+
+	for it.Seek(seekKey); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		key := item.Key()
+		if cursorKey != nil && bytes.Equal(key, cursorKey) {
+			continue
+		}
+		if startTime > 0 {
+			timestamp := binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8])
+			if int64(timestamp) < startTime {
+				break
+			}
+		}
+
+		recordKey, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		next, err := fn(string(recordKey))
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+	}
+	return nil
+}
+
+// build indexes all the records of the store, unless already done. It is
+// required for the stores created before the introduction of the index and
+// it's run by the datadir migration calling BuildCursorIndexes.
+func (i cursorIndex) build(
+	store *badgerhold.Store,
+	forEach func(fn func(owner string, cursor domain.Cursor, key string) error) error,
+) error {
+	db := store.Badger()
+	built, err := i.isBuilt(db)
+	if err != nil {
+		return err
+	}
+	if built {
+		return nil
+	}
+
+	batch := db.NewWriteBatch()
+	defer batch.Cancel()
+
+	count := 0
+	if err := forEach(func(owner string, cursor domain.Cursor, key string) error {
+		count++
+		return batch.Set(i.key(owner, cursor), []byte(key))
+	}); err != nil {
+		return err
+	}
+	// An empty store is left untouched, the records added from now on are
+	// indexed on insertion.
+	if count <= 0 {
+		return nil
+	}
+	if err := batch.Set(i.builtKey(), []byte{}); err != nil {
+		return err
+	}
+	return batch.Flush()
+}
+
+func (i cursorIndex) isBuilt(db *badger.DB) (bool, error) {
+	built := false
+	err := db.View(func(tx *badger.Txn) error {
+		_, err := tx.Get(i.builtKey())
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+		built = err == nil
+		return err
+	})
+	return built, err
+}
+
+// txKey is the context key of the read transaction of a db opened by
+// RunReadTransaction.
+type txKey struct {
+	db *badger.DB
+}
+
+// runTx runs fn in the transaction carried by the context, if any, or in a
+// new one otherwise.
+func runTx(
+	ctx context.Context, db *badger.DB, update bool,
+	fn func(tx *badger.Txn) error,
+) error {
+	if tx, ok := ctx.Value(txKey{db}).(*badger.Txn); ok {
+		return fn(tx)
+	}
+	if ctx.Value("tx") != nil {
+		return fn(ctx.Value("tx").(*badger.Txn))
+	}
+	if update {
+		return db.Update(fn)
+	}
+	return db.View(fn)
+}
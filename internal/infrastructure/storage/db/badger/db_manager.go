@@ -1,29 +1,43 @@
 package dbbadger
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/dgraph-io/badger/v3/options"
-	log "github.com/sirupsen/logrus"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	"github.com/timshannon/badgerhold/v4"
 )
 
+// Names of the directories of the stores, relative to the base data dir.
+const (
+	marketsDir = "markets"
+	pricesDir  = "prices"
+	tradesDir  = "trades"
+	txsDir     = "transactions"
+	balanceDir = "balances"
+)
+
 // repoManager holds all the badgerhold stores in a single data structure.
 type repoManager struct {
-	marketStore *badgerhold.Store
-	priceStore  *badgerhold.Store
-	tradeStore  *badgerhold.Store
-	txStore     *badgerhold.Store
+	marketStore  *badgerhold.Store
+	priceStore   *badgerhold.Store
+	tradeStore   *badgerhold.Store
+	txStore      *badgerhold.Store
+	balanceStore *badgerhold.Store
 
 	marketRepository     domain.MarketRepository
 	tradeRepository      domain.TradeRepository
 	depositRepository    domain.DepositRepository
 	withdrawalRepository domain.WithdrawalRepository
+	balanceSnapshotRepo  domain.BalanceSnapshotRepository
+
+	gc *gcManager
 }
 
 // NewRepoManager opens (or creates if not exists) the badger store on disk.
@@ -31,45 +45,85 @@ type repoManager struct {
 // It creates a dedicated directory for main and prices stores, while the
 // unspent repository lives in memory.
 func NewRepoManager(baseDbDir string, logger badger.Logger) (ports.RepoManager, error) {
-	var marketsDir, pricesDir, tradesDir, txsDir string
+	return newRepoManager(baseDbDir, logger, false)
+}
+
+// NewReadOnlyRepoManager opens the existing badger stores on disk in
+// read-only mode, for a replica of the daemon. Nothing is written to the
+// stores, therefore the datadir must have been already migrated by a daemon
+// in normal mode, and the value-log GC is not run.
+func NewReadOnlyRepoManager(
+	baseDbDir string, logger badger.Logger,
+) (ports.RepoManager, error) {
+	if len(baseDbDir) <= 0 {
+		return nil, fmt.Errorf("missing db dir for read-only stores")
+	}
+	return newRepoManager(baseDbDir, logger, true)
+}
+
+func newRepoManager(
+	baseDbDir string, logger badger.Logger, readOnly bool,
+) (ports.RepoManager, error) {
+	var marketsDbDir, pricesDbDir, tradesDbDir, txsDbDir, balanceDbDir string
 	if len(baseDbDir) > 0 {
-		marketsDir = filepath.Join(baseDbDir, "markets")
-		pricesDir = filepath.Join(baseDbDir, "prices")
-		tradesDir = filepath.Join(baseDbDir, "trades")
-		txsDir = filepath.Join(baseDbDir, "transactions")
+		marketsDbDir = filepath.Join(baseDbDir, marketsDir)
+		pricesDbDir = filepath.Join(baseDbDir, pricesDir)
+		tradesDbDir = filepath.Join(baseDbDir, tradesDir)
+		txsDbDir = filepath.Join(baseDbDir, txsDir)
+		balanceDbDir = filepath.Join(baseDbDir, balanceDir)
 	}
 
-	marketDb, err := createDb(marketsDir, logger)
+	marketDb, err := createDb(marketsDbDir, logger, readOnly)
 	if err != nil {
 		return nil, fmt.Errorf("opening main db: %w", err)
 	}
-	priceDb, err := createDb(pricesDir, logger)
+	priceDb, err := createDb(pricesDbDir, logger, readOnly)
 	if err != nil {
 		return nil, fmt.Errorf("opening prices db: %w", err)
 	}
-	tradeDb, err := createDb(tradesDir, logger)
+	tradeDb, err := createDb(tradesDbDir, logger, readOnly)
 	if err != nil {
 		return nil, fmt.Errorf("opening unspents db: %w", err)
 	}
-	txDb, err := createDb(txsDir, logger)
+	txDb, err := createDb(txsDbDir, logger, readOnly)
 	if err != nil {
 		return nil, fmt.Errorf("opening unspents db: %w", err)
 	}
 
+	balanceDb, err := createDb(balanceDbDir, logger, readOnly)
+	if err != nil {
+		return nil, fmt.Errorf("opening balances db: %w", err)
+	}
+
 	marketRepo := NewMarketRepositoryImpl(marketDb, priceDb)
 	tradeRepo := NewTradeRepositoryImpl(tradeDb)
 	depositRepository := NewDepositRepositoryImpl(txDb)
 	withdrawalRepository := NewWithdrawalRepositoryImpl(txDb)
+	balanceSnapshotRepo := NewBalanceSnapshotRepositoryImpl(balanceDb)
+
+	gc := newGCManager([]*gcStore{
+		{name: marketsDir, store: marketDb},
+		{name: pricesDir, store: priceDb},
+		{name: tradesDir, store: tradeDb},
+		{name: txsDir, store: txDb},
+		{name: balanceDir, store: balanceDb},
+	})
+	if len(baseDbDir) > 0 && !readOnly {
+		gc.start()
+	}
 
 	return &repoManager{
 		marketStore:          marketDb,
 		priceStore:           priceDb,
 		tradeStore:           tradeDb,
 		txStore:              txDb,
+		balanceStore:         balanceDb,
 		marketRepository:     marketRepo,
 		tradeRepository:      tradeRepo,
 		depositRepository:    depositRepository,
 		withdrawalRepository: withdrawalRepository,
+		balanceSnapshotRepo:  balanceSnapshotRepo,
+		gc:                   gc,
 	}, nil
 }
 
@@ -89,11 +143,68 @@ func (d *repoManager) WithdrawalRepository() domain.WithdrawalRepository {
 	return d.withdrawalRepository
 }
 
+func (d *repoManager) BalanceSnapshotRepository() domain.BalanceSnapshotRepository {
+	return d.balanceSnapshotRepo
+}
+
+// RunReadTransaction runs fn within a read transaction of each of the stores
+// of trades, transactions and balances, which are taken one after the other
+// before running it. Markets and prices are read outside of it.
+func (d *repoManager) RunReadTransaction(
+	ctx context.Context, fn func(ctx context.Context) error,
+) error {
+	return viewAll(
+		ctx, []*badger.DB{
+			d.tradeStore.Badger(), d.txStore.Badger(), d.balanceStore.Badger(),
+		}, fn,
+	)
+}
+
 func (d *repoManager) Close() {
+	d.gc.stop()
 	d.marketStore.Close()
 	d.priceStore.Close()
 	d.tradeStore.Close()
 	d.txStore.Close()
+	d.balanceStore.Close()
+}
+
+// Backup adds a consistent snapshot of every store to the given backup.
+func (d *repoManager) Backup(w ports.BackupWriter) error {
+	stores := []struct {
+		name  string
+		store *badgerhold.Store
+	}{
+		{marketsDir, d.marketStore},
+		{pricesDir, d.priceStore},
+		{tradesDir, d.tradeStore},
+		{txsDir, d.txStore},
+		{balanceDir, d.balanceStore},
+	}
+	for _, s := range stores {
+		db := s.store.Badger()
+		if err := w.WriteEntry(
+			s.name, ports.BackupEntryBadger, func(w io.Writer) error {
+				_, err := db.Backup(w, 0)
+				return err
+			},
+		); err != nil {
+			return fmt.Errorf("failed to backup %s db: %w", s.name, err)
+		}
+	}
+	return nil
+}
+
+// StoreStats returns the disk usage, the number of keys and the time of the
+// last value-log GC of every store.
+func (d *repoManager) StoreStats() ([]ports.StoreStats, error) {
+	return d.gc.storeStats()
+}
+
+// RunGC runs the value-log GC of every store until there's nothing left to
+// rewrite. With compact, the LSM tree of the stores is compacted beforehand.
+func (d *repoManager) RunGC(compact bool) error {
+	return d.gc.runGC(compact, true)
 }
 
 // isTransactionConflict returns whether the error occurred when committing a
@@ -102,6 +213,24 @@ func isTransactionConflict(err error) bool {
 	return err == badger.ErrConflict
 }
 
+// filterQuery returns the query for the deposits or withdrawals of the given
+// account matching the filter. The asset can't be matched by a query because
+// amounts are stored as a map, therefore records must be also filtered with
+// Matches.
+func filterQuery(accountName string, filter domain.TxFilter) *badgerhold.Query {
+	query := badgerhold.Where("AccountName").Eq(accountName)
+	if filter.TxID != "" {
+		query.And("TxID").Eq(filter.TxID)
+	}
+	if filter.StartTime > 0 {
+		query.And("Timestamp").Ge(filter.StartTime)
+	}
+	if filter.EndTime > 0 {
+		query.And("Timestamp").Le(filter.EndTime)
+	}
+	return query
+}
+
 // EncodeKey encodes key values with a type prefix which allows multiple
 // different types to exist in the badger DB
 func EncodeKey(key interface{}, typeName string) ([]byte, error) {
@@ -113,11 +242,58 @@ func EncodeKey(key interface{}, typeName string) ([]byte, error) {
 	return append([]byte(typeName), encoded...), nil
 }
 
-func createDb(dbDir string, logger badger.Logger) (*badgerhold.Store, error) {
+// BuildCursorIndexes indexes, in the existing trades, transactions and
+// balances stores of the given db datadir, all the records stored before the
+// introduction of the cursor indexes. Stores already indexed are left
+// untouched. It must run while the stores are closed, as a datadir migration.
+func BuildCursorIndexes(baseDbDir string, logger badger.Logger) error {
+	stores := []struct {
+		dir      string
+		indexers func(store *badgerhold.Store) []cursorIndexer
+	}{
+		{tradesDir, func(store *badgerhold.Store) []cursorIndexer {
+			return []cursorIndexer{tradeRepositoryImpl{store}}
+		}},
+		{txsDir, func(store *badgerhold.Store) []cursorIndexer {
+			return []cursorIndexer{
+				depositRepositoryImpl{store}, withdrawalRepositoryImpl{store},
+			}
+		}},
+		{balanceDir, func(store *badgerhold.Store) []cursorIndexer {
+			return []cursorIndexer{balanceSnapshotRepositoryImpl{store}}
+		}},
+	}
+
+	for _, s := range stores {
+		dir := filepath.Join(baseDbDir, s.dir)
+		if _, err := os.Stat(dir); os.IsNotExist(err) {
+			continue
+		}
+		store, err := createDb(dir, logger, false)
+		if err != nil {
+			return fmt.Errorf("opening %s db: %w", s.dir, err)
+		}
+		for _, indexer := range s.indexers(store) {
+			if err := indexer.buildCursorIndex(); err != nil {
+				store.Close()
+				return fmt.Errorf("building cursor index of %s db: %w", s.dir, err)
+			}
+		}
+		if err := store.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func createDb(
+	dbDir string, logger badger.Logger, readOnly bool,
+) (*badgerhold.Store, error) {
 	isInMemory := len(dbDir) <= 0
 
 	opts := badger.DefaultOptions(dbDir)
 	opts.Logger = logger
+	opts.ReadOnly = readOnly
 
 	if isInMemory {
 		opts.InMemory = true
@@ -135,18 +311,18 @@ func createDb(dbDir string, logger badger.Logger) (*badgerhold.Store, error) {
 		return nil, err
 	}
 
-	if !isInMemory {
-		ticker := time.NewTicker(30 * time.Minute)
+	return db, nil
+}
 
-		go func() {
-			for {
-				<-ticker.C
-				if err := db.Badger().RunValueLogGC(0.5); err != nil && err != badger.ErrNoRewrite {
-					log.Error(err)
-				}
-			}
-		}()
+// viewAll runs fn within a read transaction of every given db, carried by the
+// context.
+func viewAll(
+	ctx context.Context, dbs []*badger.DB, fn func(ctx context.Context) error,
+) error {
+	if len(dbs) <= 0 {
+		return fn(ctx)
 	}
-
-	return db, nil
+	return dbs[0].View(func(tx *badger.Txn) error {
+		return viewAll(context.WithValue(ctx, txKey{dbs[0]}, tx), dbs[1:], fn)
+	})
 }
@@ -22,7 +22,7 @@ func NewDepositRepositoryImpl(
 func (d depositRepositoryImpl) AddDeposits(
 	ctx context.Context,
 	deposits []domain.Deposit,
-) (int, error) {
+) ([]domain.Deposit, error) {
 	return d.insertDeposits(ctx, deposits)
 }
 
@@ -37,6 +37,71 @@ func (d depositRepositoryImpl) GetDepositsForAccount(
 	return d.findDeposits(ctx, query)
 }
 
+func (d depositRepositoryImpl) GetDepositsForAccountAfter(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]domain.Deposit, error) {
+	return d.findDepositsAfter(
+		ctx, depositCursorIndex, accountName, filter, cursor, limit,
+	)
+}
+
+func (d depositRepositoryImpl) GetAllDepositsAfter(
+	ctx context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Deposit, error) {
+	return d.findDepositsAfter(
+		ctx, allDepositsCursorIndex, allAccounts, filter, cursor, limit,
+	)
+}
+
+// findDepositsAfter returns at most limit deposits of the given owner of the
+// cursor index matching the filter that follow the cursor.
+func (d depositRepositoryImpl) findDepositsAfter(
+	ctx context.Context, index cursorIndex, owner string,
+	filter domain.TxFilter, cursor *domain.Cursor, limit int,
+) ([]domain.Deposit, error) {
+	deposits := make([]domain.Deposit, 0)
+	if err := runTx(ctx, d.store.Badger(), false, func(tx *badger.Txn) error {
+		return index.iterate(
+			tx, owner, cursor, filter.StartTime, filter.EndTime,
+			func(key string) (bool, error) {
+				var deposit domain.Deposit
+				if err := d.store.TxGet(tx, key, &deposit); err != nil {
+					if err == badgerhold.ErrNotFound {
+						return true, nil
+					}
+					return false, err
+				}
+				if deposit.Matches(filter) {
+					deposits = append(deposits, deposit)
+				}
+				return limit <= 0 || len(deposits) < limit, nil
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+func (d depositRepositoryImpl) GetDepositsTotalAmountPerAsset(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	deposits, err := d.findDeposits(ctx, filterQuery(accountName, filter))
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]uint64)
+	for _, deposit := range deposits {
+		if deposit.Matches(filter) {
+			filter.AddAmounts(totals, deposit.TotAmountPerAsset)
+		}
+	}
+	return totals, nil
+}
+
 func (d depositRepositoryImpl) GetAllDeposits(
 	ctx context.Context, page domain.Page,
 ) ([]domain.Deposit, error) {
@@ -50,31 +115,38 @@ func (d depositRepositoryImpl) GetAllDeposits(
 
 func (d depositRepositoryImpl) insertDeposits(
 	ctx context.Context, deposits []domain.Deposit,
-) (int, error) {
-	count := 0
+) ([]domain.Deposit, error) {
+	added := make([]domain.Deposit, 0, len(deposits))
 	for _, dd := range deposits {
 		done, err := d.insertDeposit(ctx, dd)
 		if err != nil {
-			return -1, err
+			return nil, err
 		}
 		if done {
-			count++
+			added = append(added, dd)
 		}
 	}
 
-	return count, nil
+	return added, nil
 }
 
 func (d depositRepositoryImpl) insertDeposit(
 	ctx context.Context, deposit domain.Deposit,
 ) (bool, error) {
-	var err error
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = d.store.TxInsert(tx, deposit.Key(), &deposit)
-	} else {
-		err = d.store.Insert(deposit.Key(), &deposit)
-	}
+	key := deposit.Key()
+	err := runTx(ctx, d.store.Badger(), true, func(tx *badger.Txn) error {
+		if err := d.store.TxInsert(tx, key, &deposit); err != nil {
+			return err
+		}
+		if err := depositCursorIndex.add(
+			tx, deposit.AccountName, deposit.Cursor(), key,
+		); err != nil {
+			return err
+		}
+		return allDepositsCursorIndex.add(
+			tx, allAccounts, deposit.AllAccountsCursor(), key,
+		)
+	})
 	if err != nil {
 		if err == badgerhold.ErrKeyExists {
 			return false, nil
@@ -88,18 +160,46 @@ func (d depositRepositoryImpl) findDeposits(
 	ctx context.Context, query *badgerhold.Query,
 ) ([]domain.Deposit, error) {
 	var deposits []domain.Deposit
-	var err error
 
 	query.SortBy("Timestamp").Reverse()
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = d.store.TxFind(tx, &deposits, query)
-	} else {
-		err = d.store.Find(&deposits, query)
-	}
-	if err != nil {
+	if err := runTx(ctx, d.store.Badger(), false, func(tx *badger.Txn) error {
+		return d.store.TxFind(tx, &deposits, query)
+	}); err != nil {
 		return nil, err
 	}
 
 	return deposits, nil
 }
+
+// buildCursorIndex indexes the deposits stored before the introduction of the
+// cursor index.
+func (d depositRepositoryImpl) buildCursorIndex() error {
+	if err := depositCursorIndex.build(d.store, d.forEachCursor); err != nil {
+		return err
+	}
+	return allDepositsCursorIndex.build(d.store, d.forEachAllAccountsCursor)
+}
+
+// forEachCursor calls fn with the owner, cursor and key of every stored
+// deposit.
+func (d depositRepositoryImpl) forEachCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return d.store.ForEach(
+		&badgerhold.Query{}, func(deposit *domain.Deposit) error {
+			return fn(deposit.AccountName, deposit.Cursor(), deposit.Key())
+		},
+	)
+}
+
+// forEachAllAccountsCursor calls fn with the cursor among those of all
+// accounts and the key of every stored deposit.
+func (d depositRepositoryImpl) forEachAllAccountsCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return d.store.ForEach(
+		&badgerhold.Query{}, func(deposit *domain.Deposit) error {
+			return fn(allAccounts, deposit.AllAccountsCursor(), deposit.Key())
+		},
+	)
+}
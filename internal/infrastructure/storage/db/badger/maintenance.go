@@ -0,0 +1,177 @@
+package dbbadger
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+const (
+	// gcInterval is the period of the value-log GC run in background.
+	gcInterval = 30 * time.Minute
+	// gcDiscardRatio is the min ratio of stale data a value-log file must hold
+	// to get rewritten by the GC.
+	gcDiscardRatio = 0.5
+)
+
+// gcStore keeps track of the last GC of a store.
+type gcStore struct {
+	name   string
+	store  *badgerhold.Store
+	lastGC int64
+}
+
+// gcManager runs the value-log GC of the stores periodically and on demand.
+type gcManager struct {
+	lock   *sync.Mutex
+	stores []*gcStore
+	quit   chan struct{}
+}
+
+func newGCManager(stores []*gcStore) *gcManager {
+	return &gcManager{&sync.Mutex{}, stores, make(chan struct{})}
+}
+
+// start runs the value-log GC of every store at regular intervals.
+func (m *gcManager) start() {
+	ticker := time.NewTicker(gcInterval)
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.runGC(false, false); err != nil {
+					log.Error(err)
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+func (m *gcManager) stop() {
+	select {
+	case <-m.quit:
+	default:
+		close(m.quit)
+	}
+}
+
+// runGC runs the value-log GC of every store. With repeat, the GC is run
+// until there's nothing left to rewrite, otherwise at most once per store.
+// With compact, the LSM tree of every store is also compacted beforehand.
+func (m *gcManager) runGC(compact, repeat bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, s := range m.stores {
+		db := s.store.Badger()
+		if db.Opts().InMemory {
+			continue
+		}
+
+		if compact {
+			if err := db.Flatten(1); err != nil {
+				return err
+			}
+		}
+		for {
+			err := db.RunValueLogGC(gcDiscardRatio)
+			if err == badger.ErrNoRewrite {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if !repeat {
+				break
+			}
+		}
+		atomic.StoreInt64(&s.lastGC, time.Now().Unix())
+	}
+	return nil
+}
+
+func (m *gcManager) storeStats() ([]ports.StoreStats, error) {
+	stats := make([]ports.StoreStats, 0, len(m.stores))
+	for _, s := range m.stores {
+		db := s.store.Badger()
+		diskUsage, err := dirSize(db.Opts().Dir)
+		if err != nil {
+			return nil, err
+		}
+
+		var keyCount uint64
+		if err := db.View(func(txn *badger.Txn) error {
+			opts := badger.DefaultIteratorOptions
+			opts.PrefetchValues = false
+			it := txn.NewIterator(opts)
+			defer it.Close()
+
+			for it.Rewind(); it.Valid(); it.Next() {
+				keyCount++
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, storeStats{
+			name:      s.name,
+			diskUsage: diskUsage,
+			keyCount:  keyCount,
+			lastGC:    atomic.LoadInt64(&s.lastGC),
+		})
+	}
+	return stats, nil
+}
+
+// dirSize returns the size of the files in the given directory, zero for in
+// memory stores.
+func dirSize(dir string) (int64, error) {
+	if dir == "" {
+		return 0, nil
+	}
+
+	var size int64
+	err := filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+type storeStats struct {
+	name      string
+	diskUsage int64
+	keyCount  uint64
+	lastGC    int64
+}
+
+func (s storeStats) GetName() string {
+	return s.name
+}
+func (s storeStats) GetDiskUsage() int64 {
+	return s.diskUsage
+}
+func (s storeStats) GetKeyCount() uint64 {
+	return s.keyCount
+}
+func (s storeStats) GetLastGC() int64 {
+	return s.lastGC
+}
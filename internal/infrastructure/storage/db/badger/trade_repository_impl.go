@@ -52,6 +52,99 @@ func (t tradeRepositoryImpl) GetAllTradesByMarket(
 	return t.findTrades(ctx, query)
 }
 
+func (t tradeRepositoryImpl) GetTradesByMarketAfter(
+	ctx context.Context, marketName string, cursor *domain.Cursor, limit int,
+) ([]domain.Trade, error) {
+	return t.findTradesAfter(
+		ctx, tradeCursorIndex, marketName, 0, 0, cursor, limit,
+	)
+}
+
+func (t tradeRepositoryImpl) GetAllTradesAfter(
+	ctx context.Context, startTime, endTime int64, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Trade, error) {
+	return t.findTradesAfter(
+		ctx, allTradesCursorIndex, allMarkets, startTime, endTime, cursor, limit,
+	)
+}
+
+// findTradesAfter returns at most limit trades of the given owner of the
+// cursor index, within the time range, that follow the cursor.
+func (t tradeRepositoryImpl) findTradesAfter(
+	ctx context.Context, index cursorIndex, owner string,
+	startTime, endTime int64, cursor *domain.Cursor, limit int,
+) ([]domain.Trade, error) {
+	trades := make([]domain.Trade, 0)
+	if err := runTx(ctx, t.store.Badger(), false, func(tx *badger.Txn) error {
+		return index.iterate(
+			tx, owner, cursor, startTime, endTime, func(key string) (bool, error) {
+				var trade domain.Trade
+				if err := t.store.TxGet(tx, key, &trade); err != nil {
+					if err == badgerhold.ErrNotFound {
+						return true, nil
+					}
+					return false, err
+				}
+				trades = append(trades, trade)
+				return limit <= 0 || len(trades) < limit, nil
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+	return trades, nil
+}
+
+func (t tradeRepositoryImpl) GetArchivableTrades(
+	ctx context.Context, before int64, limit int,
+) ([]domain.Trade, error) {
+	query := badgerhold.Where("Status").MatchFunc(
+		func(ra *badgerhold.RecordAccess) (bool, error) {
+			switch trade := ra.Record().(type) {
+			case *domain.Trade:
+				return trade.IsArchivable(before), nil
+			case domain.Trade:
+				return trade.IsArchivable(before), nil
+			default:
+				return false, nil
+			}
+		},
+	).Limit(limit)
+	return t.findTrades(ctx, query)
+}
+
+func (t tradeRepositoryImpl) DeleteTrades(
+	ctx context.Context, ids []string,
+) error {
+	if ctx.Value("tx") != nil {
+		tx := ctx.Value("tx").(*badger.Txn)
+		return t.deleteTrades(tx, ids)
+	}
+	return t.store.Badger().Update(func(tx *badger.Txn) error {
+		return t.deleteTrades(tx, ids)
+	})
+}
+
+func (t tradeRepositoryImpl) deleteTrades(tx *badger.Txn, ids []string) error {
+	for _, id := range ids {
+		var trade domain.Trade
+		if err := t.store.TxGet(tx, id, &trade); err != nil {
+			if err == badgerhold.ErrNotFound {
+				continue
+			}
+			return err
+		}
+		if err := removeTradeIndexes(tx, trade); err != nil {
+			return err
+		}
+		if err := t.store.TxDelete(tx, id, domain.Trade{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (t tradeRepositoryImpl) GetCompletedTradesByMarket(
 	ctx context.Context, marketName string, page domain.Page,
 ) ([]domain.Trade, error) {
@@ -66,6 +159,31 @@ func (t tradeRepositoryImpl) GetCompletedTradesByMarket(
 	return t.findTrades(ctx, query)
 }
 
+func (t tradeRepositoryImpl) GetTradeBySwapRequestId(
+	ctx context.Context, marketName, swapRequestId string,
+) (*domain.Trade, error) {
+	var found *domain.Trade
+	if err := runTx(ctx, t.store.Badger(), false, func(tx *badger.Txn) error {
+		return tradeSwapRequestIndex.iterate(
+			tx, swapRequestOwner(marketName, swapRequestId), nil, 0, 0,
+			func(key string) (bool, error) {
+				var trade domain.Trade
+				if err := t.store.TxGet(tx, key, &trade); err != nil {
+					if err == badgerhold.ErrNotFound {
+						return true, nil
+					}
+					return false, err
+				}
+				found = &trade
+				return false, nil
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
 func (t tradeRepositoryImpl) GetTradeBySwapAcceptId(
 	ctx context.Context, swapAcceptId string,
 ) (*domain.Trade, error) {
@@ -116,6 +234,11 @@ func (t tradeRepositoryImpl) UpdateTrade(
 	if err != nil {
 		return err
 	}
+	prevTrade := *currentTrade
+	if currentTrade.SwapRequest != nil {
+		swapRequest := *currentTrade.SwapRequest
+		prevTrade.SwapRequest = &swapRequest
+	}
 
 	updatedTrade, err := updateFn(currentTrade)
 	if err != nil {
@@ -123,7 +246,7 @@ func (t tradeRepositoryImpl) UpdateTrade(
 	}
 
 	for {
-		err := t.updateTrade(ctx, updatedTrade.Id, *updatedTrade)
+		err := t.updateTrade(ctx, updatedTrade.Id, prevTrade, *updatedTrade)
 		if err != nil {
 			if txIsNotGiven && isTransactionConflict(err) {
 				continue
@@ -137,15 +260,10 @@ func (t tradeRepositoryImpl) findTrades(
 	ctx context.Context, query *badgerhold.Query,
 ) ([]domain.Trade, error) {
 	var trades []domain.Trade
-	var err error
 
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = t.store.TxFind(tx, &trades, query)
-	} else {
-		err = t.store.Find(&trades, query)
-	}
-	if err != nil {
+	if err := runTx(ctx, t.store.Badger(), false, func(tx *badger.Txn) error {
+		return t.store.TxFind(tx, &trades, query)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -161,14 +279,9 @@ func (t tradeRepositoryImpl) getTrade(
 	ctx context.Context, id string,
 ) (*domain.Trade, error) {
 	var trade domain.Trade
-	var err error
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = t.store.TxGet(tx, id, &trade)
-	} else {
-		err = t.store.Get(id, &trade)
-	}
-	if err != nil {
+	if err := runTx(ctx, t.store.Badger(), false, func(tx *badger.Txn) error {
+		return t.store.TxGet(tx, id, &trade)
+	}); err != nil {
 		if err == badgerhold.ErrNotFound {
 			return nil, fmt.Errorf("trade with id %s not found", id)
 		}
@@ -179,25 +292,33 @@ func (t tradeRepositoryImpl) getTrade(
 }
 
 func (t tradeRepositoryImpl) updateTrade(
-	ctx context.Context, id string, trade domain.Trade,
+	ctx context.Context, id string, currentTrade, trade domain.Trade,
 ) error {
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		return t.store.TxUpdate(tx, id, trade)
-	}
-	return t.store.Update(id, trade)
+	return runTx(ctx, t.store.Badger(), true, func(tx *badger.Txn) error {
+		if err := t.store.TxUpdate(tx, id, trade); err != nil {
+			return err
+		}
+		if trade.MarketName == currentTrade.MarketName &&
+			trade.Cursor() == currentTrade.Cursor() &&
+			swapRequestId(trade) == swapRequestId(currentTrade) {
+			return nil
+		}
+		if err := removeTradeIndexes(tx, currentTrade); err != nil {
+			return err
+		}
+		return addTradeIndexes(tx, trade)
+	})
 }
 
 func (t tradeRepositoryImpl) insertTrade(
 	ctx context.Context, trade domain.Trade,
 ) error {
-	var err error
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = t.store.TxInsert(tx, trade.Id, &trade)
-	} else {
-		err = t.store.Insert(trade.Id, &trade)
-	}
+	err := runTx(ctx, t.store.Badger(), true, func(tx *badger.Txn) error {
+		if err := t.store.TxInsert(tx, trade.Id, &trade); err != nil {
+			return err
+		}
+		return addTradeIndexes(tx, trade)
+	})
 	if err != nil {
 		if err == badgerhold.ErrKeyExists {
 			return fmt.Errorf("trade with id %s already exists", trade.Id)
@@ -206,3 +327,111 @@ func (t tradeRepositoryImpl) insertTrade(
 	}
 	return nil
 }
+
+// buildCursorIndex indexes the trades stored before the introduction of the
+// cursor and swap request indexes.
+func (t tradeRepositoryImpl) buildCursorIndex() error {
+	if err := tradeCursorIndex.build(t.store, t.forEachCursor); err != nil {
+		return err
+	}
+	if err := allTradesCursorIndex.build(
+		t.store, t.forEachAllMarketsCursor,
+	); err != nil {
+		return err
+	}
+	return tradeSwapRequestIndex.build(t.store, t.forEachSwapRequest)
+}
+
+// forEachCursor calls fn with the owner, cursor and key of every stored
+// trade.
+func (t tradeRepositoryImpl) forEachCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return t.store.ForEach(
+		&badgerhold.Query{}, func(trade *domain.Trade) error {
+			return fn(trade.MarketName, trade.Cursor(), trade.Id)
+		},
+	)
+}
+
+// forEachAllMarketsCursor calls fn with the cursor and key of every stored
+// trade, owned by all markets.
+func (t tradeRepositoryImpl) forEachAllMarketsCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return t.store.ForEach(
+		&badgerhold.Query{}, func(trade *domain.Trade) error {
+			return fn(allMarkets, trade.Cursor(), trade.Id)
+		},
+	)
+}
+
+// forEachSwapRequest calls fn with the swap request owner, cursor and key of
+// every stored trade with a swap request.
+func (t tradeRepositoryImpl) forEachSwapRequest(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return t.store.ForEach(
+		&badgerhold.Query{}, func(trade *domain.Trade) error {
+			if trade.SwapRequest == nil {
+				return nil
+			}
+			owner := swapRequestOwner(trade.MarketName, trade.SwapRequest.Id)
+			return fn(owner, trade.Cursor(), trade.Id)
+		},
+	)
+}
+
+func addTradeIndexes(tx *badger.Txn, trade domain.Trade) error {
+	if err := tradeCursorIndex.add(
+		tx, trade.MarketName, trade.Cursor(), trade.Id,
+	); err != nil {
+		return err
+	}
+	if err := allTradesCursorIndex.add(
+		tx, allMarkets, trade.Cursor(), trade.Id,
+	); err != nil {
+		return err
+	}
+	if trade.SwapRequest == nil {
+		return nil
+	}
+	return tradeSwapRequestIndex.add(
+		tx, swapRequestOwner(trade.MarketName, trade.SwapRequest.Id),
+		trade.Cursor(), trade.Id,
+	)
+}
+
+func removeTradeIndexes(tx *badger.Txn, trade domain.Trade) error {
+	if err := tradeCursorIndex.remove(
+		tx, trade.MarketName, trade.Cursor(),
+	); err != nil {
+		return err
+	}
+	if err := allTradesCursorIndex.remove(
+		tx, allMarkets, trade.Cursor(),
+	); err != nil {
+		return err
+	}
+	if trade.SwapRequest == nil {
+		return nil
+	}
+	return tradeSwapRequestIndex.remove(
+		tx, swapRequestOwner(trade.MarketName, trade.SwapRequest.Id),
+		trade.Cursor(),
+	)
+}
+
+// swapRequestOwner returns the owner of the entries of the swap request index
+// for the given market and swap request id. The two are separated by a null
+// byte, like the owner from the rest of the key, so that they can't collide.
+func swapRequestOwner(marketName, swapRequestId string) string {
+	return marketName + "\x00" + swapRequestId
+}
+
+func swapRequestId(trade domain.Trade) string {
+	if trade.SwapRequest == nil {
+		return ""
+	}
+	return trade.SwapRequest.Id
+}
@@ -23,7 +23,7 @@ func NewWithdrawalRepositoryImpl(
 func (w withdrawalRepositoryImpl) AddWithdrawals(
 	ctx context.Context,
 	withdrawals []domain.Withdrawal,
-) (int, error) {
+) ([]domain.Withdrawal, error) {
 	return w.insertWithdrawals(ctx, withdrawals)
 }
 
@@ -38,6 +38,71 @@ func (w withdrawalRepositoryImpl) GetWithdrawalsForAccount(
 	return w.findWithdrawals(ctx, query)
 }
 
+func (w withdrawalRepositoryImpl) GetWithdrawalsForAccountAfter(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]domain.Withdrawal, error) {
+	return w.findWithdrawalsAfter(
+		ctx, withdrawalCursorIndex, accountName, filter, cursor, limit,
+	)
+}
+
+func (w withdrawalRepositoryImpl) GetAllWithdrawalsAfter(
+	ctx context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Withdrawal, error) {
+	return w.findWithdrawalsAfter(
+		ctx, allWithdrawalsCursorIndex, allAccounts, filter, cursor, limit,
+	)
+}
+
+// findWithdrawalsAfter returns at most limit withdrawals of the given owner of
+// the cursor index matching the filter that follow the cursor.
+func (w withdrawalRepositoryImpl) findWithdrawalsAfter(
+	ctx context.Context, index cursorIndex, owner string,
+	filter domain.TxFilter, cursor *domain.Cursor, limit int,
+) ([]domain.Withdrawal, error) {
+	withdrawals := make([]domain.Withdrawal, 0)
+	if err := runTx(ctx, w.store.Badger(), false, func(tx *badger.Txn) error {
+		return index.iterate(
+			tx, owner, cursor, filter.StartTime, filter.EndTime,
+			func(key string) (bool, error) {
+				var withdrawal domain.Withdrawal
+				if err := w.store.TxGet(tx, key, &withdrawal); err != nil {
+					if err == badgerhold.ErrNotFound {
+						return true, nil
+					}
+					return false, err
+				}
+				if withdrawal.Matches(filter) {
+					withdrawals = append(withdrawals, withdrawal)
+				}
+				return limit <= 0 || len(withdrawals) < limit, nil
+			},
+		)
+	}); err != nil {
+		return nil, err
+	}
+	return withdrawals, nil
+}
+
+func (w withdrawalRepositoryImpl) GetWithdrawalsTotalAmountPerAsset(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	withdrawals, err := w.findWithdrawals(ctx, filterQuery(accountName, filter))
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[string]uint64)
+	for _, withdrawal := range withdrawals {
+		if withdrawal.Matches(filter) {
+			filter.AddAmounts(totals, withdrawal.TotAmountPerAsset)
+		}
+	}
+	return totals, nil
+}
+
 func (w withdrawalRepositoryImpl) GetAllWithdrawals(
 	ctx context.Context, page domain.Page,
 ) ([]domain.Withdrawal, error) {
@@ -51,31 +116,38 @@ func (w withdrawalRepositoryImpl) GetAllWithdrawals(
 
 func (w withdrawalRepositoryImpl) insertWithdrawals(
 	ctx context.Context, withdrawals []domain.Withdrawal,
-) (int, error) {
-	count := 0
+) ([]domain.Withdrawal, error) {
+	added := make([]domain.Withdrawal, 0, len(withdrawals))
 	for _, ww := range withdrawals {
 		done, err := w.insertWithdrawal(ctx, ww)
 		if err != nil {
-			return -1, err
+			return nil, err
 		}
 		if done {
-			count++
+			added = append(added, ww)
 		}
 	}
-	return count, nil
+	return added, nil
 
 }
 
 func (w withdrawalRepositoryImpl) insertWithdrawal(
 	ctx context.Context, withdrawal domain.Withdrawal,
 ) (bool, error) {
-	var err error
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = w.store.TxInsert(tx, withdrawal.TxID, &withdrawal)
-	} else {
-		err = w.store.Insert(withdrawal.TxID, &withdrawal)
-	}
+	key := withdrawal.TxID
+	err := runTx(ctx, w.store.Badger(), true, func(tx *badger.Txn) error {
+		if err := w.store.TxInsert(tx, key, &withdrawal); err != nil {
+			return err
+		}
+		if err := withdrawalCursorIndex.add(
+			tx, withdrawal.AccountName, withdrawal.Cursor(), key,
+		); err != nil {
+			return err
+		}
+		return allWithdrawalsCursorIndex.add(
+			tx, allAccounts, withdrawal.AllAccountsCursor(), key,
+		)
+	})
 	if err != nil {
 		if err == badgerhold.ErrKeyExists {
 			return false, nil
@@ -89,18 +161,46 @@ func (w withdrawalRepositoryImpl) findWithdrawals(
 	ctx context.Context, query *badgerhold.Query,
 ) ([]domain.Withdrawal, error) {
 	var withdrawals []domain.Withdrawal
-	var err error
 
 	query.SortBy("Timestamp").Reverse()
-	if ctx.Value("tx") != nil {
-		tx := ctx.Value("tx").(*badger.Txn)
-		err = w.store.TxFind(tx, &withdrawals, query)
-	} else {
-		err = w.store.Find(&withdrawals, query)
-	}
-	if err != nil {
+	if err := runTx(ctx, w.store.Badger(), false, func(tx *badger.Txn) error {
+		return w.store.TxFind(tx, &withdrawals, query)
+	}); err != nil {
 		return nil, err
 	}
 
 	return withdrawals, nil
 }
+
+// buildCursorIndex indexes the withdrawals stored before the introduction of the
+// cursor index.
+func (w withdrawalRepositoryImpl) buildCursorIndex() error {
+	if err := withdrawalCursorIndex.build(w.store, w.forEachCursor); err != nil {
+		return err
+	}
+	return allWithdrawalsCursorIndex.build(w.store, w.forEachAllAccountsCursor)
+}
+
+// forEachCursor calls fn with the owner, cursor and key of every stored
+// withdrawal.
+func (w withdrawalRepositoryImpl) forEachCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return w.store.ForEach(
+		&badgerhold.Query{}, func(withdrawal *domain.Withdrawal) error {
+			return fn(withdrawal.AccountName, withdrawal.Cursor(), withdrawal.TxID)
+		},
+	)
+}
+
+// forEachAllAccountsCursor calls fn with the cursor among those of all
+// accounts and the key of every stored withdrawal.
+func (w withdrawalRepositoryImpl) forEachAllAccountsCursor(
+	fn func(owner string, cursor domain.Cursor, key string) error,
+) error {
+	return w.store.ForEach(
+		&badgerhold.Query{}, func(withdrawal *domain.Withdrawal) error {
+			return fn(allAccounts, withdrawal.AllAccountsCursor(), withdrawal.TxID)
+		},
+	)
+}
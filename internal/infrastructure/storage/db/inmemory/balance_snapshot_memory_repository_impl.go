@@ -0,0 +1,74 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+type balanceSnapshotInMemoryStore struct {
+	snapshots map[string]domain.BalanceSnapshot
+	locker    *sync.RWMutex
+}
+
+type balanceSnapshotRepositoryImpl struct {
+	store *balanceSnapshotInMemoryStore
+}
+
+// NewBalanceSnapshotRepositoryImpl returns a new empty
+// BalanceSnapshotRepositoryImpl
+func NewBalanceSnapshotRepositoryImpl() domain.BalanceSnapshotRepository {
+	return &balanceSnapshotRepositoryImpl{&balanceSnapshotInMemoryStore{
+		snapshots: map[string]domain.BalanceSnapshot{},
+		locker:    &sync.RWMutex{},
+	}}
+}
+
+func (r *balanceSnapshotRepositoryImpl) AddBalanceSnapshots(
+	_ context.Context, snapshots []domain.BalanceSnapshot,
+) (int, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	count := 0
+	for _, snapshot := range snapshots {
+		if _, ok := r.store.snapshots[snapshot.Key()]; !ok {
+			r.store.snapshots[snapshot.Key()] = snapshot
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *balanceSnapshotRepositoryImpl) GetBalanceSnapshots(
+	_ context.Context, accountName string, startTime, endTime int64,
+	cursor *domain.Cursor, limit int,
+) ([]domain.BalanceSnapshot, error) {
+	r.store.locker.RLock()
+	defer r.store.locker.RUnlock()
+
+	snapshots := make([]domain.BalanceSnapshot, 0)
+	for _, snapshot := range r.store.snapshots {
+		if accountName != "" && snapshot.AccountName != accountName {
+			continue
+		}
+		if snapshot.Timestamp < startTime ||
+			(endTime > 0 && snapshot.Timestamp > endTime) {
+			continue
+		}
+		if !cursor.Precedes(snapshot.Cursor()) {
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		c := snapshots[i].Cursor()
+		return c.Precedes(snapshots[j].Cursor())
+	})
+	if limit > 0 && len(snapshots) > limit {
+		snapshots = snapshots[:limit]
+	}
+	return snapshots, nil
+}
@@ -1,6 +1,8 @@
 package inmemory
 
 import (
+	"context"
+
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 )
@@ -10,6 +12,7 @@ type RepoManager struct {
 	tradeRepository       domain.TradeRepository
 	depositRepository     domain.DepositRepository
 	withdrawalsRepository domain.WithdrawalRepository
+	balanceSnapshotRepo   domain.BalanceSnapshotRepository
 }
 
 func NewRepoManager() ports.RepoManager {
@@ -17,12 +20,14 @@ func NewRepoManager() ports.RepoManager {
 	tradeRepo := NewTradeRepositoryImpl()
 	depositRepo := NewDepositRepositoryImpl()
 	withdrawalRepo := NewWithdrawalRepositoryImpl()
+	balanceSnapshotRepo := NewBalanceSnapshotRepositoryImpl()
 
 	return &RepoManager{
 		marketRepository:      marketRepo,
 		tradeRepository:       tradeRepo,
 		depositRepository:     depositRepo,
 		withdrawalsRepository: withdrawalRepo,
+		balanceSnapshotRepo:   balanceSnapshotRepo,
 	}
 }
 
@@ -42,4 +47,16 @@ func (d *RepoManager) WithdrawalRepository() domain.WithdrawalRepository {
 	return d.withdrawalsRepository
 }
 
+func (d *RepoManager) BalanceSnapshotRepository() domain.BalanceSnapshotRepository {
+	return d.balanceSnapshotRepo
+}
+
+// RunReadTransaction runs fn right away since every repository serves each
+// read under its own lock, without any snapshot shared across them.
+func (d *RepoManager) RunReadTransaction(
+	ctx context.Context, fn func(ctx context.Context) error,
+) error {
+	return fn(ctx)
+}
+
 func (d *RepoManager) Close() {}
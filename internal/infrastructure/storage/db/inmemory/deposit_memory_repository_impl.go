@@ -27,19 +27,19 @@ func NewDepositRepositoryImpl() domain.DepositRepository {
 
 func (d *depositRepositoryImpl) AddDeposits(
 	ctx context.Context, deposits []domain.Deposit,
-) (int, error) {
+) ([]domain.Deposit, error) {
 	d.store.locker.Lock()
 	defer d.store.locker.Unlock()
 
-	count := 0
+	added := make([]domain.Deposit, 0, len(deposits))
 	for _, deposit := range deposits {
 		if _, ok := d.store.deposits[deposit.Key()]; !ok {
 			d.store.deposits[deposit.Key()] = deposit
-			count++
+			added = append(added, deposit)
 		}
 	}
 
-	return count, nil
+	return added, nil
 }
 
 func (d *depositRepositoryImpl) GetDepositsForAccount(
@@ -73,6 +73,70 @@ func (d *depositRepositoryImpl) GetDepositsForAccount(
 	return pagDeposits, nil
 }
 
+func (d *depositRepositoryImpl) GetDepositsForAccountAfter(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]domain.Deposit, error) {
+	d.store.locker.RLock()
+	defer d.store.locker.RUnlock()
+
+	deposits := make([]domain.Deposit, 0)
+	for _, deposit := range d.store.deposits {
+		if deposit.AccountName == accountName && deposit.Matches(filter) &&
+			cursor.Precedes(deposit.Cursor()) {
+			deposits = append(deposits, deposit)
+		}
+	}
+	sort.SliceStable(deposits, func(i, j int) bool {
+		c := deposits[i].Cursor()
+		return c.Precedes(deposits[j].Cursor())
+	})
+
+	if limit > 0 && len(deposits) > limit {
+		deposits = deposits[:limit]
+	}
+	return deposits, nil
+}
+
+func (d *depositRepositoryImpl) GetDepositsTotalAmountPerAsset(
+	_ context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	d.store.locker.RLock()
+	defer d.store.locker.RUnlock()
+
+	totals := make(map[string]uint64)
+	for _, deposit := range d.store.deposits {
+		if deposit.AccountName == accountName && deposit.Matches(filter) {
+			filter.AddAmounts(totals, deposit.TotAmountPerAsset)
+		}
+	}
+	return totals, nil
+}
+
+func (d *depositRepositoryImpl) GetAllDepositsAfter(
+	_ context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Deposit, error) {
+	d.store.locker.RLock()
+	defer d.store.locker.RUnlock()
+
+	deposits := make([]domain.Deposit, 0)
+	for _, deposit := range d.store.deposits {
+		if deposit.Matches(filter) && cursor.Precedes(deposit.AllAccountsCursor()) {
+			deposits = append(deposits, deposit)
+		}
+	}
+	sort.SliceStable(deposits, func(i, j int) bool {
+		c := deposits[i].AllAccountsCursor()
+		return c.Precedes(deposits[j].AllAccountsCursor())
+	})
+
+	if limit > 0 && len(deposits) > limit {
+		deposits = deposits[:limit]
+	}
+	return deposits, nil
+}
+
 func (d *depositRepositoryImpl) GetAllDeposits(
 	ctx context.Context, page domain.Page,
 ) ([]domain.Deposit, error) {
@@ -63,6 +63,58 @@ func (r *tradeRepositoryImpl) GetAllTradesByMarket(
 	return r.getAllTradesByMarket(marketName, page)
 }
 
+func (r *tradeRepositoryImpl) GetTradesByMarketAfter(
+	_ context.Context, marketName string, cursor *domain.Cursor, limit int,
+) ([]domain.Trade, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	trades := make([]domain.Trade, 0)
+	for _, id := range r.store.tradesByMarket[marketName] {
+		trade := r.store.trades[id]
+		if cursor.Precedes(trade.Cursor()) {
+			trades = append(trades, trade)
+		}
+	}
+	sort.SliceStable(trades, func(i, j int) bool {
+		c := trades[i].Cursor()
+		return c.Precedes(trades[j].Cursor())
+	})
+
+	if len(trades) > limit {
+		trades = trades[:limit]
+	}
+	return trades, nil
+}
+
+func (r *tradeRepositoryImpl) GetAllTradesAfter(
+	_ context.Context, startTime, endTime int64, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Trade, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	trades := make([]domain.Trade, 0)
+	for _, trade := range r.store.trades {
+		c := trade.Cursor()
+		if c.Timestamp < startTime || (endTime > 0 && c.Timestamp > endTime) {
+			continue
+		}
+		if cursor.Precedes(c) {
+			trades = append(trades, trade)
+		}
+	}
+	sort.SliceStable(trades, func(i, j int) bool {
+		c := trades[i].Cursor()
+		return c.Precedes(trades[j].Cursor())
+	})
+
+	if limit > 0 && len(trades) > limit {
+		trades = trades[:limit]
+	}
+	return trades, nil
+}
+
 func (r *tradeRepositoryImpl) GetCompletedTradesByMarket(
 	_ context.Context, marketName string, page domain.Page,
 ) ([]domain.Trade, error) {
@@ -103,6 +155,27 @@ func (r *tradeRepositoryImpl) GetTradeByTxId(
 	return nil, nil
 }
 
+func (r *tradeRepositoryImpl) GetTradeBySwapRequestId(
+	_ context.Context, marketName, swapRequestId string,
+) (*domain.Trade, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	var found *domain.Trade
+	for _, t := range r.store.trades {
+		if t.MarketName != marketName ||
+			t.SwapRequest == nil || t.SwapRequest.Id != swapRequestId {
+			continue
+		}
+		if found == nil || t.SwapRequest.Timestamp > found.SwapRequest.Timestamp {
+			trade := t
+			found = &trade
+		}
+	}
+
+	return found, nil
+}
+
 func (r *tradeRepositoryImpl) GetTradeBySwapAcceptId(
 	_ context.Context, swapAcceptId string,
 ) (*domain.Trade, error) {
@@ -112,6 +185,58 @@ func (r *tradeRepositoryImpl) GetTradeBySwapAcceptId(
 	return r.getTradeBySwapAcceptId(swapAcceptId)
 }
 
+func (r *tradeRepositoryImpl) GetArchivableTrades(
+	_ context.Context, before int64, limit int,
+) ([]domain.Trade, error) {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	trades := make([]domain.Trade, 0)
+	for _, trade := range r.store.trades {
+		if trade.IsArchivable(before) {
+			trades = append(trades, trade)
+		}
+	}
+	sort.SliceStable(trades, func(i, j int) bool {
+		c := trades[j].Cursor()
+		return c.Precedes(trades[i].Cursor())
+	})
+
+	if len(trades) > limit {
+		trades = trades[:limit]
+	}
+	return trades, nil
+}
+
+func (r *tradeRepositoryImpl) DeleteTrades(
+	_ context.Context, ids []string,
+) error {
+	r.store.locker.Lock()
+	defer r.store.locker.Unlock()
+
+	for _, id := range ids {
+		trade, ok := r.store.trades[id]
+		if !ok {
+			continue
+		}
+		delete(r.store.trades, id)
+		if trade.SwapAccept != nil {
+			delete(r.store.tradesBySwapAcceptId, trade.SwapAccept.Id)
+		}
+
+		tradeIds := r.store.tradesByMarket[trade.MarketName]
+		for i, tradeId := range tradeIds {
+			if tradeId == id {
+				r.store.tradesByMarket[trade.MarketName] = append(
+					tradeIds[:i:i], tradeIds[i+1:]...,
+				)
+				break
+			}
+		}
+	}
+	return nil
+}
+
 func (r *tradeRepositoryImpl) UpdateTrade(
 	ctx context.Context,
 	tradeId string, updateFn func(t *domain.Trade) (*domain.Trade, error),
@@ -147,6 +272,9 @@ func (r *tradeRepositoryImpl) addTrade(trade *domain.Trade) error {
 		return fmt.Errorf("trade with id %s already exists", trade.Id)
 	}
 	r.store.trades[trade.Id] = *trade
+	if trade.SwapAccept != nil {
+		r.store.tradesBySwapAcceptId[trade.SwapAccept.Id] = trade.Id
+	}
 	r.store.tradesByMarket[trade.MarketName] = append(r.store.tradesByMarket[trade.MarketName], trade.Id)
 	return nil
 }
@@ -27,18 +27,18 @@ func NewWithdrawalRepositoryImpl() domain.WithdrawalRepository {
 
 func (w *withdrawalRepositoryImpl) AddWithdrawals(
 	_ context.Context, withdrawals []domain.Withdrawal,
-) (int, error) {
+) ([]domain.Withdrawal, error) {
 	w.store.locker.Lock()
 	defer w.store.locker.Unlock()
 
-	count := 0
+	added := make([]domain.Withdrawal, 0, len(withdrawals))
 	for _, withdrawal := range withdrawals {
 		if _, ok := w.store.withdrawals[withdrawal.TxID]; !ok {
 			w.store.withdrawals[withdrawal.TxID] = withdrawal
-			count++
+			added = append(added, withdrawal)
 		}
 	}
-	return count, nil
+	return added, nil
 }
 
 func (w *withdrawalRepositoryImpl) GetWithdrawalsForAccount(
@@ -73,6 +73,70 @@ func (w *withdrawalRepositoryImpl) GetWithdrawalsForAccount(
 	return pagWithdrawals, nil
 }
 
+func (w *withdrawalRepositoryImpl) GetWithdrawalsForAccountAfter(
+	_ context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]domain.Withdrawal, error) {
+	w.store.locker.RLock()
+	defer w.store.locker.RUnlock()
+
+	withdrawals := make([]domain.Withdrawal, 0)
+	for _, withdrawal := range w.store.withdrawals {
+		if withdrawal.AccountName == accountName && withdrawal.Matches(filter) &&
+			cursor.Precedes(withdrawal.Cursor()) {
+			withdrawals = append(withdrawals, withdrawal)
+		}
+	}
+	sort.SliceStable(withdrawals, func(i, j int) bool {
+		c := withdrawals[i].Cursor()
+		return c.Precedes(withdrawals[j].Cursor())
+	})
+
+	if limit > 0 && len(withdrawals) > limit {
+		withdrawals = withdrawals[:limit]
+	}
+	return withdrawals, nil
+}
+
+func (w *withdrawalRepositoryImpl) GetWithdrawalsTotalAmountPerAsset(
+	_ context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	w.store.locker.RLock()
+	defer w.store.locker.RUnlock()
+
+	totals := make(map[string]uint64)
+	for _, withdrawal := range w.store.withdrawals {
+		if withdrawal.AccountName == accountName && withdrawal.Matches(filter) {
+			filter.AddAmounts(totals, withdrawal.TotAmountPerAsset)
+		}
+	}
+	return totals, nil
+}
+
+func (w *withdrawalRepositoryImpl) GetAllWithdrawalsAfter(
+	_ context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Withdrawal, error) {
+	w.store.locker.RLock()
+	defer w.store.locker.RUnlock()
+
+	withdrawals := make([]domain.Withdrawal, 0)
+	for _, withdrawal := range w.store.withdrawals {
+		if withdrawal.Matches(filter) && cursor.Precedes(withdrawal.AllAccountsCursor()) {
+			withdrawals = append(withdrawals, withdrawal)
+		}
+	}
+	sort.SliceStable(withdrawals, func(i, j int) bool {
+		c := withdrawals[i].AllAccountsCursor()
+		return c.Precedes(withdrawals[j].AllAccountsCursor())
+	})
+
+	if limit > 0 && len(withdrawals) > limit {
+		withdrawals = withdrawals[:limit]
+	}
+	return withdrawals, nil
+}
+
 func (w *withdrawalRepositoryImpl) GetAllWithdrawals(
 	_ context.Context, page domain.Page,
 ) ([]domain.Withdrawal, error) {
@@ -0,0 +1,131 @@
+package dbsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+type balanceSnapshotRepositoryImpl struct {
+	db *db
+}
+
+// newBalanceSnapshotRepositoryImpl initialize a SQL implementation of the
+// domain.BalanceSnapshotRepository
+func newBalanceSnapshotRepositoryImpl(
+	db *db,
+) domain.BalanceSnapshotRepository {
+	return balanceSnapshotRepositoryImpl{db}
+}
+
+func (r balanceSnapshotRepositoryImpl) AddBalanceSnapshots(
+	ctx context.Context, snapshots []domain.BalanceSnapshot,
+) (int, error) {
+	count := 0
+	err := r.db.withTx(ctx, func(tx *sql.Tx) error {
+		for _, s := range snapshots {
+			res, err := tx.ExecContext(ctx, r.db.rebind(
+				"INSERT INTO balance_snapshots (account_name, timestamp) "+
+					"VALUES (?, ?) ON CONFLICT (account_name, timestamp) DO NOTHING;",
+			), s.AccountName, s.Timestamp)
+			if err != nil {
+				return err
+			}
+			if added, _ := res.RowsAffected(); added <= 0 {
+				continue
+			}
+
+			for asset, balance := range s.BalancePerAsset {
+				if _, err := tx.ExecContext(ctx, r.db.rebind(
+					"INSERT INTO balance_snapshot_amounts (account_name, timestamp, "+
+						"asset, confirmed, unconfirmed, locked) "+
+						"VALUES (?, ?, ?, ?, ?, ?);",
+				),
+					s.AccountName, s.Timestamp, asset, int64(balance.Confirmed),
+					int64(balance.Unconfirmed), int64(balance.Locked),
+				); err != nil {
+					return err
+				}
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
+}
+
+func (r balanceSnapshotRepositoryImpl) GetBalanceSnapshots(
+	ctx context.Context, accountName string, startTime, endTime int64,
+	cursor *domain.Cursor, limit int,
+) ([]domain.BalanceSnapshot, error) {
+	where := "WHERE timestamp >= ?"
+	args := []interface{}{startTime}
+	if endTime > 0 {
+		where += " AND timestamp <= ?"
+		args = append(args, endTime)
+	}
+	if accountName != "" {
+		where += " AND account_name = ?"
+		args = append(args, accountName)
+	}
+	if cursor != nil {
+		where += " AND (timestamp < ? OR (timestamp = ? AND account_name < ?))"
+		args = append(args, cursor.Timestamp, cursor.Timestamp, cursor.Id)
+	}
+	var limitClause string
+	if limit > 0 {
+		limitClause = fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	// The page is selected on the snapshots before joining their amounts,
+	// otherwise the limit would apply to the rows of the amounts.
+	rows, err := r.db.QueryContext(ctx, r.db.rebind(
+		"SELECT s.account_name, s.timestamp, a.asset, a.confirmed, "+
+			"a.unconfirmed, a.locked FROM ("+
+			"SELECT account_name, timestamp FROM balance_snapshots "+
+			where+" ORDER BY timestamp DESC, account_name DESC"+limitClause+
+			") s LEFT JOIN balance_snapshot_amounts a "+
+			"ON a.account_name = s.account_name AND a.timestamp = s.timestamp "+
+			"ORDER BY s.timestamp DESC, s.account_name DESC;",
+	), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshots := make([]domain.BalanceSnapshot, 0)
+	for rows.Next() {
+		var (
+			s                              domain.BalanceSnapshot
+			asset                          sql.NullString
+			confirmed, unconfirmed, locked sql.NullInt64
+		)
+		if err := rows.Scan(
+			&s.AccountName, &s.Timestamp, &asset,
+			&confirmed, &unconfirmed, &locked,
+		); err != nil {
+			return nil, err
+		}
+
+		last := len(snapshots) - 1
+		if last < 0 || snapshots[last].AccountName != s.AccountName ||
+			snapshots[last].Timestamp != s.Timestamp {
+			s.BalancePerAsset = make(map[string]domain.AssetBalance)
+			snapshots = append(snapshots, s)
+			last++
+		}
+		if asset.Valid {
+			snapshots[last].BalancePerAsset[asset.String] = domain.AssetBalance{
+				Confirmed:   uint64(confirmed.Int64),
+				Unconfirmed: uint64(unconfirmed.Int64),
+				Locked:      uint64(locked.Int64),
+			}
+		}
+	}
+	return snapshots, rows.Err()
+}
@@ -0,0 +1,405 @@
+package dbsql
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/jackc/pgx/v4/stdlib"
+	_ "github.com/mattn/go-sqlite3"
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+const (
+	sqliteDialect   = "sqlite"
+	postgresDialect = "postgres"
+
+	// SQLiteFile is the name of the SQLite database file in the db datadir.
+	SQLiteFile = "tdexd.sqlite"
+)
+
+var (
+	//go:embed migrations
+	migrations embed.FS
+
+	drivers = map[string]string{
+		sqliteDialect:   "sqlite3",
+		postgresDialect: "pgx",
+	}
+)
+
+// repoManager holds the connection to the SQL database shared by all
+// repositories.
+type repoManager struct {
+	db *db
+
+	marketRepository     domain.MarketRepository
+	tradeRepository      domain.TradeRepository
+	depositRepository    domain.DepositRepository
+	withdrawalRepository domain.WithdrawalRepository
+	balanceSnapshotRepo  domain.BalanceSnapshotRepository
+}
+
+// NewSQLiteRepoManager opens (or creates if not exists) the SQLite database at
+// the given path and applies any pending schema migration.
+func NewSQLiteRepoManager(dbFile string) (ports.RepoManager, error) {
+	// Transactions are started in IMMEDIATE mode to prevent the
+	// read-then-write ones from failing with SQLITE_BUSY in case of concurrent
+	// writes.
+	dsn := fmt.Sprintf(
+		"file:%s?_busy_timeout=5000&_journal_mode=WAL&_foreign_keys=1"+
+			"&_txlock=immediate", dbFile,
+	)
+	return newRepoManager(sqliteDialect, dsn, false)
+}
+
+// NewReadOnlySQLiteRepoManager opens the existing SQLite database at the
+// given path in read-only mode, for example for a replica of the daemon.
+// Migrations are not applied, the db must be already migrated by the daemon.
+func NewReadOnlySQLiteRepoManager(dbFile string) (ports.RepoManager, error) {
+	if _, err := os.Stat(dbFile); err != nil {
+		return nil, fmt.Errorf("opening sqlite db: %w", err)
+	}
+	// The db is already in WAL mode and no write transaction is ever started.
+	dsn := fmt.Sprintf(
+		"file:%s?mode=ro&_busy_timeout=5000&_foreign_keys=1", dbFile,
+	)
+	return newRepoManager(sqliteDialect, dsn, true)
+}
+
+// NewPostgresRepoManager connects to the PostgreSQL database identified by
+// the given connection string and applies any pending schema migration.
+func NewPostgresRepoManager(dsn string) (ports.RepoManager, error) {
+	return newRepoManager(postgresDialect, dsn, false)
+}
+
+func newRepoManager(
+	dialect, dsn string, readOnly bool,
+) (ports.RepoManager, error) {
+	sqlDb, err := sql.Open(drivers[dialect], dsn)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s db: %w", dialect, err)
+	}
+	if err := sqlDb.Ping(); err != nil {
+		sqlDb.Close()
+		return nil, fmt.Errorf("connecting to %s db: %w", dialect, err)
+	}
+
+	db := &db{sqlDb, dialect}
+	if readOnly {
+		if err := db.checkMigrated(); err != nil {
+			sqlDb.Close()
+			return nil, fmt.Errorf("checking %s db schema: %w", dialect, err)
+		}
+	} else if err := db.migrate(); err != nil {
+		sqlDb.Close()
+		return nil, fmt.Errorf("migrating %s db: %w", dialect, err)
+	}
+
+	return &repoManager{
+		db:                   db,
+		marketRepository:     newMarketRepositoryImpl(db),
+		tradeRepository:      newTradeRepositoryImpl(db),
+		depositRepository:    newDepositRepositoryImpl(db),
+		withdrawalRepository: newWithdrawalRepositoryImpl(db),
+		balanceSnapshotRepo:  newBalanceSnapshotRepositoryImpl(db),
+	}, nil
+}
+
+func (d *repoManager) MarketRepository() domain.MarketRepository {
+	return d.marketRepository
+}
+
+func (d *repoManager) TradeRepository() domain.TradeRepository {
+	return d.tradeRepository
+}
+
+func (d *repoManager) DepositRepository() domain.DepositRepository {
+	return d.depositRepository
+}
+
+func (d *repoManager) WithdrawalRepository() domain.WithdrawalRepository {
+	return d.withdrawalRepository
+}
+
+func (d *repoManager) BalanceSnapshotRepository() domain.BalanceSnapshotRepository {
+	return d.balanceSnapshotRepo
+}
+
+// RunReadTransaction runs fn within a read-only transaction on a dedicated
+// connection, used by all the queries made with the context passed to fn.
+func (d *repoManager) RunReadTransaction(
+	ctx context.Context, fn func(ctx context.Context) error,
+) error {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The sqlite driver starts every transaction in IMMEDIATE mode, which would
+	// take the write lock, hence the one is started explicitly as DEFERRED.
+	begin := "BEGIN DEFERRED;"
+	if d.db.dialect == postgresDialect {
+		begin = "BEGIN ISOLATION LEVEL REPEATABLE READ READ ONLY;"
+	}
+	if _, err := conn.ExecContext(ctx, begin); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.Background(), "ROLLBACK;")
+
+	return fn(context.WithValue(ctx, connKey{d.db}, conn))
+}
+
+func (d *repoManager) Close() {
+	if err := d.db.Close(); err != nil {
+		log.WithError(err).Warn("failed to close sql db")
+	}
+}
+
+// Backup adds a consistent snapshot of the SQLite db to the given backup.
+// PostgreSQL dbs are not included since they are expected to be backed up
+// with the tools of the db server, like pg_dump.
+func (d *repoManager) Backup(w ports.BackupWriter) error {
+	if d.db.dialect != sqliteDialect {
+		log.Warnf("skipping backup of %s db", d.db.dialect)
+		return nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "tdexd-backup-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	snapshot := filepath.Join(tmpDir, SQLiteFile)
+	if _, err := d.db.Exec("VACUUM INTO ?;", snapshot); err != nil {
+		return fmt.Errorf("failed to snapshot sqlite db: %w", err)
+	}
+
+	f, err := os.Open(snapshot)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return w.WriteEntry(SQLiteFile, ports.BackupEntryFile, func(w io.Writer) error {
+		_, err := io.Copy(w, f)
+		return err
+	})
+}
+
+// querier is implemented by *sql.DB, *sql.Conn and *sql.Tx.
+type querier interface {
+	ExecContext(
+		ctx context.Context, query string, args ...interface{},
+	) (sql.Result, error)
+	QueryContext(
+		ctx context.Context, query string, args ...interface{},
+	) (*sql.Rows, error)
+	QueryRowContext(
+		ctx context.Context, query string, args ...interface{},
+	) *sql.Row
+}
+
+// db wraps the sql connection to make queries portable across the supported
+// dialects. Queries are written with the ? placeholder and rebound to the
+// $n one for PostgreSQL.
+type db struct {
+	*sql.DB
+	dialect string
+}
+
+// connKey is the context key of the connection of the read transaction
+// started with RunReadTransaction, if any.
+type connKey struct {
+	db *db
+}
+
+// querier returns the connection of the read transaction carried by the
+// context, if any, or the db.
+func (d *db) querier(ctx context.Context) querier {
+	if conn, ok := ctx.Value(connKey{d}).(*sql.Conn); ok {
+		return conn
+	}
+	return d.DB
+}
+
+func (d *db) rebind(query string) string {
+	if d.dialect != postgresDialect {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// withTx runs the given function within a transaction that is committed only
+// if the function doesn't return any error.
+func (d *db) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// migrate applies, in order and each one within a dedicated transaction, the
+// embedded migrations for the db dialect not yet listed in the
+// schema_migrations table.
+func (d *db) migrate() error {
+	if _, err := d.Exec(
+		"CREATE TABLE IF NOT EXISTS schema_migrations " +
+			"(version INTEGER PRIMARY KEY, applied_at BIGINT NOT NULL);",
+	); err != nil {
+		return err
+	}
+
+	currentVersion, err := d.schemaVersion()
+	if err != nil {
+		return err
+	}
+	files, err := d.migrationFiles()
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		name, version := f.name, f.version
+		if version <= currentVersion {
+			continue
+		}
+
+		stmts, err := migrations.ReadFile(path.Join(d.migrationsDir(), name))
+		if err != nil {
+			return err
+		}
+
+		if err := d.withTx(context.Background(), func(tx *sql.Tx) error {
+			if _, err := tx.Exec(string(stmts)); err != nil {
+				return err
+			}
+			_, err := tx.Exec(d.rebind(
+				"INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?);",
+			), version, time.Now().Unix())
+			return err
+		}); err != nil {
+			return fmt.Errorf("applying migration %s: %w", name, err)
+		}
+
+		log.Debugf("applied sql db migration %s", name)
+	}
+
+	return nil
+}
+
+// checkMigrated returns an error if any of the embedded migrations for the db
+// dialect is not yet applied, without applying it.
+func (d *db) checkMigrated() error {
+	currentVersion, err := d.schemaVersion()
+	if err != nil {
+		return err
+	}
+	files, err := d.migrationFiles()
+	if err != nil {
+		return err
+	}
+	if last := len(files) - 1; last >= 0 && files[last].version > currentVersion {
+		return fmt.Errorf(
+			"db schema version %d is older than %d, start the daemon to migrate it",
+			currentVersion, files[last].version,
+		)
+	}
+	return nil
+}
+
+// schemaVersion returns the version of the last migration applied to the db.
+func (d *db) schemaVersion() (int, error) {
+	var version int
+	if err := d.QueryRow(
+		"SELECT COALESCE(MAX(version), 0) FROM schema_migrations;",
+	).Scan(&version); err != nil {
+		return -1, err
+	}
+	return version, nil
+}
+
+type migrationFile struct {
+	name    string
+	version int
+}
+
+// migrationFiles returns the embedded migrations for the db dialect, sorted
+// by version.
+func (d *db) migrationFiles() ([]migrationFile, error) {
+	entries, err := migrations.ReadDir(d.migrationsDir())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	files := make([]migrationFile, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		version, err := strconv.Atoi(strings.SplitN(name, "_", 2)[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %s", name)
+		}
+		files = append(files, migrationFile{name, version})
+	}
+	return files, nil
+}
+
+func (d *db) migrationsDir() string {
+	return path.Join("migrations", d.dialect)
+}
+
+// firstPage is the page made of the first n records of a list.
+type firstPage int64
+
+func (p firstPage) GetNumber() int64 { return 1 }
+func (p firstPage) GetSize() int64   { return int64(p) }
+
+// pageClause returns the LIMIT/OFFSET clause for the given page, if any.
+func pageClause(page domain.Page) string {
+	if page == nil {
+		return ""
+	}
+	offset := page.GetNumber()*page.GetSize() - page.GetSize()
+	return fmt.Sprintf(" LIMIT %d OFFSET %d", page.GetSize(), offset)
+}
+
+// nilIfEmpty makes sure that empty byte slices read from db are returned as
+// nil like for the other storage implementations.
+func nilIfEmpty(buf []byte) []byte {
+	if len(buf) <= 0 {
+		return nil
+	}
+	return buf
+}
@@ -0,0 +1,96 @@
+package dbsql
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+type depositRepositoryImpl struct {
+	store txRecordStore
+}
+
+// newDepositRepositoryImpl initialize a SQL implementation of the
+// domain.DepositRepository
+func newDepositRepositoryImpl(db *db) domain.DepositRepository {
+	return depositRepositoryImpl{txRecordStore{db, "deposits", "deposit_amounts"}}
+}
+
+func (d depositRepositoryImpl) AddDeposits(
+	ctx context.Context, deposits []domain.Deposit,
+) ([]domain.Deposit, error) {
+	records := make([]txRecord, 0, len(deposits))
+	for _, dd := range deposits {
+		records = append(records, txRecord{
+			dd.AccountName, dd.TxID, dd.TotAmountPerAsset, dd.Timestamp,
+		})
+	}
+	added, err := d.store.insert(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+	return depositsFromRecords(added), nil
+}
+
+func (d depositRepositoryImpl) GetDepositsForAccount(
+	ctx context.Context, accountName string, page domain.Page,
+) ([]domain.Deposit, error) {
+	return d.findDeposits(ctx, "WHERE account_name = ?", page, accountName)
+}
+
+func (d depositRepositoryImpl) GetDepositsForAccountAfter(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]domain.Deposit, error) {
+	records, err := d.store.findAfter(ctx, accountName, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return depositsFromRecords(records), nil
+}
+
+func (d depositRepositoryImpl) GetDepositsTotalAmountPerAsset(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	return d.store.totalAmountPerAsset(ctx, accountName, filter)
+}
+
+func (d depositRepositoryImpl) GetAllDepositsAfter(
+	ctx context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Deposit, error) {
+	records, err := d.store.findAllAfter(ctx, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return depositsFromRecords(records), nil
+}
+
+func (d depositRepositoryImpl) GetAllDeposits(
+	ctx context.Context, page domain.Page,
+) ([]domain.Deposit, error) {
+	return d.findDeposits(ctx, "", page)
+}
+
+func (d depositRepositoryImpl) findDeposits(
+	ctx context.Context, where string, page domain.Page, args ...interface{},
+) ([]domain.Deposit, error) {
+	records, err := d.store.find(ctx, where, page, args...)
+	if err != nil {
+		return nil, err
+	}
+	return depositsFromRecords(records), nil
+}
+
+func depositsFromRecords(records []txRecord) []domain.Deposit {
+	deposits := make([]domain.Deposit, 0, len(records))
+	for _, r := range records {
+		deposits = append(deposits, domain.Deposit{
+			AccountName:       r.accountName,
+			TxID:              r.txid,
+			TotAmountPerAsset: r.totAmountPerAsset,
+			Timestamp:         r.timestamp,
+		})
+	}
+	return deposits
+}
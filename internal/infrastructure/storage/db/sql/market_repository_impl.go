@@ -0,0 +1,284 @@
+package dbsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+const marketColumns = "name, base_asset, quote_asset, base_asset_precision, " +
+	"quote_asset_precision, base_percentage_fee, quote_percentage_fee, " +
+	"base_fixed_fee, quote_fixed_fee, tradable, strategy_type, base_price, " +
+	"quote_price, trade_expiry_time"
+
+type marketRepositoryImpl struct {
+	db *db
+}
+
+// newMarketRepositoryImpl initialize a SQL implementation of the
+// domain.MarketRepository
+func newMarketRepositoryImpl(db *db) domain.MarketRepository {
+	return marketRepositoryImpl{db}
+}
+
+func (m marketRepositoryImpl) AddMarket(
+	ctx context.Context, market *domain.Market,
+) error {
+	if _, err := m.getMarket(ctx, m.db, market.Name); err == nil {
+		return fmt.Errorf(
+			"market with assets %s %s already exists",
+			market.BaseAsset, market.QuoteAsset,
+		)
+	}
+
+	query := fmt.Sprintf(
+		"INSERT INTO markets (%s) VALUES "+
+			"(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);", marketColumns,
+	)
+	if _, err := m.db.ExecContext(
+		ctx, m.db.rebind(query),
+		market.Name, market.BaseAsset, market.QuoteAsset,
+		int64(market.BaseAssetPrecision), int64(market.QuoteAssetPrecision),
+		int64(market.PercentageFee.BaseAsset),
+		int64(market.PercentageFee.QuoteAsset),
+		int64(market.FixedFee.BaseAsset), int64(market.FixedFee.QuoteAsset),
+		market.Tradable, market.StrategyType,
+		market.Price.BasePrice, market.Price.QuotePrice,
+		int64(market.TradeExpiryTime),
+	); err != nil {
+		return fmt.Errorf("failed to add market %s: %s", market.Name, err)
+	}
+	return nil
+}
+
+func (m marketRepositoryImpl) GetMarketByName(
+	ctx context.Context, marketName string,
+) (*domain.Market, error) {
+	return m.getMarket(ctx, m.db, marketName)
+}
+
+func (m marketRepositoryImpl) GetMarketByAssets(
+	ctx context.Context, baseAsset, quoteAsset string,
+) (*domain.Market, error) {
+	markets, err := m.findMarkets(
+		ctx, "WHERE base_asset = ? AND quote_asset = ?", baseAsset, quoteAsset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(markets) == 0 {
+		return nil, fmt.Errorf(
+			"market with assets %s %s not found", baseAsset, quoteAsset,
+		)
+	}
+
+	return &markets[0], nil
+}
+
+func (m marketRepositoryImpl) GetTradableMarkets(
+	ctx context.Context,
+) ([]domain.Market, error) {
+	return m.findMarkets(ctx, "WHERE tradable = ?", true)
+}
+
+func (m marketRepositoryImpl) GetAllMarkets(
+	ctx context.Context,
+) ([]domain.Market, error) {
+	return m.findMarkets(ctx, "")
+}
+
+func (m marketRepositoryImpl) UpdateMarket(
+	ctx context.Context,
+	marketName string, updateFn func(m *domain.Market) (*domain.Market, error),
+) error {
+	return m.db.withTx(ctx, func(tx *sql.Tx) error {
+		currentMarket, err := m.getMarket(ctx, tx, marketName)
+		if err != nil {
+			return err
+		}
+
+		updatedMarket, err := updateFn(currentMarket)
+		if err != nil {
+			return err
+		}
+
+		return m.updateMarket(ctx, tx, *updatedMarket)
+	})
+}
+
+func (m marketRepositoryImpl) OpenMarket(
+	ctx context.Context, marketName string,
+) error {
+	return m.db.withTx(ctx, func(tx *sql.Tx) error {
+		market, err := m.getMarket(ctx, tx, marketName)
+		if err != nil {
+			return err
+		}
+
+		if market.IsTradable() {
+			return nil
+		}
+
+		if err := market.MakeTradable(); err != nil {
+			return err
+		}
+
+		return m.updateMarket(ctx, tx, *market)
+	})
+}
+
+func (m marketRepositoryImpl) CloseMarket(
+	ctx context.Context, marketName string,
+) error {
+	return m.db.withTx(ctx, func(tx *sql.Tx) error {
+		market, err := m.getMarket(ctx, tx, marketName)
+		if err != nil {
+			return err
+		}
+
+		if !market.IsTradable() {
+			return nil
+		}
+
+		market.MakeNotTradable()
+
+		return m.updateMarket(ctx, tx, *market)
+	})
+}
+
+func (m marketRepositoryImpl) DeleteMarket(
+	ctx context.Context, marketName string,
+) error {
+	res, err := m.db.ExecContext(
+		ctx, m.db.rebind("DELETE FROM markets WHERE name = ?;"), marketName,
+	)
+	if err != nil {
+		return err
+	}
+	if count, _ := res.RowsAffected(); count <= 0 {
+		return fmt.Errorf("market with name %s not found", marketName)
+	}
+	return nil
+}
+
+func (m marketRepositoryImpl) UpdateMarketPrice(
+	ctx context.Context, marketName string, price domain.MarketPrice,
+) error {
+	res, err := m.db.ExecContext(
+		ctx, m.db.rebind(
+			"UPDATE markets SET base_price = ?, quote_price = ? WHERE name = ?;",
+		),
+		price.BasePrice, price.QuotePrice, marketName,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to update price for market %s: %s", marketName, err,
+		)
+	}
+	if count, _ := res.RowsAffected(); count <= 0 {
+		return fmt.Errorf("market with name %s not found", marketName)
+	}
+	return nil
+}
+
+func (m marketRepositoryImpl) getMarket(
+	ctx context.Context, q querier, marketName string,
+) (*domain.Market, error) {
+	query := fmt.Sprintf("SELECT %s FROM markets WHERE name = ?;", marketColumns)
+	market, err := scanMarket(
+		q.QueryRowContext(ctx, m.db.rebind(query), marketName),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("market with name %s not found", marketName)
+		}
+		return nil, err
+	}
+	return market, nil
+}
+
+// updateMarket updates all the market fields, except for the price that can
+// be changed only with UpdateMarketPrice.
+func (m marketRepositoryImpl) updateMarket(
+	ctx context.Context, q querier, market domain.Market,
+) error {
+	if _, err := q.ExecContext(
+		ctx, m.db.rebind(
+			"UPDATE markets SET base_asset = ?, quote_asset = ?, "+
+				"base_asset_precision = ?, quote_asset_precision = ?, "+
+				"base_percentage_fee = ?, quote_percentage_fee = ?, "+
+				"base_fixed_fee = ?, quote_fixed_fee = ?, tradable = ?, "+
+				"strategy_type = ?, trade_expiry_time = ? WHERE name = ?;",
+		),
+		market.BaseAsset, market.QuoteAsset,
+		int64(market.BaseAssetPrecision), int64(market.QuoteAssetPrecision),
+		int64(market.PercentageFee.BaseAsset),
+		int64(market.PercentageFee.QuoteAsset),
+		int64(market.FixedFee.BaseAsset), int64(market.FixedFee.QuoteAsset),
+		market.Tradable, market.StrategyType, int64(market.TradeExpiryTime),
+		market.Name,
+	); err != nil {
+		return fmt.Errorf("failed to update market %s: %s", market.Name, err)
+	}
+	return nil
+}
+
+func (m marketRepositoryImpl) findMarkets(
+	ctx context.Context, where string, args ...interface{},
+) ([]domain.Market, error) {
+	query := fmt.Sprintf(
+		"SELECT %s FROM markets %s ORDER BY name;", marketColumns, where,
+	)
+	rows, err := m.db.QueryContext(ctx, m.db.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	markets := make([]domain.Market, 0)
+	for rows.Next() {
+		market, err := scanMarket(rows)
+		if err != nil {
+			return nil, err
+		}
+		markets = append(markets, *market)
+	}
+	return markets, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMarket(row scanner) (*domain.Market, error) {
+	var (
+		market                                  domain.Market
+		basePrecision, quotePrecision           int64
+		basePercentageFee, quotePercentageFee   int64
+		baseFixedFee, quoteFixedFee, expiryTime int64
+	)
+	if err := row.Scan(
+		&market.Name, &market.BaseAsset, &market.QuoteAsset,
+		&basePrecision, &quotePrecision, &basePercentageFee, &quotePercentageFee,
+		&baseFixedFee, &quoteFixedFee, &market.Tradable, &market.StrategyType,
+		&market.Price.BasePrice, &market.Price.QuotePrice, &expiryTime,
+	); err != nil {
+		return nil, err
+	}
+
+	market.BaseAssetPrecision = uint(basePrecision)
+	market.QuoteAssetPrecision = uint(quotePrecision)
+	market.PercentageFee = domain.MarketFee{
+		BaseAsset:  uint64(basePercentageFee),
+		QuoteAsset: uint64(quotePercentageFee),
+	}
+	market.FixedFee = domain.MarketFee{
+		BaseAsset:  uint64(baseFixedFee),
+		QuoteAsset: uint64(quoteFixedFee),
+	}
+	market.TradeExpiryTime = uint64(expiryTime)
+	return &market, nil
+}
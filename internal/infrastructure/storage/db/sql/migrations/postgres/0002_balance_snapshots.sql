@@ -0,0 +1,18 @@
+CREATE TABLE balance_snapshots (
+  account_name TEXT NOT NULL,
+  timestamp BIGINT NOT NULL,
+  PRIMARY KEY (account_name, timestamp)
+);
+CREATE INDEX balance_snapshots_timestamp_idx ON balance_snapshots (timestamp);
+
+CREATE TABLE balance_snapshot_amounts (
+  account_name TEXT NOT NULL,
+  timestamp BIGINT NOT NULL,
+  asset TEXT NOT NULL,
+  confirmed BIGINT NOT NULL,
+  unconfirmed BIGINT NOT NULL,
+  locked BIGINT NOT NULL,
+  PRIMARY KEY (account_name, timestamp, asset),
+  FOREIGN KEY (account_name, timestamp)
+    REFERENCES balance_snapshots (account_name, timestamp) ON DELETE CASCADE
+);
@@ -0,0 +1,98 @@
+CREATE TABLE markets (
+  name TEXT PRIMARY KEY,
+  base_asset TEXT NOT NULL,
+  quote_asset TEXT NOT NULL,
+  base_asset_precision INTEGER NOT NULL,
+  quote_asset_precision INTEGER NOT NULL,
+  base_percentage_fee BIGINT NOT NULL,
+  quote_percentage_fee BIGINT NOT NULL,
+  base_fixed_fee BIGINT NOT NULL,
+  quote_fixed_fee BIGINT NOT NULL,
+  tradable BOOLEAN NOT NULL,
+  strategy_type INTEGER NOT NULL,
+  base_price TEXT NOT NULL,
+  quote_price TEXT NOT NULL,
+  trade_expiry_time BIGINT NOT NULL,
+  UNIQUE (base_asset, quote_asset)
+);
+CREATE INDEX markets_tradable_idx ON markets (tradable);
+
+CREATE TABLE trades (
+  id TEXT PRIMARY KEY,
+  type INTEGER NOT NULL,
+  market_name TEXT NOT NULL,
+  market_base_asset TEXT NOT NULL,
+  market_quote_asset TEXT NOT NULL,
+  market_base_price TEXT NOT NULL,
+  market_quote_price TEXT NOT NULL,
+  market_base_percentage_fee BIGINT NOT NULL,
+  market_quote_percentage_fee BIGINT NOT NULL,
+  market_base_fixed_fee BIGINT NOT NULL,
+  market_quote_fixed_fee BIGINT NOT NULL,
+  fee_asset TEXT NOT NULL,
+  fee_amount BIGINT NOT NULL,
+  trader_pubkey BLOB,
+  status_code INTEGER NOT NULL,
+  status_failed BOOLEAN NOT NULL,
+  pset_base64 TEXT NOT NULL,
+  tx_id TEXT NOT NULL,
+  tx_hex TEXT NOT NULL,
+  expiry_time BIGINT NOT NULL,
+  settlement_time BIGINT NOT NULL,
+  swap_request_id TEXT,
+  swap_request_message BLOB,
+  swap_request_timestamp BIGINT,
+  swap_accept_id TEXT,
+  swap_accept_message BLOB,
+  swap_accept_timestamp BIGINT,
+  swap_complete_id TEXT,
+  swap_complete_message BLOB,
+  swap_complete_timestamp BIGINT,
+  swap_fail_id TEXT,
+  swap_fail_message BLOB,
+  swap_fail_timestamp BIGINT
+);
+CREATE INDEX trades_market_name_idx ON trades (market_name, swap_request_timestamp);
+CREATE INDEX trades_status_idx ON trades (status_code, status_failed);
+CREATE INDEX trades_tx_id_idx ON trades (tx_id);
+CREATE INDEX trades_swap_request_id_idx ON trades (swap_request_id);
+CREATE INDEX trades_swap_accept_id_idx ON trades (swap_accept_id);
+CREATE INDEX trades_swap_request_timestamp_idx ON trades (swap_request_timestamp);
+
+CREATE TABLE deposits (
+  account_name TEXT NOT NULL,
+  tx_id TEXT NOT NULL,
+  timestamp BIGINT NOT NULL,
+  PRIMARY KEY (account_name, tx_id)
+);
+CREATE INDEX deposits_timestamp_idx ON deposits (timestamp);
+
+CREATE TABLE deposit_amounts (
+  account_name TEXT NOT NULL,
+  tx_id TEXT NOT NULL,
+  asset TEXT NOT NULL,
+  amount BIGINT NOT NULL,
+  PRIMARY KEY (account_name, tx_id, asset),
+  FOREIGN KEY (account_name, tx_id) REFERENCES deposits (account_name, tx_id)
+    ON DELETE CASCADE
+);
+CREATE INDEX deposit_amounts_asset_idx ON deposit_amounts (asset);
+
+CREATE TABLE withdrawals (
+  account_name TEXT NOT NULL,
+  tx_id TEXT NOT NULL,
+  timestamp BIGINT NOT NULL,
+  PRIMARY KEY (account_name, tx_id)
+);
+CREATE INDEX withdrawals_timestamp_idx ON withdrawals (timestamp);
+
+CREATE TABLE withdrawal_amounts (
+  account_name TEXT NOT NULL,
+  tx_id TEXT NOT NULL,
+  asset TEXT NOT NULL,
+  amount BIGINT NOT NULL,
+  PRIMARY KEY (account_name, tx_id, asset),
+  FOREIGN KEY (account_name, tx_id) REFERENCES withdrawals (account_name, tx_id)
+    ON DELETE CASCADE
+);
+CREATE INDEX withdrawal_amounts_asset_idx ON withdrawal_amounts (asset);
@@ -0,0 +1,357 @@
+package dbsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+const (
+	// tradeDataColumns are all trade columns except for the id.
+	tradeDataColumns = "type, market_name, market_base_asset, " +
+		"market_quote_asset, market_base_price, market_quote_price, " +
+		"market_base_percentage_fee, market_quote_percentage_fee, " +
+		"market_base_fixed_fee, market_quote_fixed_fee, fee_asset, fee_amount, " +
+		"trader_pubkey, status_code, status_failed, pset_base64, tx_id, tx_hex, " +
+		"expiry_time, settlement_time, " +
+		"swap_request_id, swap_request_message, swap_request_timestamp, " +
+		"swap_accept_id, swap_accept_message, swap_accept_timestamp, " +
+		"swap_complete_id, swap_complete_message, swap_complete_timestamp, " +
+		"swap_fail_id, swap_fail_message, swap_fail_timestamp"
+	tradeColumns = "id, " + tradeDataColumns
+	tradeOrderBy = "ORDER BY COALESCE(swap_request_timestamp, 0) DESC, id DESC"
+)
+
+type tradeRepositoryImpl struct {
+	db *db
+}
+
+// newTradeRepositoryImpl initialize a SQL implementation of the
+// domain.TradeRepository
+func newTradeRepositoryImpl(db *db) domain.TradeRepository {
+	return tradeRepositoryImpl{db}
+}
+
+func (t tradeRepositoryImpl) AddTrade(
+	ctx context.Context, trade *domain.Trade,
+) error {
+	if _, err := t.getTrade(ctx, t.db, trade.Id); err == nil {
+		return fmt.Errorf("trade with id %s already exists", trade.Id)
+	}
+
+	args := append([]interface{}{trade.Id}, tradeArgs(*trade)...)
+	query := fmt.Sprintf(
+		"INSERT INTO trades (%s) VALUES (%s);", tradeColumns, placeholders(len(args)),
+	)
+	_, err := t.db.ExecContext(ctx, t.db.rebind(query), args...)
+	return err
+}
+
+func (t tradeRepositoryImpl) GetTradeById(
+	ctx context.Context, tradeId string,
+) (*domain.Trade, error) {
+	return t.getTrade(ctx, t.db, tradeId)
+}
+
+func (t tradeRepositoryImpl) GetAllTrades(
+	ctx context.Context, page domain.Page,
+) ([]domain.Trade, error) {
+	return t.findTrades(ctx, "", page)
+}
+
+func (t tradeRepositoryImpl) GetAllTradesByMarket(
+	ctx context.Context, marketName string, page domain.Page,
+) ([]domain.Trade, error) {
+	return t.findTrades(ctx, "WHERE market_name = ?", page, marketName)
+}
+
+func (t tradeRepositoryImpl) GetTradesByMarketAfter(
+	ctx context.Context, marketName string, cursor *domain.Cursor, limit int,
+) ([]domain.Trade, error) {
+	if cursor == nil {
+		return t.findTrades(
+			ctx, "WHERE market_name = ?", firstPage(limit), marketName,
+		)
+	}
+	return t.findTrades(
+		ctx,
+		"WHERE market_name = ? AND (COALESCE(swap_request_timestamp, 0) < ? OR "+
+			"(COALESCE(swap_request_timestamp, 0) = ? AND id < ?))",
+		firstPage(limit), marketName,
+		cursor.Timestamp, cursor.Timestamp, cursor.Id,
+	)
+}
+
+func (t tradeRepositoryImpl) GetAllTradesAfter(
+	ctx context.Context, startTime, endTime int64, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Trade, error) {
+	where := "WHERE COALESCE(swap_request_timestamp, 0) >= ?"
+	args := []interface{}{startTime}
+	if endTime > 0 {
+		where += " AND COALESCE(swap_request_timestamp, 0) <= ?"
+		args = append(args, endTime)
+	}
+	if cursor != nil {
+		where += " AND (COALESCE(swap_request_timestamp, 0) < ? OR " +
+			"(COALESCE(swap_request_timestamp, 0) = ? AND id < ?))"
+		args = append(args, cursor.Timestamp, cursor.Timestamp, cursor.Id)
+	}
+	var page domain.Page
+	if limit > 0 {
+		page = firstPage(limit)
+	}
+	return t.findTrades(ctx, where, page, args...)
+}
+
+func (t tradeRepositoryImpl) GetArchivableTrades(
+	ctx context.Context, before int64, limit int,
+) ([]domain.Trade, error) {
+	return t.findTrades(
+		ctx,
+		"WHERE COALESCE(swap_request_timestamp, 0) < ? AND "+
+			"(status_failed = ? OR status_code = ? OR "+
+			"(status_code < ? AND expiry_time > 0 AND expiry_time < ?))",
+		firstPage(limit), before, true, domain.TradeStatusCodeExpired,
+		domain.TradeStatusCodeCompleted, before,
+	)
+}
+
+func (t tradeRepositoryImpl) DeleteTrades(
+	ctx context.Context, ids []string,
+) error {
+	return t.db.withTx(ctx, func(tx *sql.Tx) error {
+		for _, id := range ids {
+			if _, err := tx.ExecContext(
+				ctx, t.db.rebind("DELETE FROM trades WHERE id = ?;"), id,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (t tradeRepositoryImpl) GetCompletedTradesByMarket(
+	ctx context.Context, marketName string, page domain.Page,
+) ([]domain.Trade, error) {
+	return t.findTrades(
+		ctx,
+		"WHERE market_name = ? AND status_code >= ? AND status_failed = ?",
+		page, marketName, domain.TradeStatusCodeCompleted, false,
+	)
+}
+
+func (t tradeRepositoryImpl) GetTradeBySwapRequestId(
+	ctx context.Context, marketName, swapRequestId string,
+) (*domain.Trade, error) {
+	trades, err := t.findTrades(
+		ctx, "WHERE market_name = ? AND swap_request_id = ?", nil,
+		marketName, swapRequestId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(trades) <= 0 {
+		return nil, nil
+	}
+
+	return &trades[0], nil
+}
+
+func (t tradeRepositoryImpl) GetTradeBySwapAcceptId(
+	ctx context.Context, swapAcceptId string,
+) (*domain.Trade, error) {
+	trades, err := t.findTrades(
+		ctx, "WHERE swap_accept_id = ?", nil, swapAcceptId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(trades) <= 0 {
+		return nil, fmt.Errorf(
+			"trade with swap accept id %s not found", swapAcceptId,
+		)
+	}
+
+	return &trades[0], nil
+}
+
+func (t tradeRepositoryImpl) GetTradeByTxId(
+	ctx context.Context, txId string,
+) (*domain.Trade, error) {
+	trades, err := t.findTrades(ctx, "WHERE tx_id = ?", nil, txId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(trades) <= 0 {
+		return nil, nil
+	}
+
+	return &trades[0], nil
+}
+
+func (t tradeRepositoryImpl) UpdateTrade(
+	ctx context.Context,
+	id string, updateFn func(t *domain.Trade) (*domain.Trade, error),
+) error {
+	return t.db.withTx(ctx, func(tx *sql.Tx) error {
+		currentTrade, err := t.getTrade(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		updatedTrade, err := updateFn(currentTrade)
+		if err != nil {
+			return err
+		}
+
+		args := tradeArgs(*updatedTrade)
+		query := fmt.Sprintf(
+			"UPDATE trades SET (%s) = (%s) WHERE id = ?;",
+			tradeDataColumns, placeholders(len(args)),
+		)
+		args = append(args, id)
+		_, err = tx.ExecContext(ctx, t.db.rebind(query), args...)
+		return err
+	})
+}
+
+func (t tradeRepositoryImpl) getTrade(
+	ctx context.Context, q querier, id string,
+) (*domain.Trade, error) {
+	query := fmt.Sprintf("SELECT %s FROM trades WHERE id = ?;", tradeColumns)
+	trade, err := scanTrade(q.QueryRowContext(ctx, t.db.rebind(query), id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("trade with id %s not found", id)
+		}
+		return nil, err
+	}
+	return trade, nil
+}
+
+func (t tradeRepositoryImpl) findTrades(
+	ctx context.Context, where string, page domain.Page, args ...interface{},
+) ([]domain.Trade, error) {
+	query := fmt.Sprintf(
+		"SELECT %s FROM trades %s %s%s;",
+		tradeColumns, where, tradeOrderBy, pageClause(page),
+	)
+	rows, err := t.db.querier(ctx).QueryContext(
+		ctx, t.db.rebind(query), args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	trades := make([]domain.Trade, 0)
+	for rows.Next() {
+		trade, err := scanTrade(rows)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, *trade)
+	}
+	return trades, rows.Err()
+}
+
+// tradeArgs returns the values of the trade data columns.
+func tradeArgs(trade domain.Trade) []interface{} {
+	args := []interface{}{
+		int(trade.Type), trade.MarketName, trade.MarketBaseAsset,
+		trade.MarketQuoteAsset, trade.MarketPrice.BasePrice,
+		trade.MarketPrice.QuotePrice,
+		int64(trade.MarketPercentageFee.BaseAsset),
+		int64(trade.MarketPercentageFee.QuoteAsset),
+		int64(trade.MarketFixedFee.BaseAsset),
+		int64(trade.MarketFixedFee.QuoteAsset),
+		trade.FeeAsset, int64(trade.FeeAmount), nilIfEmpty(trade.TraderPubkey),
+		trade.Status.Code, trade.Status.Failed, trade.PsetBase64, trade.TxId,
+		trade.TxHex, trade.ExpiryTime, trade.SettlementTime,
+	}
+	for _, swap := range []*domain.Swap{
+		trade.SwapRequest, trade.SwapAccept, trade.SwapComplete, trade.SwapFail,
+	} {
+		if swap == nil {
+			args = append(args, nil, nil, nil)
+			continue
+		}
+		args = append(args, swap.Id, nilIfEmpty(swap.Message), swap.Timestamp)
+	}
+	return args
+}
+
+func scanTrade(row scanner) (*domain.Trade, error) {
+	var (
+		trade                                  domain.Trade
+		tradeType                              int
+		basePercentageFee, quotePercentageFee  int64
+		baseFixedFee, quoteFixedFee, feeAmount int64
+		traderPubkey                           []byte
+		swapIds                                [4]sql.NullString
+		swapMessages                           [4][]byte
+		swapTimestamps                         [4]sql.NullInt64
+	)
+	dest := []interface{}{
+		&trade.Id, &tradeType, &trade.MarketName, &trade.MarketBaseAsset,
+		&trade.MarketQuoteAsset, &trade.MarketPrice.BasePrice,
+		&trade.MarketPrice.QuotePrice, &basePercentageFee, &quotePercentageFee,
+		&baseFixedFee, &quoteFixedFee, &trade.FeeAsset, &feeAmount,
+		&traderPubkey, &trade.Status.Code, &trade.Status.Failed,
+		&trade.PsetBase64, &trade.TxId, &trade.TxHex, &trade.ExpiryTime,
+		&trade.SettlementTime,
+	}
+	for i := range swapIds {
+		dest = append(dest, &swapIds[i], &swapMessages[i], &swapTimestamps[i])
+	}
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	trade.Type = domain.TradeType(tradeType)
+	trade.MarketPercentageFee = domain.MarketFee{
+		BaseAsset:  uint64(basePercentageFee),
+		QuoteAsset: uint64(quotePercentageFee),
+	}
+	trade.MarketFixedFee = domain.MarketFee{
+		BaseAsset:  uint64(baseFixedFee),
+		QuoteAsset: uint64(quoteFixedFee),
+	}
+	trade.FeeAmount = uint64(feeAmount)
+	trade.TraderPubkey = nilIfEmpty(traderPubkey)
+
+	swaps := make([]*domain.Swap, len(swapIds))
+	for i := range swapIds {
+		if !swapIds[i].Valid {
+			continue
+		}
+		swaps[i] = &domain.Swap{
+			Id:        swapIds[i].String,
+			Message:   nilIfEmpty(swapMessages[i]),
+			Timestamp: swapTimestamps[i].Int64,
+		}
+	}
+	trade.SwapRequest, trade.SwapAccept = swaps[0], swaps[1]
+	trade.SwapComplete, trade.SwapFail = swaps[2], swaps[3]
+
+	return &trade, nil
+}
+
+func placeholders(num int) string {
+	if num <= 0 {
+		return ""
+	}
+	buf := make([]byte, 0, 3*num)
+	for i := 0; i < num; i++ {
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, '?')
+	}
+	return string(buf)
+}
@@ -0,0 +1,236 @@
+package dbsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+// txRecord is the common representation of deposits and withdrawals, stored
+// in dedicated tables with the same layout. The amounts of every record are
+// stored, one row per asset, in a related amounts table.
+type txRecord struct {
+	accountName       string
+	txid              string
+	totAmountPerAsset map[string]uint64
+	timestamp         int64
+}
+
+// allAccounts is the account name used to look for the records of all
+// accounts.
+const allAccounts = ""
+
+type txRecordStore struct {
+	db           *db
+	table        string
+	amountsTable string
+}
+
+// insert adds the given records, skipping those already existing, and
+// returns the number of those actually added.
+func (s txRecordStore) insert(
+	ctx context.Context, records []txRecord,
+) ([]txRecord, error) {
+	added := make([]txRecord, 0, len(records))
+	err := s.db.withTx(ctx, func(tx *sql.Tx) error {
+		for _, r := range records {
+			res, err := tx.ExecContext(ctx, s.db.rebind(fmt.Sprintf(
+				"INSERT INTO %s (account_name, tx_id, timestamp) VALUES (?, ?, ?) "+
+					"ON CONFLICT (account_name, tx_id) DO NOTHING;", s.table,
+			)), r.accountName, r.txid, r.timestamp)
+			if err != nil {
+				return err
+			}
+			if added, _ := res.RowsAffected(); added <= 0 {
+				continue
+			}
+
+			for asset, amount := range r.totAmountPerAsset {
+				if _, err := tx.ExecContext(ctx, s.db.rebind(fmt.Sprintf(
+					"INSERT INTO %s (account_name, tx_id, asset, amount) "+
+						"VALUES (?, ?, ?, ?);", s.amountsTable,
+				)), r.accountName, r.txid, asset, int64(amount)); err != nil {
+					return err
+				}
+			}
+			added = append(added, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+// findAfter returns at most limit records of the given account matching the
+// filter that follow the cursor. A non-positive limit returns all of them.
+func (s txRecordStore) findAfter(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]txRecord, error) {
+	where, args := s.filterClause(s.table, accountName, filter)
+	if cursor != nil {
+		where += " AND (timestamp < ? OR (timestamp = ? AND tx_id < ?))"
+		args = append(args, cursor.Timestamp, cursor.Timestamp, cursor.Id)
+	}
+	var page domain.Page
+	if limit > 0 {
+		page = firstPage(limit)
+	}
+	return s.find(ctx, where, page, args...)
+}
+
+// findAllAfter returns at most limit records of all accounts matching the
+// filter that follow the cursor, whose id is made of the tx id and account
+// name separated by a colon. A non-positive limit returns all of them.
+func (s txRecordStore) findAllAfter(
+	ctx context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]txRecord, error) {
+	where, args := s.filterClause(s.table, allAccounts, filter)
+	if cursor != nil {
+		txid, accountName := cursor.Id, ""
+		if parts := strings.SplitN(cursor.Id, ":", 2); len(parts) == 2 {
+			txid, accountName = parts[0], parts[1]
+		}
+		where += " AND (timestamp < ? OR (timestamp = ? AND (tx_id < ? OR " +
+			"(tx_id = ? AND account_name < ?))))"
+		args = append(
+			args, cursor.Timestamp, cursor.Timestamp, txid, txid, accountName,
+		)
+	}
+	var page domain.Page
+	if limit > 0 {
+		page = firstPage(limit)
+	}
+	return s.find(ctx, where, page, args...)
+}
+
+// totalAmountPerAsset returns the sum of the amounts, grouped by asset, of
+// the records of the given account matching the filter. If the filter has an
+// asset, only its total is returned.
+func (s txRecordStore) totalAmountPerAsset(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	where, args := s.filterClause("r", accountName, filter)
+	if filter.Asset != "" {
+		where += " AND a.asset = ?"
+		args = append(args, filter.Asset)
+	}
+	query := fmt.Sprintf(
+		"SELECT a.asset, SUM(a.amount) FROM %s r JOIN %s a "+
+			"ON a.account_name = r.account_name AND a.tx_id = r.tx_id "+
+			"%s GROUP BY a.asset;",
+		s.table, s.amountsTable, where,
+	)
+	rows, err := s.db.querier(ctx).QueryContext(
+		ctx, s.db.rebind(query), args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[string]uint64)
+	for rows.Next() {
+		var (
+			asset  string
+			amount int64
+		)
+		if err := rows.Scan(&asset, &amount); err != nil {
+			return nil, err
+		}
+		totals[asset] = uint64(amount)
+	}
+	return totals, rows.Err()
+}
+
+// filterClause returns the where clause, and its args, matching the records
+// of the given account, or of all accounts for allAccounts, that satisfy the
+// filter. Columns are qualified with the given table name or alias.
+func (s txRecordStore) filterClause(
+	table, accountName string, filter domain.TxFilter,
+) (string, []interface{}) {
+	where := "WHERE 1 = 1"
+	args := make([]interface{}, 0)
+	if accountName != allAccounts {
+		where += fmt.Sprintf(" AND %s.account_name = ?", table)
+		args = append(args, accountName)
+	}
+	if filter.TxID != "" {
+		where += fmt.Sprintf(" AND %s.tx_id = ?", table)
+		args = append(args, filter.TxID)
+	}
+	if filter.StartTime > 0 {
+		where += fmt.Sprintf(" AND %s.timestamp >= ?", table)
+		args = append(args, filter.StartTime)
+	}
+	if filter.EndTime > 0 {
+		where += fmt.Sprintf(" AND %s.timestamp <= ?", table)
+		args = append(args, filter.EndTime)
+	}
+	if filter.Asset != "" {
+		where += fmt.Sprintf(
+			" AND EXISTS (SELECT 1 FROM %[1]s f WHERE f.account_name = "+
+				"%[2]s.account_name AND f.tx_id = %[2]s.tx_id AND f.asset = ?)",
+			s.amountsTable, table,
+		)
+		args = append(args, filter.Asset)
+	}
+	return where, args
+}
+
+// find returns the records matching the given where clause, sorted by
+// timestamp in descending order.
+func (s txRecordStore) find(
+	ctx context.Context, where string, page domain.Page, args ...interface{},
+) ([]txRecord, error) {
+	query := fmt.Sprintf(
+		"SELECT r.account_name, r.tx_id, r.timestamp, a.asset, a.amount FROM "+
+			"(SELECT account_name, tx_id, timestamp FROM %[1]s %[2]s "+
+			"ORDER BY timestamp DESC, tx_id DESC, account_name DESC%[3]s) r "+
+			"LEFT JOIN %[4]s a "+
+			"ON a.account_name = r.account_name AND a.tx_id = r.tx_id "+
+			"ORDER BY r.timestamp DESC, r.tx_id DESC, r.account_name DESC;",
+		s.table, where, pageClause(page), s.amountsTable,
+	)
+	rows, err := s.db.querier(ctx).QueryContext(
+		ctx, s.db.rebind(query), args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]txRecord, 0)
+	for rows.Next() {
+		var (
+			r      txRecord
+			asset  sql.NullString
+			amount sql.NullInt64
+		)
+		if err := rows.Scan(
+			&r.accountName, &r.txid, &r.timestamp, &asset, &amount,
+		); err != nil {
+			return nil, err
+		}
+
+		last := len(records) - 1
+		if last < 0 || records[last].accountName != r.accountName ||
+			records[last].txid != r.txid {
+			records = append(records, r)
+			last++
+		}
+		if asset.Valid {
+			if records[last].totAmountPerAsset == nil {
+				records[last].totAmountPerAsset = make(map[string]uint64)
+			}
+			records[last].totAmountPerAsset[asset.String] = uint64(amount.Int64)
+		}
+	}
+	return records, rows.Err()
+}
@@ -0,0 +1,96 @@
+package dbsql
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+)
+
+type withdrawalRepositoryImpl struct {
+	store txRecordStore
+}
+
+// newWithdrawalRepositoryImpl initialize a SQL implementation of the
+// domain.WithdrawalRepository
+func newWithdrawalRepositoryImpl(db *db) domain.WithdrawalRepository {
+	return withdrawalRepositoryImpl{txRecordStore{db, "withdrawals", "withdrawal_amounts"}}
+}
+
+func (w withdrawalRepositoryImpl) AddWithdrawals(
+	ctx context.Context, withdrawals []domain.Withdrawal,
+) ([]domain.Withdrawal, error) {
+	records := make([]txRecord, 0, len(withdrawals))
+	for _, ww := range withdrawals {
+		records = append(records, txRecord{
+			ww.AccountName, ww.TxID, ww.TotAmountPerAsset, ww.Timestamp,
+		})
+	}
+	added, err := w.store.insert(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalsFromRecords(added), nil
+}
+
+func (w withdrawalRepositoryImpl) GetWithdrawalsForAccount(
+	ctx context.Context, accountName string, page domain.Page,
+) ([]domain.Withdrawal, error) {
+	return w.findWithdrawals(ctx, "WHERE account_name = ?", page, accountName)
+}
+
+func (w withdrawalRepositoryImpl) GetWithdrawalsForAccountAfter(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+	cursor *domain.Cursor, limit int,
+) ([]domain.Withdrawal, error) {
+	records, err := w.store.findAfter(ctx, accountName, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalsFromRecords(records), nil
+}
+
+func (w withdrawalRepositoryImpl) GetWithdrawalsTotalAmountPerAsset(
+	ctx context.Context, accountName string, filter domain.TxFilter,
+) (map[string]uint64, error) {
+	return w.store.totalAmountPerAsset(ctx, accountName, filter)
+}
+
+func (w withdrawalRepositoryImpl) GetAllWithdrawalsAfter(
+	ctx context.Context, filter domain.TxFilter, cursor *domain.Cursor,
+	limit int,
+) ([]domain.Withdrawal, error) {
+	records, err := w.store.findAllAfter(ctx, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalsFromRecords(records), nil
+}
+
+func (w withdrawalRepositoryImpl) GetAllWithdrawals(
+	ctx context.Context, page domain.Page,
+) ([]domain.Withdrawal, error) {
+	return w.findWithdrawals(ctx, "", page)
+}
+
+func (w withdrawalRepositoryImpl) findWithdrawals(
+	ctx context.Context, where string, page domain.Page, args ...interface{},
+) ([]domain.Withdrawal, error) {
+	records, err := w.store.find(ctx, where, page, args...)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawalsFromRecords(records), nil
+}
+
+func withdrawalsFromRecords(records []txRecord) []domain.Withdrawal {
+	withdrawals := make([]domain.Withdrawal, 0, len(records))
+	for _, r := range records {
+		withdrawals = append(withdrawals, domain.Withdrawal{
+			AccountName:       r.accountName,
+			TxID:              r.txid,
+			TotAmountPerAsset: r.totAmountPerAsset,
+			Timestamp:         r.timestamp,
+		})
+	}
+	return withdrawals
+}
@@ -0,0 +1,153 @@
+package db_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
+)
+
+func TestBalanceSnapshotRepositoryImplementations(t *testing.T) {
+	repositories := createBalanceSnapshotRepositories(t)
+
+	for i := range repositories {
+		repo := repositories[i]
+
+		t.Run(repo.Name, func(t *testing.T) {
+			t.Run("add_and_get_balance_snapshots", func(t *testing.T) {
+				testAddAndGetBalanceSnapshots(t, repo)
+			})
+		})
+	}
+}
+
+func testAddAndGetBalanceSnapshots(t *testing.T, repo balanceSnapshotRepository) {
+	snapshotRepository := repo.Repository
+	ctx := context.Background()
+	accounts := []string{randomHex(20), randomHex(20)}
+	timestamp := randomTimestamp()
+
+	// One snapshot per account every hour, the last ones with empty balances.
+	snapshots := make([]domain.BalanceSnapshot, 0)
+	for i := 0; i < 4; i++ {
+		for _, account := range accounts {
+			balance := map[string]domain.AssetBalance{
+				randomHex(32): {Confirmed: uint64(i + 1), Unconfirmed: 2, Locked: 3},
+				randomHex(32): {Confirmed: 10},
+			}
+			if i == 3 {
+				balance = map[string]domain.AssetBalance{}
+			}
+			snapshots = append(snapshots, domain.BalanceSnapshot{
+				AccountName:     account,
+				Timestamp:       timestamp + int64(i*3600),
+				BalancePerAsset: balance,
+			})
+		}
+	}
+
+	count, err := snapshotRepository.AddBalanceSnapshots(ctx, snapshots)
+	require.NoError(t, err)
+	require.Equal(t, len(snapshots), count)
+
+	count, err = snapshotRepository.AddBalanceSnapshots(ctx, snapshots)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	history, err := snapshotRepository.GetBalanceSnapshots(
+		ctx, "", 0, 0, nil, 0,
+	)
+	require.NoError(t, err)
+	require.Len(t, history, len(snapshots))
+	for i := 1; i < len(history); i++ {
+		require.GreaterOrEqual(t, history[i-1].Timestamp, history[i].Timestamp)
+	}
+
+	history, err = snapshotRepository.GetBalanceSnapshots(
+		ctx, accounts[0], 0, 0, nil, 0,
+	)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	for i, snapshot := range history {
+		require.Exactly(t, snapshots[6-2*i], snapshot)
+	}
+
+	history, err = snapshotRepository.GetBalanceSnapshots(
+		ctx, accounts[1], timestamp+3600, timestamp+7200, nil, 0,
+	)
+	require.NoError(t, err)
+	require.Exactly(t, []domain.BalanceSnapshot{snapshots[5], snapshots[3]}, history)
+
+	history, err = snapshotRepository.GetBalanceSnapshots(
+		ctx, randomHex(20), 0, 0, nil, 0,
+	)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	// Pages of the history of all accounts follow one another without gaps.
+	pages := make([]domain.BalanceSnapshot, 0)
+	var cursor *domain.Cursor
+	for {
+		page, err := snapshotRepository.GetBalanceSnapshots(
+			ctx, "", timestamp, 0, cursor, 3,
+		)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 3)
+		if len(page) <= 0 {
+			break
+		}
+		pages = append(pages, page...)
+		last := page[len(page)-1].Cursor()
+		cursor = &last
+	}
+	require.Len(t, pages, len(snapshots))
+	for i, snapshot := range pages {
+		require.Exactly(t, snapshots[len(snapshots)-1-i].Timestamp, snapshot.Timestamp)
+	}
+
+	// Pages of the history of a single account are limited to its snapshots.
+	cursor = &domain.Cursor{Timestamp: timestamp + 7200, Id: accounts[0]}
+	history, err = snapshotRepository.GetBalanceSnapshots(
+		ctx, accounts[0], 0, 0, cursor, 3,
+	)
+	require.NoError(t, err)
+	require.Exactly(t, []domain.BalanceSnapshot{snapshots[2], snapshots[0]}, history)
+}
+
+type balanceSnapshotRepository struct {
+	Name       string
+	Repository domain.BalanceSnapshotRepository
+}
+
+func createBalanceSnapshotRepositories(
+	t *testing.T,
+) []balanceSnapshotRepository {
+	inmemoryDBManager := inmemory.NewRepoManager()
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
+	require.NoError(t, err)
+	sqliteDBManager, err := dbsql.NewSQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.NoError(t, err)
+
+	return []balanceSnapshotRepository{
+		{
+			Name:       "badger",
+			Repository: badgerDBManager.BalanceSnapshotRepository(),
+		},
+		{
+			Name:       "inmemory",
+			Repository: inmemoryDBManager.BalanceSnapshotRepository(),
+		},
+		{
+			Name:       "sqlite",
+			Repository: sqliteDBManager.BalanceSnapshotRepository(),
+		},
+	}
+}
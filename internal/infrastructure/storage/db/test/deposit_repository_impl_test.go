@@ -2,6 +2,7 @@ package db_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,6 +10,7 @@ import (
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
 	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
 )
 
 func TestDepositRepositoryImplementations(t *testing.T) {
@@ -21,6 +23,18 @@ func TestDepositRepositoryImplementations(t *testing.T) {
 			t.Run("add_and_get_deposits", func(t *testing.T) {
 				testAddAndGetDeposits(t, repo)
 			})
+
+			t.Run("get_deposits_for_account_after", func(t *testing.T) {
+				testGetDepositsForAccountAfter(t, repo)
+			})
+
+			t.Run("get_deposits_with_filter", func(t *testing.T) {
+				testGetDepositsWithFilter(t, repo)
+			})
+
+			t.Run("get_all_deposits_after", func(t *testing.T) {
+				testGetAllDepositsAfter(t, repo)
+			})
 		})
 	}
 }
@@ -34,17 +48,17 @@ func testAddAndGetDeposits(t *testing.T, repo depositRepository) {
 	require.NoError(t, err)
 	require.Empty(t, allDeposits)
 
-	count, err := depositRepository.AddDeposits(ctx, deposits)
+	added, err := depositRepository.AddDeposits(ctx, deposits)
 	require.NoError(t, err)
-	require.Equal(t, 20, count)
+	require.Len(t, added, 20)
 
 	allDeposits, err = depositRepository.GetAllDeposits(ctx, nil)
 	require.NoError(t, err)
 	require.Len(t, allDeposits, 20)
 
-	count, err = depositRepository.AddDeposits(ctx, deposits)
+	added, err = depositRepository.AddDeposits(ctx, deposits)
 	require.NoError(t, err)
-	require.Zero(t, count)
+	require.Empty(t, added)
 
 	// Test that pagination is correct by getting all 20 deposits in 4 pages,
 	// each including 5 items. The concatenation of all pages must match the
@@ -73,6 +87,200 @@ func testAddAndGetDeposits(t *testing.T, repo depositRepository) {
 	require.Empty(t, depositsByMarket)
 }
 
+func testGetDepositsForAccountAfter(t *testing.T, repo depositRepository) {
+	depositRepository := repo.Repository
+	ctx := context.Background()
+	accountName := randomHex(20)
+	timestamp := randomTimestamp()
+
+	// Pairs of deposits share the same timestamp to check that ties are broken.
+	deposits := makeRandomDeposits(10)
+	for i := range deposits {
+		deposits[i].AccountName = accountName
+		deposits[i].Timestamp = timestamp + int64(i/2)
+	}
+	_, err := depositRepository.AddDeposits(ctx, deposits)
+	require.NoError(t, err)
+
+	allDeposits, err := depositRepository.GetDepositsForAccountAfter(
+		ctx, accountName, domain.TxFilter{}, nil, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, allDeposits, 10)
+
+	// New deposits added while paginating must not shift the following pages.
+	pagedDeposits := make([]domain.Deposit, 0)
+	var cursor *domain.Cursor
+	for {
+		list, err := depositRepository.GetDepositsForAccountAfter(
+			ctx, accountName, domain.TxFilter{}, cursor, 3,
+		)
+		require.NoError(t, err)
+		if len(list) <= 0 {
+			break
+		}
+		pagedDeposits = append(pagedDeposits, list...)
+		c := list[len(list)-1].Cursor()
+		cursor = &c
+
+		newer := makeRandomDeposits(1)
+		newer[0].AccountName = accountName
+		newer[0].Timestamp = timestamp + 10
+		_, err = depositRepository.AddDeposits(ctx, newer)
+		require.NoError(t, err)
+	}
+	require.Exactly(t, allDeposits, pagedDeposits)
+}
+
+func testGetDepositsWithFilter(t *testing.T, repo depositRepository) {
+	depositRepository := repo.Repository
+	ctx := context.Background()
+	accountName := randomHex(20)
+	timestamp := randomTimestamp()
+	assetA, assetB := randomHex(32), randomHex(32)
+
+	// Even deposits move only asset A, odd ones move both assets.
+	deposits := makeRandomDeposits(6)
+	for i := range deposits {
+		deposits[i].AccountName = accountName
+		deposits[i].Timestamp = timestamp + int64(i)
+		deposits[i].TotAmountPerAsset = map[string]uint64{assetA: uint64(10 * (i + 1))}
+		if i%2 != 0 {
+			deposits[i].TotAmountPerAsset = map[string]uint64{assetA: 1, assetB: 100}
+		}
+	}
+	_, err := depositRepository.AddDeposits(ctx, deposits)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		filter      domain.TxFilter
+		expectedIds []string
+		totals      map[string]uint64
+	}{
+		{
+			name:   "no filter",
+			filter: domain.TxFilter{},
+			expectedIds: []string{
+				deposits[5].TxID, deposits[4].TxID, deposits[3].TxID,
+				deposits[2].TxID, deposits[1].TxID, deposits[0].TxID,
+			},
+			totals: map[string]uint64{assetA: 93, assetB: 300},
+		},
+		{
+			name:   "by asset",
+			filter: domain.TxFilter{Asset: assetB},
+			expectedIds: []string{
+				deposits[5].TxID, deposits[3].TxID, deposits[1].TxID,
+			},
+			totals: map[string]uint64{assetB: 300},
+		},
+		{
+			name:        "by txid",
+			filter:      domain.TxFilter{TxID: deposits[2].TxID},
+			expectedIds: []string{deposits[2].TxID},
+			totals:      map[string]uint64{assetA: 30},
+		},
+		{
+			name: "by time range",
+			filter: domain.TxFilter{
+				StartTime: timestamp + 2, EndTime: timestamp + 3,
+			},
+			expectedIds: []string{deposits[3].TxID, deposits[2].TxID},
+			totals:      map[string]uint64{assetA: 31, assetB: 100},
+		},
+		{
+			name:        "no match",
+			filter:      domain.TxFilter{Asset: randomHex(32)},
+			expectedIds: []string{},
+			totals:      map[string]uint64{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := depositRepository.GetDepositsForAccountAfter(
+				ctx, accountName, tt.filter, nil, 0,
+			)
+			require.NoError(t, err)
+			ids := make([]string, 0, len(list))
+			for _, deposit := range list {
+				ids = append(ids, deposit.TxID)
+			}
+			require.Exactly(t, tt.expectedIds, ids)
+
+			// Paginating the filtered list must return the same records.
+			paged := make([]domain.Deposit, 0)
+			var cursor *domain.Cursor
+			for {
+				next, err := depositRepository.GetDepositsForAccountAfter(
+					ctx, accountName, tt.filter, cursor, 2,
+				)
+				require.NoError(t, err)
+				if len(next) <= 0 {
+					break
+				}
+				paged = append(paged, next...)
+				c := next[len(next)-1].Cursor()
+				cursor = &c
+			}
+			require.Exactly(t, list, paged)
+
+			totals, err := depositRepository.GetDepositsTotalAmountPerAsset(
+				ctx, accountName, tt.filter,
+			)
+			require.NoError(t, err)
+			require.Exactly(t, tt.totals, totals)
+		})
+	}
+}
+
+func testGetAllDepositsAfter(t *testing.T, repo depositRepository) {
+	depositRepository := repo.Repository
+	ctx := context.Background()
+	// The records are out of the range of the random timestamps of those added
+	// by the other tests.
+	timestamp := int64(1700000000)
+	filter := domain.TxFilter{StartTime: timestamp, EndTime: timestamp + 10}
+
+	// Every tx is a deposit for 2 accounts, and pairs of txs share the same
+	// timestamp to check that ties are broken.
+	deposits := make([]domain.Deposit, 0, 20)
+	for i, deposit := range makeRandomDeposits(10) {
+		deposit.Timestamp = timestamp + int64(i/2)
+		other := deposit
+		other.AccountName = randomHex(20)
+		deposits = append(deposits, deposit, other)
+	}
+	_, err := depositRepository.AddDeposits(ctx, deposits)
+	require.NoError(t, err)
+
+	allDeposits, err := depositRepository.GetAllDepositsAfter(
+		ctx, filter, nil, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, allDeposits, 20)
+	for i := 1; i < len(allDeposits); i++ {
+		c := allDeposits[i-1].AllAccountsCursor()
+		require.True(t, c.Precedes(allDeposits[i].AllAccountsCursor()))
+	}
+
+	pagedDeposits := make([]domain.Deposit, 0)
+	var cursor *domain.Cursor
+	for {
+		list, err := depositRepository.GetAllDepositsAfter(ctx, filter, cursor, 3)
+		require.NoError(t, err)
+		if len(list) <= 0 {
+			break
+		}
+		pagedDeposits = append(pagedDeposits, list...)
+		c := list[len(list)-1].AllAccountsCursor()
+		cursor = &c
+	}
+	require.Exactly(t, allDeposits, pagedDeposits)
+}
+
 type depositRepository struct {
 	Name       string
 	Repository domain.DepositRepository
@@ -82,6 +290,10 @@ func createDepositRepositories(t *testing.T) []depositRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
 	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
 	require.NoError(t, err)
+	sqliteDBManager, err := dbsql.NewSQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.NoError(t, err)
 
 	return []depositRepository{
 		{
@@ -92,5 +304,9 @@ func createDepositRepositories(t *testing.T) []depositRepository {
 			Name:       "inmemory",
 			Repository: inmemoryDBManager.DepositRepository(),
 		},
+		{
+			Name:       "sqlite",
+			Repository: sqliteDBManager.DepositRepository(),
+		},
 	}
 }
@@ -0,0 +1,65 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+)
+
+func TestBadgerMaintenance(t *testing.T) {
+	repoManager, err := dbbadger.NewRepoManager(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer repoManager.Close()
+
+	store, ok := repoManager.(ports.Maintainable)
+	require.True(t, ok)
+
+	stats, err := store.StoreStats()
+	require.NoError(t, err)
+	require.Len(t, stats, 5)
+	for _, s := range stats {
+		require.Zero(t, s.GetKeyCount())
+		require.Zero(t, s.GetLastGC())
+	}
+
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		err := repoManager.TradeRepository().AddTrade(ctx, makeRandomTrade())
+		require.NoError(t, err)
+	}
+
+	stats, err = store.StoreStats()
+	require.NoError(t, err)
+	tradeStats := findStoreStats(stats, "trades")
+	require.NotNil(t, tradeStats)
+	require.NotZero(t, tradeStats.GetKeyCount())
+	require.Positive(t, tradeStats.GetDiskUsage())
+
+	for _, compact := range []bool{false, true} {
+		err = store.RunGC(compact)
+		require.NoError(t, err)
+
+		stats, err = store.StoreStats()
+		require.NoError(t, err)
+		for _, s := range stats {
+			require.NotZero(t, s.GetLastGC())
+		}
+		require.Equal(
+			t, tradeStats.GetKeyCount(),
+			findStoreStats(stats, "trades").GetKeyCount(),
+		)
+	}
+}
+
+func findStoreStats(stats []ports.StoreStats, name string) ports.StoreStats {
+	for _, s := range stats {
+		if s.GetName() == name {
+			return s
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package db_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -10,6 +11,7 @@ import (
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
 	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
 )
 
 func TestMarketRepositoryImplementations(t *testing.T) {
@@ -212,6 +214,10 @@ func createMarketRepositories(t *testing.T) []marketRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
 	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
 	require.NoError(t, err)
+	sqliteDBManager, err := dbsql.NewSQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.NoError(t, err)
 
 	return []marketRepository{
 		{
@@ -222,6 +228,10 @@ func createMarketRepositories(t *testing.T) []marketRepository {
 			Name:       "inmemory",
 			Repository: inmemoryDBManager.MarketRepository(),
 		},
+		{
+			Name:       "sqlite",
+			Repository: sqliteDBManager.MarketRepository(),
+		},
 	}
 }
 
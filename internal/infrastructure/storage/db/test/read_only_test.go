@@ -0,0 +1,81 @@
+package db_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
+)
+
+func TestBadgerReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dbDir := t.TempDir()
+
+	repoManager, err := dbbadger.NewRepoManager(dbDir, nil)
+	require.NoError(t, err)
+
+	trade := makeRandomTrade()
+	err = repoManager.TradeRepository().AddTrade(ctx, trade)
+	require.NoError(t, err)
+	repoManager.Close()
+
+	repoManager, err = dbbadger.NewReadOnlyRepoManager(dbDir, nil)
+	require.NoError(t, err)
+	defer repoManager.Close()
+
+	// Trades are paged with the cursor index built by the daemon in normal
+	// mode.
+	trades, err := repoManager.TradeRepository().GetTradesByMarketAfter(
+		ctx, trade.MarketName, nil, 10,
+	)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	require.Equal(t, trade.Id, trades[0].Id)
+
+	err = repoManager.TradeRepository().AddTrade(ctx, makeRandomTrade())
+	require.Error(t, err)
+
+	_, err = dbbadger.NewReadOnlyRepoManager("", nil)
+	require.Error(t, err)
+}
+
+func TestSQLiteReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dbFile := filepath.Join(t.TempDir(), dbsql.SQLiteFile)
+
+	repoManager, err := dbsql.NewSQLiteRepoManager(dbFile)
+	require.NoError(t, err)
+
+	trade := makeRandomTrade()
+	err = repoManager.TradeRepository().AddTrade(ctx, trade)
+	require.NoError(t, err)
+	repoManager.Close()
+
+	repoManager, err = dbsql.NewReadOnlySQLiteRepoManager(dbFile)
+	require.NoError(t, err)
+	defer repoManager.Close()
+
+	err = repoManager.RunReadTransaction(ctx, func(ctx context.Context) error {
+		trades, err := repoManager.TradeRepository().GetTradesByMarketAfter(
+			ctx, trade.MarketName, nil, 10,
+		)
+		require.NoError(t, err)
+		require.Len(t, trades, 1)
+		require.Equal(t, trade.Id, trades[0].Id)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = repoManager.TradeRepository().AddTrade(ctx, makeRandomTrade())
+	require.Error(t, err)
+
+	// The db is never created in read-only mode.
+	_, err = dbsql.NewReadOnlySQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,67 @@
+package db_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
+)
+
+func TestRunReadTransaction(t *testing.T) {
+	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
+	require.NoError(t, err)
+	sqliteDBManager, err := dbsql.NewSQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.NoError(t, err)
+
+	for name, repoManager := range map[string]ports.RepoManager{
+		"badger": badgerDBManager,
+		"sqlite": sqliteDBManager,
+	} {
+		repoManager := repoManager
+		t.Run(name, func(t *testing.T) {
+			defer repoManager.Close()
+			ctx := context.Background()
+			repo := repoManager.DepositRepository()
+
+			_, err := repo.AddDeposits(ctx, makeRandomDeposits(2))
+			require.NoError(t, err)
+
+			// Deposits added while the read transaction is open are not seen by
+			// the reads made within it.
+			err = repoManager.RunReadTransaction(
+				ctx, func(txCtx context.Context) error {
+					deposits, err := repo.GetAllDepositsAfter(
+						txCtx, domain.TxFilter{}, nil, 0,
+					)
+					require.NoError(t, err)
+					require.Len(t, deposits, 2)
+
+					_, err = repo.AddDeposits(ctx, makeRandomDeposits(1))
+					require.NoError(t, err)
+
+					deposits, err = repo.GetAllDepositsAfter(
+						txCtx, domain.TxFilter{}, nil, 0,
+					)
+					require.NoError(t, err)
+					require.Len(t, deposits, 2)
+					return nil
+				},
+			)
+			require.NoError(t, err)
+
+			deposits, err := repo.GetAllDepositsAfter(
+				ctx, domain.TxFilter{}, nil, 0,
+			)
+			require.NoError(t, err)
+			require.Len(t, deposits, 3)
+		})
+	}
+}
@@ -3,12 +3,14 @@ package db_test
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/domain"
 	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
 	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
 )
 
 func TestTradeRepositoryImplementations(t *testing.T) {
@@ -31,6 +33,22 @@ func TestTradeRepositoryImplementations(t *testing.T) {
 			t.Run("get_completed_trades", func(t *testing.T) {
 				testGetCompletedTrades(t, repo.Repository)
 			})
+
+			t.Run("get_trade_by_swap_request_id", func(t *testing.T) {
+				testGetTradeBySwapRequestId(t, repo.Repository)
+			})
+
+			t.Run("get_trades_by_market_after", func(t *testing.T) {
+				testGetTradesByMarketAfter(t, repo.Repository)
+			})
+
+			t.Run("get_all_trades_after", func(t *testing.T) {
+				testGetAllTradesAfter(t, repo.Repository)
+			})
+
+			t.Run("get_archivable_and_delete_trades", func(t *testing.T) {
+				testGetArchivableAndDeleteTrades(t, repo.Repository)
+			})
 		})
 	}
 }
@@ -139,10 +157,251 @@ func testGetCompletedTrades(t *testing.T, repo domain.TradeRepository) {
 	require.Exactly(t, trade, *foundTrade)
 }
 
+func testGetTradeBySwapRequestId(t *testing.T, repo domain.TradeRepository) {
+	ctx := context.Background()
+	marketName := randomHex(20)
+	swapRequestId := randomId()
+
+	foundTrade, err := repo.GetTradeBySwapRequestId(ctx, marketName, swapRequestId)
+	require.NoError(t, err)
+	require.Nil(t, foundTrade)
+
+	trade := makeRandomTrade()
+	trade.MarketName = marketName
+	trade.SwapRequest = &domain.Swap{Id: swapRequestId, Timestamp: 1}
+	err = repo.AddTrade(ctx, trade)
+	require.NoError(t, err)
+
+	foundTrade, err = repo.GetTradeBySwapRequestId(ctx, marketName, swapRequestId)
+	require.NoError(t, err)
+	require.NotNil(t, foundTrade)
+	require.Equal(t, trade.Id, foundTrade.Id)
+
+	// In case of multiple trades for the same swap request, the most recent one
+	// is expected to be returned.
+	newTrade := makeRandomTrade()
+	newTrade.MarketName = marketName
+	newTrade.SwapRequest = &domain.Swap{Id: swapRequestId, Timestamp: 2}
+	err = repo.AddTrade(ctx, newTrade)
+	require.NoError(t, err)
+
+	foundTrade, err = repo.GetTradeBySwapRequestId(ctx, marketName, swapRequestId)
+	require.NoError(t, err)
+	require.NotNil(t, foundTrade)
+	require.Equal(t, newTrade.Id, foundTrade.Id)
+
+	// The trades of other markets with the same swap request id are ignored.
+	otherTrade := makeRandomTrade()
+	otherTrade.SwapRequest = &domain.Swap{Id: swapRequestId, Timestamp: 3}
+	err = repo.AddTrade(ctx, otherTrade)
+	require.NoError(t, err)
+
+	foundTrade, err = repo.GetTradeBySwapRequestId(ctx, marketName, swapRequestId)
+	require.NoError(t, err)
+	require.NotNil(t, foundTrade)
+	require.Equal(t, newTrade.Id, foundTrade.Id)
+
+	err = repo.DeleteTrades(ctx, []string{newTrade.Id})
+	require.NoError(t, err)
+
+	foundTrade, err = repo.GetTradeBySwapRequestId(ctx, marketName, swapRequestId)
+	require.NoError(t, err)
+	require.NotNil(t, foundTrade)
+	require.Equal(t, trade.Id, foundTrade.Id)
+}
+
+func testGetTradesByMarketAfter(t *testing.T, repo domain.TradeRepository) {
+	ctx := context.Background()
+	marketName := randomHex(20)
+	timestamp := randomTimestamp()
+
+	// Pairs of trades share the same timestamp to check that ties are broken.
+	for i := 0; i < 10; i++ {
+		trade := makeRandomTrade()
+		trade.MarketName = marketName
+		trade.SwapRequest = &domain.Swap{
+			Id: randomId(), Timestamp: timestamp + int64(i/2),
+		}
+		require.NoError(t, repo.AddTrade(ctx, trade))
+	}
+
+	allTrades, err := repo.GetTradesByMarketAfter(ctx, marketName, nil, 100)
+	require.NoError(t, err)
+	require.Len(t, allTrades, 10)
+	for i := 1; i < len(allTrades); i++ {
+		c := allTrades[i-1].Cursor()
+		require.True(t, c.Precedes(allTrades[i].Cursor()))
+	}
+
+	// New trades added while paginating must not shift the following pages.
+	pagedTrades := make([]domain.Trade, 0)
+	var cursor *domain.Cursor
+	for {
+		trades, err := repo.GetTradesByMarketAfter(ctx, marketName, cursor, 3)
+		require.NoError(t, err)
+		if len(trades) <= 0 {
+			break
+		}
+		pagedTrades = append(pagedTrades, trades...)
+		c := trades[len(trades)-1].Cursor()
+		cursor = &c
+
+		trade := makeRandomTrade()
+		trade.MarketName = marketName
+		trade.SwapRequest = &domain.Swap{Id: randomId(), Timestamp: timestamp + 10}
+		require.NoError(t, repo.AddTrade(ctx, trade))
+	}
+	require.Exactly(t, allTrades, pagedTrades)
+
+	// Deleted trades must not be returned anymore.
+	trades, err := repo.GetTradesByMarketAfter(ctx, marketName, nil, 100)
+	require.NoError(t, err)
+	deletedTrade := allTrades[0]
+	require.NoError(t, repo.DeleteTrades(ctx, []string{deletedTrade.Id}))
+
+	tradesAfterDelete, err := repo.GetTradesByMarketAfter(
+		ctx, marketName, nil, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, tradesAfterDelete, len(trades)-1)
+	for _, trade := range tradesAfterDelete {
+		require.NotEqual(t, deletedTrade.Id, trade.Id)
+	}
+}
+
+func testGetAllTradesAfter(t *testing.T, repo domain.TradeRepository) {
+	ctx := context.Background()
+	// The trades are out of the range of the random timestamps of those added
+	// by the other tests.
+	timestamp := int64(1700000000)
+
+	// Trades of different markets, where pairs of them share the same
+	// timestamp to check that ties are broken.
+	for i := 0; i < 12; i++ {
+		trade := makeRandomTrade()
+		trade.SwapRequest = &domain.Swap{
+			Id: randomId(), Timestamp: timestamp + int64(i/2),
+		}
+		require.NoError(t, repo.AddTrade(ctx, trade))
+	}
+
+	// Bounds are included.
+	allTrades, err := repo.GetAllTradesAfter(
+		ctx, timestamp+1, timestamp+4, nil, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, allTrades, 8)
+	for i := 1; i < len(allTrades); i++ {
+		c := allTrades[i-1].Cursor()
+		require.True(t, c.Precedes(allTrades[i].Cursor()))
+	}
+
+	pagedTrades := make([]domain.Trade, 0)
+	var cursor *domain.Cursor
+	for {
+		trades, err := repo.GetAllTradesAfter(
+			ctx, timestamp+1, timestamp+4, cursor, 3,
+		)
+		require.NoError(t, err)
+		if len(trades) <= 0 {
+			break
+		}
+		pagedTrades = append(pagedTrades, trades...)
+		c := trades[len(trades)-1].Cursor()
+		cursor = &c
+	}
+	require.Exactly(t, allTrades, pagedTrades)
+
+	// A zero end time means no upper bound.
+	trades, err := repo.GetAllTradesAfter(ctx, timestamp+4, 0, nil, 100)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(trades), 4)
+	for _, trade := range trades {
+		require.GreaterOrEqual(t, trade.SwapRequest.Timestamp, timestamp+4)
+	}
+}
+
+func testGetArchivableAndDeleteTrades(
+	t *testing.T, repo domain.TradeRepository,
+) {
+	ctx := context.Background()
+	// Other tests use random timestamps, therefore archivable trades are
+	// looked for before a time older than those.
+	before := int64(1000)
+
+	newTrade := func(
+		timestamp int64, status domain.TradeStatus, expiryTime int64,
+	) *domain.Trade {
+		trade := makeRandomTrade()
+		trade.Status = status
+		trade.ExpiryTime = expiryTime
+		trade.SwapRequest = &domain.Swap{Id: randomId(), Timestamp: timestamp}
+		require.NoError(t, repo.AddTrade(ctx, trade))
+		return trade
+	}
+
+	archivable := []*domain.Trade{
+		newTrade(before-10, domain.TradeStatus{
+			Code: domain.TradeStatusCodeProposal, Failed: true,
+		}, 0),
+		newTrade(before-10, domain.TradeStatus{
+			Code: domain.TradeStatusCodeExpired,
+		}, 0),
+		newTrade(before-10, domain.TradeStatus{
+			Code: domain.TradeStatusCodeAccepted,
+		}, before-5),
+	}
+	notArchivable := []*domain.Trade{
+		newTrade(before-10, domain.TradeStatus{
+			Code: domain.TradeStatusCodeSettled,
+		}, before-5),
+		newTrade(before-10, domain.TradeStatus{
+			Code: domain.TradeStatusCodeAccepted,
+		}, before+5),
+		newTrade(before+10, domain.TradeStatus{
+			Code: domain.TradeStatusCodeProposal, Failed: true,
+		}, 0),
+	}
+
+	trades, err := repo.GetArchivableTrades(ctx, before, 2)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+
+	trades, err = repo.GetArchivableTrades(ctx, before, 100)
+	require.NoError(t, err)
+	require.Len(t, trades, len(archivable))
+
+	ids := make([]string, 0, len(trades))
+	for _, trade := range trades {
+		ids = append(ids, trade.Id)
+	}
+	require.NoError(t, repo.DeleteTrades(ctx, ids))
+
+	trades, err = repo.GetArchivableTrades(ctx, before, 100)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+
+	for _, trade := range archivable {
+		_, err := repo.GetTradeById(ctx, trade.Id)
+		require.Error(t, err)
+		trades, err := repo.GetAllTradesByMarket(ctx, trade.MarketName, nil)
+		require.NoError(t, err)
+		require.Empty(t, trades)
+	}
+	for _, trade := range notArchivable {
+		_, err := repo.GetTradeById(ctx, trade.Id)
+		require.NoError(t, err)
+	}
+}
+
 func createTradeRepositories(t *testing.T) []tradeRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
 	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
 	require.NoError(t, err)
+	sqliteDBManager, err := dbsql.NewSQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.NoError(t, err)
 
 	return []tradeRepository{
 		{
@@ -153,6 +412,10 @@ func createTradeRepositories(t *testing.T) []tradeRepository {
 			Name:       "inmemory",
 			Repository: inmemoryDBManager.TradeRepository(),
 		},
+		{
+			Name:       "sqlite",
+			Repository: sqliteDBManager.TradeRepository(),
+		},
 	}
 }
 
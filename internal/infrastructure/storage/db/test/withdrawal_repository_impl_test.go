@@ -2,9 +2,11 @@ package db_test
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/inmemory"
+	dbsql "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/sql"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
@@ -26,6 +28,18 @@ func TestWithdrawalRepositoryImplementations(t *testing.T) {
 			t.Run("add_and_get_withdrawals", func(t *testing.T) {
 				testAddAndGetWithdrawals(t, repo)
 			})
+
+			t.Run("get_withdrawals_for_account_after", func(t *testing.T) {
+				testGetWithdrawalsForAccountAfter(t, repo)
+			})
+
+			t.Run("get_withdrawals_with_filter", func(t *testing.T) {
+				testGetWithdrawalsWithFilter(t, repo)
+			})
+
+			t.Run("get_all_withdrawals_after", func(t *testing.T) {
+				testGetAllWithdrawalsAfter(t, repo)
+			})
 		})
 	}
 }
@@ -39,21 +53,21 @@ func testAddAndGetWithdrawals(t *testing.T, repo withdrawalRepository) {
 	require.NoError(t, err)
 	require.Empty(t, allWithdrawals)
 
-	count, err := withdrawalRepository.AddWithdrawals(
+	added, err := withdrawalRepository.AddWithdrawals(
 		context.Background(), withdrawals,
 	)
 	require.NoError(t, err)
-	require.Equal(t, 20, count)
+	require.Len(t, added, 20)
 
 	allWithdrawals, err = withdrawalRepository.GetAllWithdrawals(ctx, nil)
 	require.NoError(t, err)
 	require.Len(t, allWithdrawals, 20)
 
-	count, err = withdrawalRepository.AddWithdrawals(
+	added, err = withdrawalRepository.AddWithdrawals(
 		context.Background(), withdrawals,
 	)
 	require.NoError(t, err)
-	require.Zero(t, count)
+	require.Empty(t, added)
 
 	// Test that pagination is correct by getting all 20 withdrawals in 4 pages,
 	// each including 5 items. The concatenation of all pages must match the
@@ -82,6 +96,197 @@ func testAddAndGetWithdrawals(t *testing.T, repo withdrawalRepository) {
 	require.Empty(t, withdrawalsByMarket)
 }
 
+func testGetWithdrawalsForAccountAfter(t *testing.T, repo withdrawalRepository) {
+	withdrawalRepository := repo.Repository
+	ctx := context.Background()
+	accountName := randomHex(20)
+	timestamp := randomTimestamp()
+
+	// Pairs of withdrawals share the same timestamp to check that ties are broken.
+	withdrawals := makeRandomWithdrawals(10)
+	for i := range withdrawals {
+		withdrawals[i].AccountName = accountName
+		withdrawals[i].Timestamp = timestamp + int64(i/2)
+	}
+	_, err := withdrawalRepository.AddWithdrawals(ctx, withdrawals)
+	require.NoError(t, err)
+
+	allWithdrawals, err := withdrawalRepository.GetWithdrawalsForAccountAfter(
+		ctx, accountName, domain.TxFilter{}, nil, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, allWithdrawals, 10)
+
+	// New withdrawals added while paginating must not shift the following pages.
+	pagedWithdrawals := make([]domain.Withdrawal, 0)
+	var cursor *domain.Cursor
+	for {
+		list, err := withdrawalRepository.GetWithdrawalsForAccountAfter(
+			ctx, accountName, domain.TxFilter{}, cursor, 3,
+		)
+		require.NoError(t, err)
+		if len(list) <= 0 {
+			break
+		}
+		pagedWithdrawals = append(pagedWithdrawals, list...)
+		c := list[len(list)-1].Cursor()
+		cursor = &c
+
+		newer := makeRandomWithdrawals(1)
+		newer[0].AccountName = accountName
+		newer[0].Timestamp = timestamp + 10
+		_, err = withdrawalRepository.AddWithdrawals(ctx, newer)
+		require.NoError(t, err)
+	}
+	require.Exactly(t, allWithdrawals, pagedWithdrawals)
+}
+
+func testGetWithdrawalsWithFilter(t *testing.T, repo withdrawalRepository) {
+	withdrawalRepository := repo.Repository
+	ctx := context.Background()
+	accountName := randomHex(20)
+	timestamp := randomTimestamp()
+	assetA, assetB := randomHex(32), randomHex(32)
+
+	// Even withdrawals move only asset A, odd ones move both assets.
+	withdrawals := makeRandomWithdrawals(6)
+	for i := range withdrawals {
+		withdrawals[i].AccountName = accountName
+		withdrawals[i].Timestamp = timestamp + int64(i)
+		withdrawals[i].TotAmountPerAsset = map[string]uint64{assetA: uint64(10 * (i + 1))}
+		if i%2 != 0 {
+			withdrawals[i].TotAmountPerAsset = map[string]uint64{assetA: 1, assetB: 100}
+		}
+	}
+	_, err := withdrawalRepository.AddWithdrawals(ctx, withdrawals)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		filter      domain.TxFilter
+		expectedIds []string
+		totals      map[string]uint64
+	}{
+		{
+			name:   "no filter",
+			filter: domain.TxFilter{},
+			expectedIds: []string{
+				withdrawals[5].TxID, withdrawals[4].TxID, withdrawals[3].TxID,
+				withdrawals[2].TxID, withdrawals[1].TxID, withdrawals[0].TxID,
+			},
+			totals: map[string]uint64{assetA: 93, assetB: 300},
+		},
+		{
+			name:   "by asset",
+			filter: domain.TxFilter{Asset: assetB},
+			expectedIds: []string{
+				withdrawals[5].TxID, withdrawals[3].TxID, withdrawals[1].TxID,
+			},
+			totals: map[string]uint64{assetB: 300},
+		},
+		{
+			name:        "by txid",
+			filter:      domain.TxFilter{TxID: withdrawals[2].TxID},
+			expectedIds: []string{withdrawals[2].TxID},
+			totals:      map[string]uint64{assetA: 30},
+		},
+		{
+			name: "by time range",
+			filter: domain.TxFilter{
+				StartTime: timestamp + 2, EndTime: timestamp + 3,
+			},
+			expectedIds: []string{withdrawals[3].TxID, withdrawals[2].TxID},
+			totals:      map[string]uint64{assetA: 31, assetB: 100},
+		},
+		{
+			name:        "no match",
+			filter:      domain.TxFilter{Asset: randomHex(32)},
+			expectedIds: []string{},
+			totals:      map[string]uint64{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := withdrawalRepository.GetWithdrawalsForAccountAfter(
+				ctx, accountName, tt.filter, nil, 0,
+			)
+			require.NoError(t, err)
+			ids := make([]string, 0, len(list))
+			for _, withdrawal := range list {
+				ids = append(ids, withdrawal.TxID)
+			}
+			require.Exactly(t, tt.expectedIds, ids)
+
+			// Paginating the filtered list must return the same records.
+			paged := make([]domain.Withdrawal, 0)
+			var cursor *domain.Cursor
+			for {
+				next, err := withdrawalRepository.GetWithdrawalsForAccountAfter(
+					ctx, accountName, tt.filter, cursor, 2,
+				)
+				require.NoError(t, err)
+				if len(next) <= 0 {
+					break
+				}
+				paged = append(paged, next...)
+				c := next[len(next)-1].Cursor()
+				cursor = &c
+			}
+			require.Exactly(t, list, paged)
+
+			totals, err := withdrawalRepository.GetWithdrawalsTotalAmountPerAsset(
+				ctx, accountName, tt.filter,
+			)
+			require.NoError(t, err)
+			require.Exactly(t, tt.totals, totals)
+		})
+	}
+}
+
+func testGetAllWithdrawalsAfter(t *testing.T, repo withdrawalRepository) {
+	withdrawalRepository := repo.Repository
+	ctx := context.Background()
+	// The records are out of the range of the random timestamps of those added
+	// by the other tests.
+	timestamp := int64(1700000000)
+	filter := domain.TxFilter{StartTime: timestamp, EndTime: timestamp + 10}
+
+	// Withdrawals of different accounts, where groups of them share the same
+	// timestamp to check that ties are broken.
+	withdrawals := makeRandomWithdrawals(20)
+	for i := range withdrawals {
+		withdrawals[i].Timestamp = timestamp + int64(i/4)
+	}
+	_, err := withdrawalRepository.AddWithdrawals(ctx, withdrawals)
+	require.NoError(t, err)
+
+	allWithdrawals, err := withdrawalRepository.GetAllWithdrawalsAfter(
+		ctx, filter, nil, 100,
+	)
+	require.NoError(t, err)
+	require.Len(t, allWithdrawals, 20)
+	for i := 1; i < len(allWithdrawals); i++ {
+		c := allWithdrawals[i-1].AllAccountsCursor()
+		require.True(t, c.Precedes(allWithdrawals[i].AllAccountsCursor()))
+	}
+
+	pagedWithdrawals := make([]domain.Withdrawal, 0)
+	var cursor *domain.Cursor
+	for {
+		list, err := withdrawalRepository.GetAllWithdrawalsAfter(ctx, filter, cursor, 3)
+		require.NoError(t, err)
+		if len(list) <= 0 {
+			break
+		}
+		pagedWithdrawals = append(pagedWithdrawals, list...)
+		c := list[len(list)-1].AllAccountsCursor()
+		cursor = &c
+	}
+	require.Exactly(t, allWithdrawals, pagedWithdrawals)
+}
+
 type withdrawalRepository struct {
 	Name       string
 	DBManager  ports.RepoManager
@@ -92,6 +297,10 @@ func createWithdrawalRepositories(t *testing.T) []withdrawalRepository {
 	inmemoryDBManager := inmemory.NewRepoManager()
 	badgerDBManager, err := dbbadger.NewRepoManager("", nil)
 	require.NoError(t, err)
+	sqliteDBManager, err := dbsql.NewSQLiteRepoManager(
+		filepath.Join(t.TempDir(), dbsql.SQLiteFile),
+	)
+	require.NoError(t, err)
 
 	return []withdrawalRepository{
 		{
@@ -104,5 +313,10 @@ func createWithdrawalRepositories(t *testing.T) []withdrawalRepository {
 			DBManager:  inmemoryDBManager,
 			Repository: inmemoryDBManager.WithdrawalRepository(),
 		},
+		{
+			Name:       "sqlite",
+			DBManager:  sqliteDBManager,
+			Repository: sqliteDBManager.WithdrawalRepository(),
+		},
 	}
 }
@@ -0,0 +1,283 @@
+// Package migration keeps track of the schema version of the daemon's db
+// datadir and upgrades it by running, in order, the registered migrations
+// that have not been applied yet.
+//
+// The version is recorded in a file in the db datadir. A datadir without
+// such file is considered at version BaseVersion if it contains any store,
+// or fresh otherwise, in which case it's directly marked at the latest
+// version.
+// Migrations must run while all stores are closed. Before applying any, a
+// snapshot of the whole db datadir is made to let the operator rollback in
+// case something goes wrong.
+package migration
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+const (
+	// VersionFile is the name of the file, relative to the db datadir,
+	// containing the schema version.
+	VersionFile = "VERSION"
+	// SnapshotsDir is the name of the directory, relative to the datadir,
+	// where the pre-migration snapshots are stored.
+	SnapshotsDir = "snapshots"
+	// BaseVersion is the schema version of any datadir created before the
+	// version was recorded.
+	BaseVersion = uint32(1)
+)
+
+// Migration upgrades the stores of the db datadir to the given version.
+type Migration struct {
+	Version     uint32
+	Description string
+	Migrate     func(dbDir string) error
+}
+
+// Migrator applies the pending migrations to the db datadir.
+type Migrator struct {
+	datadir    string
+	dbDir      string
+	migrations []Migration
+}
+
+// NewMigrator returns a Migrator for the given datadir and migrations, that
+// must have unique versions, all greater than BaseVersion.
+func NewMigrator(
+	datadir, dbDir string, migrations []Migration,
+) (*Migrator, error) {
+	sorted := append([]Migration{}, migrations...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	for i, m := range sorted {
+		if m.Version <= BaseVersion {
+			return nil, fmt.Errorf(
+				"migration version %d must be greater than %d",
+				m.Version, BaseVersion,
+			)
+		}
+		if i > 0 && sorted[i-1].Version == m.Version {
+			return nil, fmt.Errorf("duplicated migration version %d", m.Version)
+		}
+		if m.Migrate == nil {
+			return nil, fmt.Errorf("migration %d is missing handler", m.Version)
+		}
+	}
+	return &Migrator{datadir, dbDir, sorted}, nil
+}
+
+// LatestVersion returns the version of the datadir once all migrations are
+// applied.
+func (m *Migrator) LatestVersion() uint32 {
+	if len(m.migrations) <= 0 {
+		return BaseVersion
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// CurrentVersion returns the schema version of the datadir, or 0 if it's
+// fresh.
+func (m *Migrator) CurrentVersion() (uint32, error) {
+	buf, err := os.ReadFile(filepath.Join(m.dbDir, VersionFile))
+	if err == nil {
+		version, err := strconv.ParseUint(strings.TrimSpace(string(buf)), 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid schema version: %w", err)
+		}
+		return uint32(version), nil
+	}
+	if !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	entries, err := os.ReadDir(m.dbDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if len(entries) > 0 {
+		return BaseVersion, nil
+	}
+	return 0, nil
+}
+
+// Pending returns the migrations not applied yet to the datadir.
+func (m *Migrator) Pending() ([]Migration, error) {
+	current, err := m.CurrentVersion()
+	if err != nil {
+		return nil, err
+	}
+	if current == 0 {
+		return nil, nil
+	}
+	if current > m.LatestVersion() {
+		return nil, fmt.Errorf(
+			"datadir schema version %d is newer than supported %d, "+
+				"please upgrade the daemon",
+			current, m.LatestVersion(),
+		)
+	}
+
+	pending := make([]Migration, 0)
+	for _, mm := range m.migrations {
+		if mm.Version > current {
+			pending = append(pending, mm)
+		}
+	}
+	return pending, nil
+}
+
+// Run applies the pending migrations, if any, and records the schema version
+// of the datadir after each of them. A fresh datadir is directly marked at
+// the latest version. In dry-run mode, the pending migrations are only
+// logged and the datadir is left untouched.
+func (m *Migrator) Run(dryRun bool) error {
+	current, err := m.CurrentVersion()
+	if err != nil {
+		return err
+	}
+	pending, err := m.Pending()
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		if current == 0 {
+			log.Info("datadir is fresh, no migration needed")
+			return nil
+		}
+		if len(pending) <= 0 {
+			log.Infof("datadir schema is up to date (version %d)", current)
+			return nil
+		}
+		for _, mm := range pending {
+			log.Infof("pending migration %d: %s", mm.Version, mm.Description)
+		}
+		return nil
+	}
+
+	if current == 0 {
+		return m.writeVersion(m.LatestVersion())
+	}
+	if len(pending) <= 0 {
+		// Make sure the version is recorded also for base datadirs.
+		return m.writeVersion(current)
+	}
+
+	snapshotDir, err := m.snapshot(current)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot datadir: %w", err)
+	}
+	log.Infof("datadir snapshot saved at %s", snapshotDir)
+
+	for _, mm := range pending {
+		log.Infof("applying migration %d: %s", mm.Version, mm.Description)
+		if err := mm.Migrate(m.dbDir); err != nil {
+			return fmt.Errorf(
+				"migration %d failed, restore the datadir from snapshot %s: %w",
+				mm.Version, snapshotDir, err,
+			)
+		}
+		if err := m.writeVersion(mm.Version); err != nil {
+			return err
+		}
+	}
+	log.Infof("datadir schema migrated to version %d", m.LatestVersion())
+	return nil
+}
+
+// Backup adds the schema version file to the given backup, so that the
+// restored datadir is migrated if restored by a newer daemon.
+func (m *Migrator) Backup(w ports.BackupWriter) error {
+	f, err := os.Open(filepath.Join(m.dbDir, VersionFile))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return w.WriteEntry(
+		VersionFile, ports.BackupEntryFile, func(w io.Writer) error {
+			_, err := io.Copy(w, f)
+			return err
+		},
+	)
+}
+
+func (m *Migrator) writeVersion(version uint32) error {
+	if err := os.MkdirAll(m.dbDir, 0700); err != nil {
+		return err
+	}
+	path := filepath.Join(m.dbDir, VersionFile)
+	tmpPath := path + ".tmp"
+	data := []byte(strconv.FormatUint(uint64(version), 10) + "\n")
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// snapshot copies the whole db datadir to a new folder in the snapshots dir.
+func (m *Migrator) snapshot(version uint32) (string, error) {
+	snapshotDir := filepath.Join(
+		m.datadir, SnapshotsDir,
+		fmt.Sprintf("v%d-%d", version, time.Now().Unix()),
+	)
+	if err := copyDir(m.dbDir, snapshotDir); err != nil {
+		os.RemoveAll(snapshotDir)
+		return "", err
+	}
+	return snapshotDir, nil
+}
+
+func copyDir(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, relPath)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0700)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
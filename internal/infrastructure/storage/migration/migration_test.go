@@ -0,0 +1,183 @@
+package migration_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/migration"
+)
+
+var (
+	key   = []byte("bh_Market:key")
+	value = []byte("value")
+)
+
+func TestMigrator(t *testing.T) {
+	migrations := []migration.Migration{
+		{
+			Version:     3,
+			Description: "uppercase values",
+			Migrate: func(dbDir string) error {
+				return migration.UpdateBadgerStore(
+					dbDir, migration.MarketsStore, func(txn *badger.Txn) error {
+						item, err := txn.Get(key)
+						if err != nil {
+							return err
+						}
+						v, err := item.ValueCopy(nil)
+						if err != nil {
+							return err
+						}
+						return txn.Set(key, []byte(strings.ToUpper(string(v))))
+					},
+				)
+			},
+		},
+		{
+			Version:     2,
+			Description: "add values",
+			Migrate: func(dbDir string) error {
+				return migration.UpdateBadgerStore(
+					dbDir, migration.MarketsStore, func(txn *badger.Txn) error {
+						return txn.Set(key, value)
+					},
+				)
+			},
+		},
+	}
+
+	t.Run("fresh", func(t *testing.T) {
+		datadir, dbDir := newDatadir(t)
+		migrator, err := migration.NewMigrator(datadir, dbDir, migrations)
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), migrator.LatestVersion())
+
+		require.NoError(t, migrator.Run(true))
+		_, err = os.Stat(filepath.Join(dbDir, migration.VersionFile))
+		require.True(t, os.IsNotExist(err))
+
+		require.NoError(t, migrator.Run(false))
+		version, err := migrator.CurrentVersion()
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), version)
+
+		_, err = os.Stat(filepath.Join(datadir, migration.SnapshotsDir))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("base", func(t *testing.T) {
+		datadir, dbDir := newDatadir(t)
+		createBadgerStore(t, filepath.Join(dbDir, migration.MarketsStore))
+
+		migrator, err := migration.NewMigrator(datadir, dbDir, migrations)
+		require.NoError(t, err)
+
+		pending, err := migrator.Pending()
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, uint32(2), pending[0].Version)
+
+		// Dry-run must leave the datadir untouched.
+		require.NoError(t, migrator.Run(true))
+		version, err := migrator.CurrentVersion()
+		require.NoError(t, err)
+		require.Equal(t, migration.BaseVersion, version)
+
+		require.NoError(t, migrator.Run(false))
+		version, err = migrator.CurrentVersion()
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), version)
+		require.Equal(
+			t, "VALUE", readValue(t, filepath.Join(dbDir, migration.MarketsStore)),
+		)
+
+		snapshots, err := os.ReadDir(filepath.Join(datadir, migration.SnapshotsDir))
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		snapshotDir := filepath.Join(
+			datadir, migration.SnapshotsDir, snapshots[0].Name(),
+			migration.MarketsStore,
+		)
+		require.Empty(t, readValue(t, snapshotDir))
+
+		pending, err = migrator.Pending()
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		datadir, dbDir := newDatadir(t)
+		createBadgerStore(t, filepath.Join(dbDir, migration.MarketsStore))
+
+		failing := append(migrations, migration.Migration{
+			Version: 4,
+			Migrate: func(string) error { return fmt.Errorf("failed") },
+		})
+		migrator, err := migration.NewMigrator(datadir, dbDir, failing)
+		require.NoError(t, err)
+
+		require.Error(t, migrator.Run(false))
+		version, err := migrator.CurrentVersion()
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), version)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		datadir, dbDir := newDatadir(t)
+		for _, m := range [][]migration.Migration{
+			{{Version: migration.BaseVersion, Migrate: migrations[0].Migrate}},
+			{migrations[0], migrations[0]},
+			{{Version: 2}},
+		} {
+			_, err := migration.NewMigrator(datadir, dbDir, m)
+			require.Error(t, err)
+		}
+
+		// A datadir newer than the daemon can't be used.
+		migrator, err := migration.NewMigrator(datadir, dbDir, migrations[1:])
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(
+			filepath.Join(dbDir, migration.VersionFile), []byte("3\n"), 0600,
+		))
+		require.Error(t, migrator.Run(false))
+	})
+}
+
+func newDatadir(t *testing.T) (string, string) {
+	datadir := t.TempDir()
+	dbDir := filepath.Join(datadir, "db")
+	require.NoError(t, os.Mkdir(dbDir, 0700))
+	return datadir, dbDir
+}
+
+func createBadgerStore(t *testing.T, dir string) {
+	db, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
+
+func readValue(t *testing.T, dir string) string {
+	db, err := badger.Open(badger.DefaultOptions(dir).WithLogger(nil))
+	require.NoError(t, err)
+	defer db.Close()
+
+	var v []byte
+	err = db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return nil
+			}
+			return err
+		}
+		v, err = item.ValueCopy(nil)
+		return err
+	})
+	require.NoError(t, err)
+	return string(v)
+}
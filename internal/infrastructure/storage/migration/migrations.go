@@ -0,0 +1,22 @@
+package migration
+
+import (
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+)
+
+// Migrations is the ordered list of migrations of the db datadir.
+// Any change to the domain types that breaks the decoding of the records of
+// an existing badger store, or to the layout of the datadir, must come with
+// a new migration appended here, with version incremented by 1.
+var Migrations = []Migration{
+	{
+		// The indexes are maintained by the badger repositories, that are
+		// therefore in charge of building them for the existing records.
+		Version: 2,
+		Description: "build the cursor indexes of trades, deposits, " +
+			"withdrawals and balance snapshots",
+		Migrate: func(dbDir string) error {
+			return dbbadger.BuildCursorIndexes(dbDir, nil)
+		},
+	},
+}
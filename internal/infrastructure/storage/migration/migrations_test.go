@@ -0,0 +1,53 @@
+package migration_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	dbbadger "github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/db/badger"
+	"github.com/tdex-network/tdex-daemon/internal/infrastructure/storage/migration"
+	"github.com/timshannon/badgerhold/v4"
+)
+
+func TestBuildCursorIndexesMigration(t *testing.T) {
+	datadir, dbDir := newDatadir(t)
+
+	// A trade stored before the introduction of the cursor indexes.
+	trade := domain.Trade{
+		Id:          "trade",
+		MarketName:  "market",
+		SwapRequest: &domain.Swap{Id: "swap", Timestamp: 1000},
+	}
+	opts := badger.DefaultOptions(filepath.Join(dbDir, migration.TradesStore))
+	opts.Logger = nil
+	store, err := badgerhold.Open(badgerhold.Options{
+		Encoder: badgerhold.DefaultEncode,
+		Decoder: badgerhold.DefaultDecode,
+		Options: opts,
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.Insert(trade.Id, &trade))
+	require.NoError(t, store.Close())
+
+	migrator, err := migration.NewMigrator(datadir, dbDir, migration.Migrations)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Run(false))
+	version, err := migrator.CurrentVersion()
+	require.NoError(t, err)
+	require.Equal(t, migrator.LatestVersion(), version)
+
+	repoManager, err := dbbadger.NewRepoManager(dbDir, nil)
+	require.NoError(t, err)
+	defer repoManager.Close()
+
+	trades, err := repoManager.TradeRepository().GetTradesByMarketAfter(
+		context.Background(), trade.MarketName, nil, 10,
+	)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	require.Equal(t, trade.Id, trades[0].Id)
+}
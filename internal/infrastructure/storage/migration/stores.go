@@ -0,0 +1,72 @@
+package migration
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/badger/v3/options"
+	"github.com/tdex-network/tdex-daemon/pkg/securestore/kvdb"
+)
+
+// Paths of the stores, relative to the db datadir. Migrations must refer to
+// these rather than to those of the infrastructure packages, since they're
+// bound to the layout of the datadir at the time they're written.
+const (
+	MarketsStore      = "markets"
+	PricesStore       = "prices"
+	TradesStore       = "trades"
+	TransactionsStore = "transactions"
+	BalancesStore     = "balances"
+	FeederStore       = "feeder"
+	PubSubStore       = "pubsub.db"
+)
+
+// UpdateBadgerStore opens the badger store at the given path, relative to
+// the db datadir, and runs fn in a read-write transaction. Badgerhold stores
+// its records with keys prefixed by "bh_<TypeName>:", and values gob-encoded,
+// therefore fn is expected to work on raw keys and values and to decode them
+// with copies of the domain types as they were at the time of the migration.
+// It's a no-op if the store doesn't exist.
+func UpdateBadgerStore(
+	dbDir, store string, fn func(txn *badger.Txn) error,
+) error {
+	dir := filepath.Join(dbDir, store)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	opts := badger.DefaultOptions(dir)
+	opts.Logger = nil
+	opts.Compression = options.ZSTD
+
+	db, err := badger.Open(opts)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(fn)
+}
+
+// UpdateBoltStore opens the bolt store at the given path, relative to the db
+// datadir, and runs fn in a read-write transaction.
+// It's a no-op if the store doesn't exist.
+func UpdateBoltStore(
+	dbDir, store string, fn func(tx kvdb.RwTx) error,
+) error {
+	path := filepath.Join(dbDir, store)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	db, err := kvdb.Open(
+		kvdb.BoltBackendName, path, true, kvdb.DefaultDBTimeout,
+	)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return kvdb.Update(db, fn, func() {})
+}
@@ -0,0 +1,86 @@
+package tradearchive
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+)
+
+// ArchiveFile is the name of the archive file, relative to the archive dir.
+const ArchiveFile = "trades.jsonl.gz"
+
+type fileArchive struct {
+	path string
+	lock *sync.Mutex
+}
+
+// NewFileArchive returns a TradeArchive that appends the trades, one JSON
+// object per line, to a gzip file in the given directory. Every call to
+// Archive adds a new gzip member to the file, therefore it can be read with
+// any gzip reader supporting multistream, like zcat.
+func NewFileArchive(dir string) (ports.TradeArchive, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &fileArchive{filepath.Join(dir, ArchiveFile), &sync.Mutex{}}, nil
+}
+
+func (a *fileArchive) Archive(trades []domain.Trade) error {
+	if len(trades) <= 0 {
+		return nil
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	f, err := os.OpenFile(a.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := gzip.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, trade := range trades {
+		if err := enc.Encode(trade); err != nil {
+			return fmt.Errorf("failed to encode trade %s: %w", trade.Id, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func (a *fileArchive) Location() string {
+	return a.path
+}
+
+// Backup adds the archive file, if any, to the given backup.
+func (a *fileArchive) Backup(w ports.BackupWriter) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	f, err := os.Open(a.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	return w.WriteEntry(
+		ArchiveFile, ports.BackupEntryArchive, func(w io.Writer) error {
+			_, err := io.Copy(w, f)
+			return err
+		},
+	)
+}
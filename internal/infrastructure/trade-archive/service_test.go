@@ -0,0 +1,80 @@
+package tradearchive_test
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	tradearchive "github.com/tdex-network/tdex-daemon/internal/infrastructure/trade-archive"
+)
+
+func TestFileArchive(t *testing.T) {
+	archive, err := tradearchive.NewFileArchive(t.TempDir())
+	require.NoError(t, err)
+
+	trades := []domain.Trade{*domain.NewTrade(), *domain.NewTrade()}
+	trades[1].PsetBase64 = "cHNldP8="
+	trades[1].SwapRequest = &domain.Swap{Id: "id", Message: []byte{1, 2}}
+
+	require.NoError(t, archive.Archive(trades[:1]))
+	require.NoError(t, archive.Archive(trades[1:]))
+	require.NoError(t, archive.Archive(nil))
+
+	f, err := os.Open(archive.Location())
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+
+	archived := make([]domain.Trade, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var trade domain.Trade
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &trade))
+		archived = append(archived, trade)
+	}
+	require.NoError(t, scanner.Err())
+	require.Exactly(t, trades, archived)
+}
+
+func TestFileArchiveBackup(t *testing.T) {
+	archive, err := tradearchive.NewFileArchive(t.TempDir())
+	require.NoError(t, err)
+	backupable, ok := archive.(ports.Backupable)
+	require.True(t, ok)
+
+	w := &backupWriter{entries: make(map[string][]byte)}
+	require.NoError(t, backupable.Backup(w))
+	require.Empty(t, w.entries)
+
+	require.NoError(t, archive.Archive([]domain.Trade{*domain.NewTrade()}))
+	require.NoError(t, backupable.Backup(w))
+
+	buf, err := os.ReadFile(archive.Location())
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{
+		tradearchive.ArchiveFile + ":" + ports.BackupEntryArchive: buf,
+	}, w.entries)
+}
+
+type backupWriter struct {
+	entries map[string][]byte
+}
+
+func (w *backupWriter) WriteEntry(
+	name, kind string, fn func(w io.Writer) error,
+) error {
+	buf := &bytes.Buffer{}
+	if err := fn(buf); err != nil {
+		return err
+	}
+	w.entries[name+":"+kind] = buf.Bytes()
+	return nil
+}
@@ -0,0 +1,65 @@
+package grpchandler
+
+import (
+	"context"
+
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type dryRunHandler struct {
+	tradeSvc application.TradeService
+}
+
+func NewDryRunHandler(
+	tradeSvc application.TradeService,
+) daemonv2.DryRunServiceServer {
+	return newDryRunHandler(tradeSvc)
+}
+
+func newDryRunHandler(tradeSvc application.TradeService) *dryRunHandler {
+	return &dryRunHandler{
+		tradeSvc: tradeSvc,
+	}
+}
+
+func (h dryRunHandler) ProposeTrade(
+	ctx context.Context, req *daemonv2.ProposeTradeDryRunRequest,
+) (*daemonv2.ProposeTradeDryRunResponse, error) {
+	return h.proposeTrade(ctx, req)
+}
+
+func (h dryRunHandler) proposeTrade(
+	ctx context.Context, req *daemonv2.ProposeTradeDryRunRequest,
+) (*daemonv2.ProposeTradeDryRunResponse, error) {
+	market, err := parseMarket(req.GetMarket())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tradeType, err := parseTradeType(req.GetType())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	swapRequest, err := parseSwapRequest(
+		req.GetSwapRequest(), req.GetFeeAsset(), req.GetFeeAmount(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := h.tradeSvc.TradeProposeDryRun(
+		ctx, market, tradeType, swapRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.ProposeTradeDryRunResponse{
+		Accepted:         result.IsAccepted(),
+		Reason:           result.GetReason(),
+		SwapFail:         swapFailInfo{result.GetSwapFail()}.toProto(),
+		NetworkFeeAmount: result.GetNetworkFeeAmount(),
+	}, nil
+}
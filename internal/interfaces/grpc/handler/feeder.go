@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/core/ports"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -29,14 +30,61 @@ func (f *feederHandler) AddPriceFeed(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	sources, err := parsePriceSources(req.GetSources())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	aggregation, err := parsePriceAggregation(req.GetAggregation())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	legs, err := parsePriceLegs(req.GetLegs())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	transformation, err := parsePriceTransformation(req.GetTransformation())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	isComposite := sources != nil || aggregation != nil
+	if isComposite && legs != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"a price feed can't be both composite and derived",
+		)
+	}
+	if legs == nil && req.GetLegsMaxAge() > 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "legs max age requires price legs",
+		)
+	}
 
-	id, err := f.feederSvc.AddPriceFeed(
-		ctx, mkt, req.GetSource(), req.GetTicker(),
-	)
+	var id string
+	if isComposite {
+		id, err = f.feederSvc.AddCompositePriceFeed(
+			ctx, mkt, sources, aggregation,
+		)
+	} else if legs != nil {
+		id, err = f.feederSvc.AddDerivedPriceFeed(
+			ctx, mkt, legs, req.GetLegsMaxAge(),
+		)
+	} else {
+		id, err = f.feederSvc.AddPriceFeed(
+			ctx, mkt, req.GetSource(), req.GetTicker(),
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if transformation != nil {
+		if err := f.feederSvc.UpdatePriceFeedTransformation(
+			ctx, id, transformation,
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	return &daemonv2.AddPriceFeedResponse{
 		Id: id,
 	}, nil
@@ -79,11 +127,67 @@ func (f *feederHandler) UpdatePriceFeed(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	if req.GetSource() == "" && req.GetTicker() == "" {
+	sources, err := parsePriceSources(req.GetSources())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	aggregation, err := parsePriceAggregation(req.GetAggregation())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	legs, err := parsePriceLegs(req.GetLegs())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	transformation, err := parsePriceTransformation(req.GetTransformation())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	isComposite := sources != nil || aggregation != nil
+	isPlain := req.GetSource() != "" || req.GetTicker() != ""
+	isDerived := legs != nil || req.GetLegsMaxAge() > 0
+	if (isComposite && isDerived) || (isPlain && (isComposite || isDerived)) {
 		return nil, status.Error(
-			codes.InvalidArgument, "missing source and/or ticker",
+			codes.InvalidArgument,
+			"source and ticker, sources and aggregation, and legs are mutually exclusive",
 		)
 	}
+	if !isComposite && !isPlain && !isDerived && transformation == nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"missing source and/or ticker, sources, legs or transformation",
+		)
+	}
+
+	if transformation != nil {
+		if err := f.feederSvc.UpdatePriceFeedTransformation(
+			ctx, id, transformation,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if isDerived {
+		if err := f.feederSvc.UpdateDerivedPriceFeed(
+			ctx, id, legs, req.GetLegsMaxAge(),
+		); err != nil {
+			return nil, err
+		}
+		return &daemonv2.UpdatePriceFeedResponse{}, nil
+	}
+
+	if isComposite {
+		if err := f.feederSvc.UpdateCompositePriceFeed(
+			ctx, id, sources, aggregation,
+		); err != nil {
+			return nil, err
+		}
+		return &daemonv2.UpdatePriceFeedResponse{}, nil
+	}
+
+	if !isPlain {
+		return &daemonv2.UpdatePriceFeedResponse{}, nil
+	}
 
 	if err := f.feederSvc.UpdatePriceFeed(
 		ctx, id, req.GetSource(), req.GetTicker(),
@@ -94,6 +198,25 @@ func (f *feederHandler) UpdatePriceFeed(
 	return &daemonv2.UpdatePriceFeedResponse{}, nil
 }
 
+func (f *feederHandler) PushPrice(
+	ctx context.Context, req *daemonv2.PushPriceRequest,
+) (*daemonv2.PushPriceResponse, error) {
+	id, err := parseId(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	price, err := parsePushedPrice(req.GetPrice())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := f.feederSvc.PushPrice(ctx, id, price); err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.PushPriceResponse{}, nil
+}
+
 func (f *feederHandler) RemovePriceFeed(
 	ctx context.Context, req *daemonv2.RemovePriceFeedRequest,
 ) (*daemonv2.RemovePriceFeedResponse, error) {
@@ -127,6 +250,44 @@ func (f *feederHandler) GetPriceFeed(
 	}, nil
 }
 
+func (f *feederHandler) ListPriceTicks(
+	ctx context.Context, req *daemonv2.ListPriceTicksRequest,
+) (*daemonv2.ListPriceTicksResponse, error) {
+	id, err := parseId(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tickType, err := parsePriceTickType(req.GetType())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var timeRange ports.TimeRange
+	if req.GetTimeRange() != nil {
+		timeRange, err = parseTimeRange(req.GetTimeRange())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	page, err := parsePage(req.GetPage())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if page != nil && page.GetToken() != "" {
+		return nil, status.Error(
+			codes.InvalidArgument, "page tokens are not supported",
+		)
+	}
+
+	ticks, err := f.feederSvc.ListPriceTicks(ctx, id, tickType, timeRange, page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.ListPriceTicksResponse{
+		Ticks: priceTicksInfo(ticks).toProto(),
+	}, nil
+}
+
 func (f *feederHandler) ListSupportedPriceSources(
 	ctx context.Context, _ *daemonv2.ListSupportedPriceSourcesRequest,
 ) (*daemonv2.ListSupportedPriceSourcesResponse, error) {
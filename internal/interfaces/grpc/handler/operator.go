@@ -2,6 +2,7 @@ package grpchandler
 
 import (
 	"context"
+	"time"
 
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
@@ -126,6 +127,12 @@ func (o operatorHandler) UpdateMarketAssetsPrecision(
 	return o.updateMarketAssetsPrecision(ctx, req)
 }
 
+func (h *operatorHandler) UpdateMarketTradeExpiryTime(
+	ctx context.Context, req *daemonv2.UpdateMarketTradeExpiryTimeRequest,
+) (*daemonv2.UpdateMarketTradeExpiryTimeResponse, error) {
+	return h.updateMarketTradeExpiryTime(ctx, req)
+}
+
 func (h *operatorHandler) UpdateMarketPrice(
 	ctx context.Context, req *daemonv2.UpdateMarketPriceRequest,
 ) (*daemonv2.UpdateMarketPriceResponse, error) {
@@ -232,6 +239,43 @@ func (h *operatorHandler) ListWithdrawals(
 	return h.listWithdrawals(ctx, req)
 }
 
+func (h *operatorHandler) ExportLedger(
+	req *daemonv2.ExportLedgerRequest,
+	stream daemonv2.OperatorService_ExportLedgerServer,
+) error {
+	return h.exportLedger(req, stream)
+}
+
+func (h *operatorHandler) Backup(
+	req *daemonv2.BackupRequest, stream daemonv2.OperatorService_BackupServer,
+) error {
+	return h.backup(req, stream)
+}
+
+func (h *operatorHandler) ArchiveTrades(
+	ctx context.Context, req *daemonv2.ArchiveTradesRequest,
+) (*daemonv2.ArchiveTradesResponse, error) {
+	return h.archiveTrades(ctx, req)
+}
+
+func (h *operatorHandler) GetBalanceHistory(
+	ctx context.Context, req *daemonv2.GetBalanceHistoryRequest,
+) (*daemonv2.GetBalanceHistoryResponse, error) {
+	return h.getBalanceHistory(ctx, req)
+}
+
+func (h *operatorHandler) GetDBStats(
+	ctx context.Context, req *daemonv2.GetDBStatsRequest,
+) (*daemonv2.GetDBStatsResponse, error) {
+	return h.getDBStats(ctx, req)
+}
+
+func (h *operatorHandler) RunDBGC(
+	ctx context.Context, req *daemonv2.RunDBGCRequest,
+) (*daemonv2.RunDBGCResponse, error) {
+	return h.runDBGC(ctx, req)
+}
+
 func (h *operatorHandler) deriveFeeAddresses(
 	ctx context.Context, req *daemonv2.DeriveFeeAddressesRequest,
 ) (*daemonv2.DeriveFeeAddressesResponse, error) {
@@ -579,6 +623,23 @@ func (o operatorHandler) updateMarketAssetsPrecision(
 	return &daemonv2.UpdateMarketAssetsPrecisionResponse{}, nil
 }
 
+func (h *operatorHandler) updateMarketTradeExpiryTime(
+	ctx context.Context, req *daemonv2.UpdateMarketTradeExpiryTimeRequest,
+) (*daemonv2.UpdateMarketTradeExpiryTimeResponse, error) {
+	market, err := parseMarket(req.GetMarket())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.operatorSvc.UpdateMarketTradeExpiryTime(
+		ctx, market, int64(req.GetTradeExpiryTime()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.UpdateMarketTradeExpiryTimeResponse{}, nil
+}
+
 func (h *operatorHandler) updateMarketPrice(
 	ctx context.Context, req *daemonv2.UpdateMarketPriceRequest,
 ) (*daemonv2.UpdateMarketPriceResponse, error) {
@@ -847,13 +908,16 @@ func (h *operatorHandler) listTrades(
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	trades, err := h.operatorSvc.ListTradesForMarket(ctx, market, page)
+	trades, nextPageToken, err := h.operatorSvc.ListTradesForMarket(
+		ctx, market, page,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &daemonv2.ListTradesResponse{
-		Trades: tradesInfo(trades).toProto(req.GetWithHex()),
+		Trades:        tradesInfo(trades).toProto(req.GetWithHex()),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -884,9 +948,8 @@ func (h *operatorHandler) listUtxos(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	spendableUtxosInfo, lockedUtxosInfo, err := h.operatorSvc.ListUtxos(
-		ctx, accountName, page,
-	)
+	spendableUtxosInfo, lockedUtxosInfo, nextPageToken, err :=
+		h.operatorSvc.ListUtxos(ctx, accountName, page)
 	if err != nil {
 		return nil, err
 	}
@@ -894,6 +957,7 @@ func (h *operatorHandler) listUtxos(
 	return &daemonv2.ListUtxosResponse{
 		SpendableUtxos: utxosInfo(spendableUtxosInfo).toProto(),
 		LockedUtxos:    utxosInfo(lockedUtxosInfo).toProto(),
+		NextPageToken:  nextPageToken,
 	}, nil
 }
 
@@ -908,17 +972,25 @@ func (h *operatorHandler) listDeposits(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	filter, err := parseTxFilter(
+		req.GetAsset(), req.GetTxid(), req.GetTimeRange(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	deposits, err := h.operatorSvc.ListDeposits(
-		ctx, accountName, page,
+	deposits, totals, nextPageToken, err := h.operatorSvc.ListDeposits(
+		ctx, accountName, filter, page,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &daemonv2.ListDepositsResponse{
-		AccountName: accountName,
-		Deposits:    depositsInfo(deposits).toProto(),
+		AccountName:         accountName,
+		Deposits:            depositsInfo(deposits).toProto(),
+		NextPageToken:       nextPageToken,
+		TotalAmountPerAsset: totals,
 	}, err
 }
 
@@ -933,13 +1005,137 @@ func (h *operatorHandler) listWithdrawals(
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	filter, err := parseTxFilter(
+		req.GetAsset(), req.GetTxid(), req.GetTimeRange(),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	withdrawals, err := h.operatorSvc.ListWithdrawals(
-		ctx, accountName, page,
+	withdrawals, totals, nextPageToken, err := h.operatorSvc.ListWithdrawals(
+		ctx, accountName, filter, page,
 	)
 
 	return &daemonv2.ListWithdrawalsResponse{
-		AccountName: accountName,
-		Withdrawals: withdrawalsInfo(withdrawals).toProto(),
+		AccountName:         accountName,
+		Withdrawals:         withdrawalsInfo(withdrawals).toProto(),
+		NextPageToken:       nextPageToken,
+		TotalAmountPerAsset: totals,
 	}, err
 }
+
+func (h *operatorHandler) exportLedger(
+	req *daemonv2.ExportLedgerRequest,
+	stream daemonv2.OperatorService_ExportLedgerServer,
+) error {
+	timeRange, err := parseTimeRange(req.GetTimeRange())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return h.operatorSvc.ExportLedger(
+		stream.Context(), timeRange, func(entry ports.LedgerEntry) error {
+			return stream.Send(&daemonv2.ExportLedgerResponse{
+				Entry: ledgerEntryInfo{entry}.toProto(req.GetWithHex()),
+			})
+		},
+	)
+}
+
+func (h *operatorHandler) backup(
+	req *daemonv2.BackupRequest, stream daemonv2.OperatorService_BackupServer,
+) error {
+	password, err := parsePassword(req.GetPassword())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return h.operatorSvc.Backup(
+		stream.Context(), password, backupStreamWriter{stream},
+	)
+}
+
+func (h *operatorHandler) archiveTrades(
+	ctx context.Context, req *daemonv2.ArchiveTradesRequest,
+) (*daemonv2.ArchiveTradesResponse, error) {
+	retention := time.Duration(req.GetRetentionDays()) * 24 * time.Hour
+	count, location, err := h.operatorSvc.ArchiveTrades(ctx, retention)
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.ArchiveTradesResponse{
+		NumArchivedTrades: uint64(count),
+		ArchiveLocation:   location,
+	}, nil
+}
+
+func (h *operatorHandler) getBalanceHistory(
+	ctx context.Context, req *daemonv2.GetBalanceHistoryRequest,
+) (*daemonv2.GetBalanceHistoryResponse, error) {
+	var timeRange ports.TimeRange
+	if req.GetTimeRange() != nil {
+		tr, err := parseTimeRange(req.GetTimeRange())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		timeRange = tr
+	}
+	page, err := parsePage(req.GetPage())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	snapshots, nextPageToken, err := h.operatorSvc.GetBalanceHistory(
+		ctx, req.GetAccountName(), timeRange, page,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.GetBalanceHistoryResponse{
+		Snapshots:     balanceSnapshotsInfo(snapshots).toProto(),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *operatorHandler) getDBStats(
+	ctx context.Context, _ *daemonv2.GetDBStatsRequest,
+) (*daemonv2.GetDBStatsResponse, error) {
+	stats, err := h.operatorSvc.GetDBStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.GetDBStatsResponse{
+		Stores: storesStatsInfo(stats).toProto(),
+	}, nil
+}
+
+func (h *operatorHandler) runDBGC(
+	ctx context.Context, req *daemonv2.RunDBGCRequest,
+) (*daemonv2.RunDBGCResponse, error) {
+	stats, err := h.operatorSvc.RunDBGC(ctx, req.GetCompact())
+	if err != nil {
+		return nil, err
+	}
+
+	return &daemonv2.RunDBGCResponse{
+		Stores: storesStatsInfo(stats).toProto(),
+	}, nil
+}
+
+// backupStreamWriter sends every chunk of the backup to the client as soon as
+// it's written.
+type backupStreamWriter struct {
+	stream daemonv2.OperatorService_BackupServer
+}
+
+func (w backupStreamWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+	if err := w.stream.Send(&daemonv2.BackupResponse{Chunk: chunk}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -7,6 +7,7 @@ import (
 	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
 	"github.com/tdex-network/tdex-daemon/internal/core/application"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -223,8 +224,14 @@ func (t tradeHandler) proposeTrade(
 		ctx, market, tradeType, swapRequest,
 	)
 	if err != nil {
+		if err == application.ErrSwapRequestIdInUse {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
+	if isRateLimited(fail) {
+		return nil, rateLimitedStatus(fail)
+	}
 
 	return &tdexv2.ProposeTradeResponse{
 		SwapAccept:     swapAcceptInfo{accept}.toProto(),
@@ -250,6 +257,9 @@ func (t tradeHandler) completeTrade(
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if isRateLimited(fail) {
+		return nil, rateLimitedStatus(fail)
+	}
 
 	var swapFailStub *tdexv2.SwapFail
 	if fail != nil {
@@ -266,3 +276,24 @@ func (t tradeHandler) completeTrade(
 		SwapFail: swapFailStub,
 	}, nil
 }
+
+func isRateLimited(fail ports.SwapFail) bool {
+	if fail == nil {
+		return false
+	}
+	code := int(fail.GetFailureCode())
+	return code == pkgswap.ErrCodeTooManyRequests ||
+		code == pkgswap.ErrCodeTooManyPendingTrades
+}
+
+// rateLimitedStatus returns a ResourceExhausted error status with the given
+// SwapFail message attached as detail.
+func rateLimitedStatus(fail ports.SwapFail) error {
+	st := status.New(codes.ResourceExhausted, fail.GetFailureMessage())
+	if stWithDetails, err := st.WithDetails(
+		swapFailInfo{fail}.toProto(),
+	); err == nil {
+		st = stWithDetails
+	}
+	return st.Err()
+}
@@ -0,0 +1,54 @@
+package grpchandler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
+	"github.com/tdex-network/tdex-daemon/internal/core/domain"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimitedStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          int
+		isRateLimited bool
+	}{
+		{"too many requests", pkgswap.ErrCodeTooManyRequests, true},
+		{"too many pending trades", pkgswap.ErrCodeTooManyPendingTrades, true},
+		{"bad pricing", pkgswap.ErrCodeBadPricingSwapRequest, false},
+		{"rejected", pkgswap.ErrCodeRejectedSwapRequest, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fail := &domain.SwapFail{
+				Id:             "failid",
+				MessageId:      "requestid",
+				FailureCode:    uint32(tt.code),
+				FailureMessage: "failure message",
+			}
+			require.Equal(t, tt.isRateLimited, isRateLimited(fail))
+			if !tt.isRateLimited {
+				return
+			}
+
+			st, ok := status.FromError(rateLimitedStatus(fail))
+			require.True(t, ok)
+			require.Equal(t, codes.ResourceExhausted, st.Code())
+			require.Equal(t, fail.FailureMessage, st.Message())
+			require.Len(t, st.Details(), 1)
+
+			detail, ok := st.Details()[0].(*tdexv2.SwapFail)
+			require.True(t, ok)
+			require.Equal(t, fail.Id, detail.GetId())
+			require.Equal(t, fail.MessageId, detail.GetMessageId())
+			require.Equal(t, fail.FailureCode, detail.GetFailureCode())
+		})
+	}
+
+	require.False(t, isRateLimited(nil))
+}
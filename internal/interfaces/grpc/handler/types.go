@@ -3,6 +3,7 @@ package grpchandler
 import (
 	"time"
 
+	"github.com/shopspring/decimal"
 	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
 	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
 	"github.com/tdex-network/tdex-daemon/internal/core/ports"
@@ -39,6 +40,7 @@ func (i marketInfo) toProto() *daemonv2.MarketInfo {
 		Balance:             balance,
 		BaseAssetPrecision:  info.GetBaseAssetPrecision(),
 		QuoteAssetPrecision: info.GetQuoteAssetPrecision(),
+		TradeExpiryTime:     info.GetTradeExpiryTime(),
 		Fee: marketFeeInfo{
 			info.GetPercentageFee(), info.GetFixedFee(),
 		}.toProto(),
@@ -63,6 +65,19 @@ func (i marketFeeInfo) toProto() *tdexv2.Fee {
 	}
 }
 
+type pushedPrice struct {
+	basePrice  decimal.Decimal
+	quotePrice decimal.Decimal
+}
+
+func (p pushedPrice) GetBasePrice() decimal.Decimal {
+	return p.basePrice
+}
+
+func (p pushedPrice) GetQuotePrice() decimal.Decimal {
+	return p.quotePrice
+}
+
 type marketPriceInfo struct {
 	ports.MarketPrice
 }
@@ -382,6 +397,12 @@ func (i webhooksInfo) toProto() []*daemonv2.WebhookInfo {
 			event = daemonv2.WebhookEvent_WEBHOOK_EVENT_ACCOUNT_WITHDRAW
 		case info.GetEvent().IsAccountDeposit():
 			event = daemonv2.WebhookEvent_WEBHOOK_EVENT_ACCOUNT_DEPOSIT
+		case info.GetEvent().IsTradeUpdated():
+			event = daemonv2.WebhookEvent_WEBHOOK_EVENT_TRADE_UPDATED
+		case info.GetEvent().IsMarketUpdated():
+			event = daemonv2.WebhookEvent_WEBHOOK_EVENT_MARKET_UPDATED
+		case info.GetEvent().IsPriceFeedDeviation():
+			event = daemonv2.WebhookEvent_WEBHOOK_EVENT_PRICE_FEED_DEVIATION
 		case info.GetEvent().IsAny():
 			event = daemonv2.WebhookEvent_WEBHOOK_EVENT_ANY
 		}
@@ -425,6 +446,15 @@ func (i webhookEventInfo) IsAccountWithdraw() bool {
 func (i webhookEventInfo) IsAccountDeposit() bool {
 	return i.WebhookEvent == daemonv2.WebhookEvent_WEBHOOK_EVENT_ACCOUNT_DEPOSIT
 }
+func (i webhookEventInfo) IsTradeUpdated() bool {
+	return i.WebhookEvent == daemonv2.WebhookEvent_WEBHOOK_EVENT_TRADE_UPDATED
+}
+func (i webhookEventInfo) IsMarketUpdated() bool {
+	return i.WebhookEvent == daemonv2.WebhookEvent_WEBHOOK_EVENT_MARKET_UPDATED
+}
+func (i webhookEventInfo) IsPriceFeedDeviation() bool {
+	return i.WebhookEvent == daemonv2.WebhookEvent_WEBHOOK_EVENT_PRICE_FEED_DEVIATION
+}
 func (i webhookEventInfo) IsAny() bool {
 	return i.WebhookEvent == daemonv2.WebhookEvent_WEBHOOK_EVENT_ANY
 }
@@ -444,6 +474,41 @@ func (i depositsInfo) toProto() []*daemonv2.Transaction {
 	return list
 }
 
+type balanceSnapshotsInfo []ports.BalanceSnapshot
+
+func (i balanceSnapshotsInfo) toProto() []*daemonv2.BalanceSnapshot {
+	list := make([]*daemonv2.BalanceSnapshot, 0, len(i))
+	for _, info := range i {
+		balance := make(map[string]*daemonv2.Balance)
+		for asset, bal := range info.GetBalance() {
+			balance[asset] = marketBalanceInfo{bal}.toProto()
+		}
+		list = append(list, &daemonv2.BalanceSnapshot{
+			AccountName: info.GetAccountName(),
+			Balance:     balance,
+			Timestamp:   info.GetTimestamp(),
+			Date:        timestampToString(info.GetTimestamp()),
+		})
+	}
+	return list
+}
+
+type storesStatsInfo []ports.StoreStats
+
+func (i storesStatsInfo) toProto() []*daemonv2.StoreStats {
+	list := make([]*daemonv2.StoreStats, 0, len(i))
+	for _, info := range i {
+		list = append(list, &daemonv2.StoreStats{
+			Name:            info.GetName(),
+			DiskUsage:       info.GetDiskUsage(),
+			KeyCount:        info.GetKeyCount(),
+			LastGcTimestamp: info.GetLastGC(),
+			LastGcDate:      timestampToString(info.GetLastGC()),
+		})
+	}
+	return list
+}
+
 type withdrawalsInfo []ports.Withdrawal
 
 func (i withdrawalsInfo) toProto() []*daemonv2.Transaction {
@@ -459,6 +524,33 @@ func (i withdrawalsInfo) toProto() []*daemonv2.Transaction {
 	return list
 }
 
+type ledgerEntryInfo struct {
+	ports.LedgerEntry
+}
+
+func (i ledgerEntryInfo) toProto(withHex bool) *daemonv2.LedgerEntry {
+	info := i.LedgerEntry
+	entry := &daemonv2.LedgerEntry{
+		AccountName: info.GetAccountName(),
+		Timestamp:   info.GetTimestamp(),
+		Date:        timestampToString(info.GetTimestamp()),
+	}
+	entryType := info.GetType()
+	if entryType.IsTrade() {
+		entry.Type = daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_TRADE
+		entry.Trade = tradesInfo{info.GetTrade()}.toProto(withHex)[0]
+	}
+	if entryType.IsDeposit() {
+		entry.Type = daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_DEPOSIT
+		entry.Transaction = depositsInfo{info.GetDeposit()}.toProto()[0]
+	}
+	if entryType.IsWithdrawal() {
+		entry.Type = daemonv2.LedgerEntryType_LEDGER_ENTRY_TYPE_WITHDRAWAL
+		entry.Transaction = withdrawalsInfo{info.GetWithdrawal()}.toProto()[0]
+	}
+	return entry
+}
+
 type timeRangeInfo struct {
 	*daemonv2.TimeRange
 }
@@ -562,9 +654,11 @@ type pageInfo struct {
 	*daemonv2.Page
 }
 
+// GetNumber returns 0 for pages without a number, meaning that the list is
+// paginated by token.
 func (i pageInfo) GetNumber() int64 {
 	if i.Page.GetNumber() <= 0 {
-		return 1
+		return 0
 	}
 	return i.Page.GetNumber()
 }
@@ -576,17 +670,184 @@ func (i pageInfo) GetSize() int64 {
 	return i.Page.GetSize()
 }
 
+type txFilterInfo struct {
+	asset     string
+	txid      string
+	timeRange ports.TimeRange
+}
+
+func (i txFilterInfo) GetAsset() string {
+	return i.asset
+}
+
+func (i txFilterInfo) GetTxid() string {
+	return i.txid
+}
+
+func (i txFilterInfo) GetTimeRange() ports.TimeRange {
+	return i.timeRange
+}
+
 type priceFeedInfo struct {
 	ports.PriceFeedInfo
 }
 
 func (i priceFeedInfo) toProto() *daemonv2.PriceFeed {
 	return &daemonv2.PriceFeed{
-		Id:      i.GetId(),
-		Market:  market{i.GetMarket()}.toProto(),
-		Source:  i.GetSource(),
-		Ticker:  i.GetTicker(),
-		Started: i.IsStarted(),
+		Id:             i.GetId(),
+		Market:         market{i.GetMarket()}.toProto(),
+		Source:         i.GetSource(),
+		Ticker:         i.GetTicker(),
+		Started:        i.IsStarted(),
+		Sources:        priceSourcesInfo(i.GetSources()).toProto(),
+		Aggregation:    priceAggregationToProto(i.GetAggregation()),
+		Legs:           priceLegsInfo(i.GetLegs()).toProto(),
+		Transformation: priceTransformationToProto(i.GetTransformation()),
+		Health:         priceFeedHealthToProto(i.GetHealth()),
+		LegsMaxAge:     i.GetLegsMaxAge(),
+	}
+}
+
+func priceFeedHealthToProto(
+	health ports.PriceFeedHealth,
+) *daemonv2.PriceFeedHealth {
+	if health == nil {
+		return nil
+	}
+	var lastPrice *tdexv2.Price
+	if price := health.GetLastPrice(); price != nil {
+		lastPrice = marketPriceInfo{price}.toProto()
+	}
+	return &daemonv2.PriceFeedHealth{
+		LastPrice:     lastPrice,
+		LastUpdate:    health.GetLastUpdate(),
+		UpdateRate:    health.GetUpdateRate(),
+		Reconnections: health.GetReconnections(),
+		LastError:     health.GetLastError(),
+	}
+}
+
+type priceTicksInfo []ports.PriceTick
+
+func (i priceTicksInfo) toProto() []*daemonv2.PriceTick {
+	list := make([]*daemonv2.PriceTick, 0, len(i))
+	for _, tick := range i {
+		tickType := daemonv2.PriceTickType_PRICE_TICK_TYPE_RECEIVED
+		if tick.GetType().IsApplied() {
+			tickType = daemonv2.PriceTickType_PRICE_TICK_TYPE_APPLIED
+		}
+		list = append(list, &daemonv2.PriceTick{
+			Id:          tick.GetId(),
+			PriceFeedId: tick.GetPriceFeedId(),
+			Type:        tickType,
+			OriginId:    tick.GetOriginId(),
+			Source:      tick.GetSource(),
+			Ticker:      tick.GetTicker(),
+			Price:       marketPriceInfo{tick.GetPrice()}.toProto(),
+			Timestamp:   tick.GetTimestamp(),
+		})
+	}
+	return list
+}
+
+type priceTickTypeInfo daemonv2.PriceTickType
+
+func (i priceTickTypeInfo) IsReceived() bool {
+	return daemonv2.PriceTickType(i) == daemonv2.PriceTickType_PRICE_TICK_TYPE_RECEIVED
+}
+func (i priceTickTypeInfo) IsApplied() bool {
+	return daemonv2.PriceTickType(i) == daemonv2.PriceTickType_PRICE_TICK_TYPE_APPLIED
+}
+
+type priceTransformationInfo struct {
+	*daemonv2.PriceTransformation
+}
+
+func (i priceTransformationInfo) IsRoundingEnabled() bool {
+	return i.GetRoundToPrecision()
+}
+
+func priceTransformationToProto(
+	transformation ports.PriceTransformation,
+) *daemonv2.PriceTransformation {
+	if transformation == nil {
+		return nil
+	}
+	return &daemonv2.PriceTransformation{
+		MarkupBps:         transformation.GetMarkupBps(),
+		MinUpdateInterval: transformation.GetMinUpdateInterval(),
+		RoundToPrecision:  transformation.IsRoundingEnabled(),
+	}
+}
+
+type priceLegInfo struct {
+	*daemonv2.PriceLeg
+}
+
+func (i priceLegInfo) IsInverse() bool {
+	return i.GetInverse()
+}
+
+type priceLegsInfo []ports.PriceLeg
+
+func (i priceLegsInfo) toProto() []*daemonv2.PriceLeg {
+	if len(i) <= 0 {
+		return nil
+	}
+	list := make([]*daemonv2.PriceLeg, 0, len(i))
+	for _, leg := range i {
+		list = append(list, &daemonv2.PriceLeg{
+			Source:  leg.GetSource(),
+			Ticker:  leg.GetTicker(),
+			Inverse: leg.IsInverse(),
+		})
+	}
+	return list
+}
+
+type priceSourcesInfo []ports.PriceSource
+
+func (i priceSourcesInfo) toProto() []*daemonv2.PriceSource {
+	if len(i) <= 0 {
+		return nil
+	}
+	list := make([]*daemonv2.PriceSource, 0, len(i))
+	for _, src := range i {
+		list = append(list, &daemonv2.PriceSource{
+			Source: src.GetSource(),
+			Ticker: src.GetTicker(),
+		})
+	}
+	return list
+}
+
+type priceAggregationInfo struct {
+	*daemonv2.PriceAggregation
+}
+
+func (i priceAggregationInfo) GetMethod() int {
+	if i.PriceAggregation.GetMethod() ==
+		daemonv2.PriceAggregationMethod_PRICE_AGGREGATION_METHOD_VOLUME_WEIGHTED {
+		return ports.PriceAggregationVolumeWeighted
+	}
+	return ports.PriceAggregationMedian
+}
+
+func priceAggregationToProto(
+	aggregation ports.PriceAggregation,
+) *daemonv2.PriceAggregation {
+	if aggregation == nil {
+		return nil
+	}
+	method := daemonv2.PriceAggregationMethod_PRICE_AGGREGATION_METHOD_MEDIAN
+	if aggregation.GetMethod() == ports.PriceAggregationVolumeWeighted {
+		method = daemonv2.PriceAggregationMethod_PRICE_AGGREGATION_METHOD_VOLUME_WEIGHTED
+	}
+	return &daemonv2.PriceAggregation{
+		Method:          method,
+		MaxDeviationBps: aggregation.GetMaxDeviationBps(),
+		Quorum:          aggregation.GetQuorum(),
+		MaxAge:          aggregation.GetMaxAge(),
 	}
 }
 
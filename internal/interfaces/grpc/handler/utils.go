@@ -103,6 +103,37 @@ func parsePrice(price *tdexv2.Price) (*decimal.Decimal, *decimal.Decimal, error)
 	return &basePrice, &quotePrice, nil
 }
 
+// parsePushedPrice parses the price pushed to a price feed, for which at
+// least one between base and quote price is required.
+func parsePushedPrice(price *tdexv2.Price) (ports.MarketPrice, error) {
+	if price == nil {
+		return nil, errors.New("missing price")
+	}
+	if price.GetBasePrice() < 0 || price.GetQuotePrice() < 0 {
+		return nil, errors.New("price must be positive")
+	}
+	if !isValidPrice(price.GetBasePrice()) && !isValidPrice(price.GetQuotePrice()) {
+		return nil, errors.New("missing base and/or quote price")
+	}
+	return pushedPrice{
+		basePrice:  decimal.NewFromFloat(price.GetBasePrice()),
+		quotePrice: decimal.NewFromFloat(price.GetQuotePrice()),
+	}, nil
+}
+
+func parsePriceTickType(
+	tickType daemonv2.PriceTickType,
+) (ports.PriceTickType, error) {
+	if tickType < daemonv2.PriceTickType_PRICE_TICK_TYPE_UNSPECIFIED ||
+		tickType > daemonv2.PriceTickType_PRICE_TICK_TYPE_APPLIED {
+		return nil, errors.New("unknown price tick type")
+	}
+	if tickType == daemonv2.PriceTickType_PRICE_TICK_TYPE_UNSPECIFIED {
+		return nil, nil
+	}
+	return priceTickTypeInfo(tickType), nil
+}
+
 func parseOutputs(outs []*daemonv2.TxOutput) ([]ports.TxOutput, error) {
 	list := make([]ports.TxOutput, 0)
 	for i, o := range outs {
@@ -229,9 +260,32 @@ func parsePage(page *daemonv2.Page) (ports.Page, error) {
 	if page.GetSize() <= 0 {
 		return nil, errors.New("invalid page size")
 	}
+	if page.GetNumber() > 0 && page.GetToken() != "" {
+		return nil, errors.New("page number and token are mutually exclusive")
+	}
 	return pageInfo{page}, nil
 }
 
+func parseTxFilter(
+	asset, txid string, timeRange *daemonv2.TimeRange,
+) (ports.TxFilter, error) {
+	if asset != "" && !isValidAsset(asset) {
+		return nil, errors.New("invalid asset")
+	}
+	if txid != "" && !isValidTxid(txid) {
+		return nil, errors.New("invalid txid")
+	}
+	filter := txFilterInfo{asset: asset, txid: txid}
+	if timeRange != nil {
+		tr, err := parseTimeRange(timeRange)
+		if err != nil {
+			return nil, err
+		}
+		filter.timeRange = tr
+	}
+	return filter, nil
+}
+
 func parseWebhook(hook *daemonv2.AddWebhookRequest) (ports.Webhook, error) {
 	if _, err := parseWebhookEvent(hook.GetEvent()); err != nil {
 		return nil, err
@@ -311,6 +365,62 @@ func parseTimeFrame(timeFrame daemonv2.TimeFrame) (int, error) {
 	}
 }
 
+func parsePriceSources(
+	sources []*daemonv2.PriceSource,
+) ([]ports.PriceSource, error) {
+	if len(sources) <= 0 {
+		return nil, nil
+	}
+	list := make([]ports.PriceSource, 0, len(sources))
+	for _, src := range sources {
+		if src.GetSource() == "" || src.GetTicker() == "" {
+			return nil, errors.New("missing price source and/or ticker")
+		}
+		list = append(list, src)
+	}
+	return list, nil
+}
+
+func parsePriceLegs(legs []*daemonv2.PriceLeg) ([]ports.PriceLeg, error) {
+	if len(legs) <= 0 {
+		return nil, nil
+	}
+	list := make([]ports.PriceLeg, 0, len(legs))
+	for _, leg := range legs {
+		if leg.GetSource() == "" || leg.GetTicker() == "" {
+			return nil, errors.New("missing price leg source and/or ticker")
+		}
+		list = append(list, priceLegInfo{leg})
+	}
+	return list, nil
+}
+
+func parsePriceTransformation(
+	transformation *daemonv2.PriceTransformation,
+) (ports.PriceTransformation, error) {
+	if transformation == nil {
+		return nil, nil
+	}
+	if transformation.GetMarkupBps() <= -10000 {
+		return nil, errors.New("markdown must be lower than 10000 basis points")
+	}
+	return priceTransformationInfo{transformation}, nil
+}
+
+func parsePriceAggregation(
+	aggregation *daemonv2.PriceAggregation,
+) (ports.PriceAggregation, error) {
+	if aggregation == nil {
+		return nil, nil
+	}
+	if _, ok := daemonv2.PriceAggregationMethod_name[int32(
+		aggregation.GetMethod(),
+	)]; !ok {
+		return nil, errors.New("unknown price aggregation method")
+	}
+	return priceAggregationInfo{aggregation}, nil
+}
+
 func isValidPassword(pwd string) bool {
 	return len(pwd) >= 8
 }
@@ -323,6 +433,14 @@ func isValidAsset(asset string) bool {
 	return len(b) == 32
 }
 
+func isValidTxid(txid string) bool {
+	b, err := hex.DecodeString(txid)
+	if err != nil {
+		return false
+	}
+	return len(b) == 32
+}
+
 func isValidPrice(price float64) bool {
 	return price > 0
 }
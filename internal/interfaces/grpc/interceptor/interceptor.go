@@ -7,11 +7,15 @@ import (
 	"google.golang.org/grpc"
 )
 
-// UnaryInterceptor returns the unary interceptor
-func UnaryInterceptor(svc *macaroons.Service) grpc.ServerOption {
+// UnaryInterceptor returns the unary interceptor. The trade rate limiter is
+// optional and, if nil, requests to the trade interface are not limited.
+func UnaryInterceptor(
+	svc *macaroons.Service, tradeLimiter *TradeRateLimiter,
+) grpc.ServerOption {
 	return grpc.UnaryInterceptor(
 		middleware.ChainUnaryServer(
 			grpc_recovery.UnaryServerInterceptor(),
+			unaryTradeRateLimitHandler(tradeLimiter),
 			unaryMacaroonAuthHandler(svc),
 			unaryLogger,
 		),
@@ -28,3 +32,30 @@ func StreamInterceptor(svc *macaroons.Service) grpc.ServerOption {
 		),
 	)
 }
+
+// ReadOnlyUnaryInterceptor returns the unary interceptor of a read-only
+// replica of the daemon, rejecting any method that requires write permissions
+// before checking the macaroon.
+func ReadOnlyUnaryInterceptor(svc *macaroons.Service) grpc.ServerOption {
+	return grpc.UnaryInterceptor(
+		middleware.ChainUnaryServer(
+			grpc_recovery.UnaryServerInterceptor(),
+			unaryReadOnlyHandler(),
+			unaryMacaroonAuthHandler(svc),
+			unaryLogger,
+		),
+	)
+}
+
+// ReadOnlyStreamInterceptor returns the stream interceptor of a read-only
+// replica of the daemon.
+func ReadOnlyStreamInterceptor(svc *macaroons.Service) grpc.ServerOption {
+	return grpc.StreamInterceptor(
+		middleware.ChainStreamServer(
+			grpc_recovery.StreamServerInterceptor(),
+			streamReadOnlyHandler(),
+			streamMacaroonAuthHandler(svc),
+			streamLogger,
+		),
+	)
+}
@@ -0,0 +1,181 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+	tdexv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex/v2"
+	"github.com/tdex-network/tdex-daemon/pkg/ratelimiter"
+	pkgswap "github.com/tdex-network/tdex-daemon/pkg/swap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const gatewayClientIPKey = "tdex-gateway-client-ip"
+
+var (
+	// gatewaySecret authenticates the metadata added by the in-process REST
+	// gateway, see GatewayMetadata.
+	gatewaySecret = newGatewaySecret()
+
+	previewTradeMethod = fmt.Sprintf(
+		"/%s/PreviewTrade", tdexv2.TradeService_ServiceDesc.ServiceName,
+	)
+	proposeTradeMethod = fmt.Sprintf(
+		"/%s/ProposeTrade", tdexv2.TradeService_ServiceDesc.ServiceName,
+	)
+	completeTradeMethod = fmt.Sprintf(
+		"/%s/CompleteTrade", tdexv2.TradeService_ServiceDesc.ServiceName,
+	)
+	proposeTradeDryRunMethod = fmt.Sprintf(
+		"/%s/ProposeTrade", daemonv2.DryRunService_ServiceDesc.ServiceName,
+	)
+)
+
+// TradeRateLimiter limits the number of PreviewTrade, ProposeTrade and
+// CompleteTrade requests per client IP. Dry-run trade proposals share the
+// same limit of the real ones, but are counted separately.
+type TradeRateLimiter struct {
+	limiters map[string]*ratelimiter.RateLimiter
+}
+
+// NewTradeRateLimiter returns a new TradeRateLimiter for the given limits,
+// each expressing the max number of requests per client IP allowed within
+// interval. A zero limit means unlimited.
+func NewTradeRateLimiter(
+	previewLimit, proposeLimit, completeLimit int, interval time.Duration,
+) *TradeRateLimiter {
+	return &TradeRateLimiter{
+		map[string]*ratelimiter.RateLimiter{
+			previewTradeMethod:  ratelimiter.NewRateLimiter(previewLimit, interval),
+			proposeTradeMethod:  ratelimiter.NewRateLimiter(proposeLimit, interval),
+			completeTradeMethod: ratelimiter.NewRateLimiter(completeLimit, interval),
+			proposeTradeDryRunMethod: ratelimiter.NewRateLimiter(
+				proposeLimit, interval,
+			),
+		},
+	}
+}
+
+func unaryTradeRateLimitHandler(limiter *TradeRateLimiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if limiter == nil {
+			return handler(ctx, req)
+		}
+		l, ok := limiter.limiters[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		// Aborting a trade is never limited since it releases the locked funds.
+		var messageId string
+		switch r := req.(type) {
+		case *tdexv2.ProposeTradeRequest:
+			messageId = r.GetSwapRequest().GetId()
+		case *tdexv2.CompleteTradeRequest:
+			if r.GetSwapFail() != nil {
+				return handler(ctx, req)
+			}
+			messageId = r.GetSwapComplete().GetId()
+		}
+
+		ip := clientIP(ctx)
+		if l.Allow(ip) {
+			return handler(ctx, req)
+		}
+
+		log.Debugf("rate limit reached for %s by client %s", info.FullMethod, ip)
+
+		st := status.New(codes.ResourceExhausted, "too many requests, retry later")
+		if info.FullMethod == previewTradeMethod ||
+			info.FullMethod == proposeTradeDryRunMethod {
+			return nil, st.Err()
+		}
+
+		_, msg, err := pkgswap.Fail(pkgswap.FailOpts{
+			MessageID: messageId,
+			ErrCode:   pkgswap.ErrCodeTooManyRequests,
+		})
+		if err != nil {
+			return nil, st.Err()
+		}
+		swapFail := &tdexv2.SwapFail{}
+		if err := proto.Unmarshal(msg, swapFail); err != nil {
+			return nil, st.Err()
+		}
+		if stWithDetails, err := st.WithDetails(swapFail); err == nil {
+			st = stWithDetails
+		}
+		return nil, st.Err()
+	}
+}
+
+// GatewayMetadata is the metadata annotator of the in-process REST gateway.
+// It adds the address of the HTTP client to the metadata of the forwarded
+// request along with a secret only known to this process, so that the rate
+// limiter can tell it apart from the one of a client connecting directly.
+func GatewayMetadata(_ context.Context, req *http.Request) metadata.MD {
+	ip := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return metadata.Pairs(gatewayClientIPKey, fmt.Sprintf("%s %s", gatewaySecret, ip))
+}
+
+// clientIP returns the IP address of the client of the given request.
+// Requests forwarded by the local REST gateway come from the loopback
+// interface, in that case the address of the original client is the one
+// added by the gateway to the metadata. Clients connecting from the loopback
+// interface through a reverse proxy or a Tor hidden service can send any
+// metadata, therefore the value is trusted only if it carries the secret of
+// the gateway.
+func clientIP(ctx context.Context) string {
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(gatewayClientIPKey) {
+			parts := strings.SplitN(value, " ", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			if subtle.ConstantTimeCompare(
+				[]byte(parts[0]), []byte(gatewaySecret),
+			) == 1 {
+				ip = parts[1]
+			}
+		}
+	}
+	return ip
+}
+
+func newGatewaySecret() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate gateway secret: %s", err))
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,112 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		peer     string
+		values   []string
+		expected string
+	}{
+		{
+			name:     "direct client",
+			peer:     "203.0.113.1:4321",
+			expected: "203.0.113.1",
+		},
+		{
+			name:     "direct client with spoofed metadata",
+			peer:     "203.0.113.1:4321",
+			values:   []string{"198.51.100.7"},
+			expected: "203.0.113.1",
+		},
+		{
+			name:     "local proxy client with spoofed metadata",
+			peer:     "127.0.0.1:4321",
+			values:   []string{"198.51.100.7", "secret 198.51.100.8"},
+			expected: "127.0.0.1",
+		},
+		{
+			name:     "gateway client",
+			peer:     "127.0.0.1:4321",
+			values:   gatewayValues(t, "203.0.113.1:5678"),
+			expected: "203.0.113.1",
+		},
+		{
+			name: "gateway client with spoofed metadata",
+			peer: "[::1]:4321",
+			values: append(
+				[]string{"198.51.100.7"}, gatewayValues(t, "203.0.113.1:5678")...,
+			),
+			expected: "203.0.113.1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newTestContext(t, tt.peer, tt.values...)
+			require.Equal(t, tt.expected, clientIP(ctx))
+		})
+	}
+}
+
+func TestTradeRateLimit(t *testing.T) {
+	limiter := NewTradeRateLimiter(1, 1, 1, time.Minute)
+	interceptor := unaryTradeRateLimitHandler(limiter)
+	info := &grpc.UnaryServerInfo{FullMethod: previewTradeMethod}
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	// Rotating the value of the metadata sent by a client connecting from the
+	// loopback interface doesn't bypass the limit.
+	ctx := newTestContext(t, "127.0.0.1:4321", "198.51.100.7")
+	_, err := interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+
+	ctx = newTestContext(t, "127.0.0.1:4321", "198.51.100.8")
+	_, err = interceptor(ctx, nil, info, handler)
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Clients of the gateway are not affected.
+	ctx = newTestContext(
+		t, "127.0.0.1:4321", gatewayValues(t, "203.0.113.2:5678")...,
+	)
+	_, err = interceptor(ctx, nil, info, handler)
+	require.NoError(t, err)
+}
+
+func newTestContext(
+	t *testing.T, addr string, values ...string,
+) context.Context {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	require.NoError(t, err)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+	if len(values) > 0 {
+		md := metadata.MD{gatewayClientIPKey: values}
+		ctx = metadata.NewIncomingContext(ctx, md)
+	}
+	return ctx
+}
+
+func gatewayValues(t *testing.T, remoteAddr string) []string {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = remoteAddr
+	return GatewayMetadata(context.Background(), req).Get(gatewayClientIPKey)
+}
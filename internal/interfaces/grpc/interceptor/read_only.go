@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/tdex-network/tdex-daemon/internal/interfaces/grpc/permissions"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func unaryReadOnlyHandler() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkReadOnly(info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func streamReadOnlyHandler() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkReadOnly(info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func checkReadOnly(fullMethod string) error {
+	if permissions.IsReplicaMethod(fullMethod) {
+		return nil
+	}
+	return status.Errorf(
+		codes.PermissionDenied, "%s: method not available in read-only mode",
+		fullMethod,
+	)
+}
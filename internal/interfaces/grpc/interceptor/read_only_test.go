@@ -0,0 +1,57 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestReadOnlyUnaryInterceptor(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(ReadOnlyUnaryInterceptor(nil))
+	daemonv2.RegisterOperatorServiceServer(
+		server, daemonv2.UnimplementedOperatorServiceServer{},
+	)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(
+		context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := daemonv2.NewOperatorServiceClient(conn)
+
+	// Write methods are rejected before reaching the handler.
+	_, err = client.NewMarket(
+		context.Background(), &daemonv2.NewMarketRequest{},
+	)
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// So are read methods needing the wallet.
+	_, err = client.ListUtxos(
+		context.Background(), &daemonv2.ListUtxosRequest{},
+	)
+	require.Error(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Read methods are let through, the handler is not implemented.
+	_, err = client.ListMarkets(
+		context.Background(), &daemonv2.ListMarketsRequest{},
+	)
+	require.Error(t, err)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -2,6 +2,7 @@ package permissions
 
 import (
 	"fmt"
+	"strings"
 
 	"gopkg.in/macaroon-bakery.v2/bakery"
 
@@ -235,6 +236,10 @@ func Whitelist() map[string][]bakery.Op {
 			Entity: EntityTrade,
 			Action: "write",
 		}},
+		fmt.Sprintf("/%s/ProposeTrade", daemonv2.DryRunService_ServiceDesc.ServiceName): {{
+			Entity: EntityTrade,
+			Action: "read",
+		}},
 		fmt.Sprintf("/%v/SupportedContentTypes", tdexv2.TransportService_ServiceDesc.ServiceName): {{
 			Entity: EntityTransport,
 			Action: "read",
@@ -322,6 +327,10 @@ func AllPermissionsByMethod() map[string][]bakery.Op {
 			Entity: EntityMarket,
 			Action: "write",
 		}},
+		fmt.Sprintf("/%s/UpdateMarketTradeExpiryTime", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityMarket,
+			Action: "write",
+		}},
 		fmt.Sprintf("/%s/UpdateMarketStrategy", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
 			Entity: EntityMarket,
 			Action: "write",
@@ -382,6 +391,30 @@ func AllPermissionsByMethod() map[string][]bakery.Op {
 			Entity: EntityMarket,
 			Action: "read",
 		}},
+		fmt.Sprintf("/%s/ExportLedger", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityMarket,
+			Action: "read",
+		}},
+		fmt.Sprintf("/%s/Backup", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityOperator,
+			Action: "write",
+		}},
+		fmt.Sprintf("/%s/ArchiveTrades", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityOperator,
+			Action: "write",
+		}},
+		fmt.Sprintf("/%s/GetBalanceHistory", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityOperator,
+			Action: "read",
+		}},
+		fmt.Sprintf("/%s/GetDBStats", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityOperator,
+			Action: "read",
+		}},
+		fmt.Sprintf("/%s/RunDBGC", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
+			Entity: EntityOperator,
+			Action: "write",
+		}},
 		fmt.Sprintf("/%s/ListUtxos", daemonv2.OperatorService_ServiceDesc.ServiceName): {{
 			Entity: EntityOperator,
 			Action: "read",
@@ -414,6 +447,10 @@ func AllPermissionsByMethod() map[string][]bakery.Op {
 			Entity: EntityFeeder,
 			Action: "write",
 		}},
+		fmt.Sprintf("/%s/PushPrice", daemonv2.FeederService_ServiceDesc.ServiceName): {{
+			Entity: EntityFeeder,
+			Action: "write",
+		}},
 		fmt.Sprintf("/%s/RemovePriceFeed", daemonv2.FeederService_ServiceDesc.ServiceName): {{
 			Entity: EntityFeeder,
 			Action: "write",
@@ -426,9 +463,71 @@ func AllPermissionsByMethod() map[string][]bakery.Op {
 			Entity: EntityFeeder,
 			Action: "read",
 		}},
+		fmt.Sprintf("/%s/ListPriceTicks", daemonv2.FeederService_ServiceDesc.ServiceName): {{
+			Entity: EntityFeeder,
+			Action: "read",
+		}},
 		fmt.Sprintf("/%s/ListSupportedPriceSources", daemonv2.FeederService_ServiceDesc.ServiceName): {{
 			Entity: EntityFeeder,
 			Action: "read",
 		}},
 	}
 }
+
+// IsReadOnlyMethod returns whether the given RPC server call requires only
+// read permissions, either because whitelisted or restricted.
+func IsReadOnlyMethod(fullMethod string) bool {
+	ops, ok := Whitelist()[fullMethod]
+	if !ok {
+		ops, ok = AllPermissionsByMethod()[fullMethod]
+	}
+	if !ok || len(ops) <= 0 {
+		return false
+	}
+	for _, op := range ops {
+		if op.Action != "read" {
+			return false
+		}
+	}
+	return true
+}
+
+// replicaServices are the services registered by a read-only replica of the
+// daemon.
+var replicaServices = map[string]bool{
+	daemonv2.OperatorService_ServiceDesc.ServiceName:       true,
+	grpchealth.Health_ServiceDesc.ServiceName:              true,
+	reflectionv1.ReflectionService_ServiceDesc.ServiceName: true,
+}
+
+// walletReadMethods returns the RPC server calls of the Operator interface
+// requiring only read permissions that can't be served without the wallet.
+func walletReadMethods() map[string]bool {
+	methods := make(map[string]bool)
+	for _, m := range []string{
+		"ListFeeAddresses", "GetFeeBalance", "ListMarketAddresses",
+		"ListFeeFragmenterAddresses", "GetFeeFragmenterBalance",
+		"ListMarketFragmenterAddresses", "GetMarketFragmenterBalance",
+		"ListUtxos",
+	} {
+		methods[fmt.Sprintf(
+			"/%s/%s", daemonv2.OperatorService_ServiceDesc.ServiceName, m,
+		)] = true
+	}
+	return methods
+}
+
+// IsReplicaMethod returns whether the given RPC server call is served by a
+// read-only replica of the daemon, that is whether it requires only read
+// permissions, belongs to one of the services registered by the replica and
+// doesn't need the wallet, which the replica never connects to.
+func IsReplicaMethod(fullMethod string) bool {
+	if !IsReadOnlyMethod(fullMethod) {
+		return false
+	}
+	service := strings.SplitN(strings.TrimPrefix(fullMethod, "/"), "/", 2)[0]
+	if !replicaServices[service] {
+		return false
+	}
+	return !walletReadMethods()[fullMethod]
+}
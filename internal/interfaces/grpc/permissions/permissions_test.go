@@ -41,6 +41,9 @@ func TestWhitelistedMethods(t *testing.T) {
 	for _, v := range tdexv2.TradeService_ServiceDesc.Methods {
 		allMethods = append(allMethods, fmt.Sprintf("/%s/%s", tdexv2.TradeService_ServiceDesc.ServiceName, v.MethodName))
 	}
+	for _, v := range daemonv2.DryRunService_ServiceDesc.Methods {
+		allMethods = append(allMethods, fmt.Sprintf("/%s/%s", daemonv2.DryRunService_ServiceDesc.ServiceName, v.MethodName))
+	}
 
 	allMethods = append(allMethods, fmt.Sprintf("/%s/%s", grpchealth.Health_ServiceDesc.ServiceName, "Check"))
 
@@ -50,3 +53,54 @@ func TestWhitelistedMethods(t *testing.T) {
 		require.True(t, ok, fmt.Sprintf("missing %s in whitelist", m))
 	}
 }
+
+func TestReadOnlyMethods(t *testing.T) {
+	operatorSvc := daemonv2.OperatorService_ServiceDesc.ServiceName
+	for _, m := range []string{
+		"ListTrades", "GetMarketReport", "ListDeposits", "ListMarkets",
+	} {
+		method := fmt.Sprintf("/%s/%s", operatorSvc, m)
+		require.True(t, permissions.IsReadOnlyMethod(method), method)
+	}
+	for _, m := range []string{
+		"NewMarket", "WithdrawFee", "UpdateMarketPrice", "RunDBGC",
+	} {
+		method := fmt.Sprintf("/%s/%s", operatorSvc, m)
+		require.False(t, permissions.IsReadOnlyMethod(method), method)
+	}
+
+	healthCheck := fmt.Sprintf("/%s/Check", grpchealth.Health_ServiceDesc.ServiceName)
+	require.True(t, permissions.IsReadOnlyMethod(healthCheck))
+	require.False(t, permissions.IsReadOnlyMethod("/unknown/Method"))
+}
+
+func TestReplicaMethods(t *testing.T) {
+	operatorSvc := daemonv2.OperatorService_ServiceDesc.ServiceName
+	for _, method := range []string{
+		fmt.Sprintf("/%s/ListTrades", operatorSvc),
+		fmt.Sprintf("/%s/ListMarkets", operatorSvc),
+		fmt.Sprintf("/%s/ExportLedger", operatorSvc),
+		fmt.Sprintf("/%s/Check", grpchealth.Health_ServiceDesc.ServiceName),
+	} {
+		require.True(t, permissions.IsReplicaMethod(method), method)
+	}
+
+	// Write methods, read methods needing the wallet and those of services not
+	// registered by the replica are not served.
+	for _, method := range []string{
+		fmt.Sprintf("/%s/NewMarket", operatorSvc),
+		fmt.Sprintf("/%s/ListUtxos", operatorSvc),
+		fmt.Sprintf("/%s/GetFeeBalance", operatorSvc),
+		fmt.Sprintf("/%s/ListMarketAddresses", operatorSvc),
+		fmt.Sprintf(
+			"/%s/ListPriceFeeds", daemonv2.FeederService_ServiceDesc.ServiceName,
+		),
+		fmt.Sprintf(
+			"/%s/ListWebhooks", daemonv2.WebhookService_ServiceDesc.ServiceName,
+		),
+		fmt.Sprintf("/%s/GetInfo", daemonv2.WalletService_ServiceDesc.ServiceName),
+		fmt.Sprintf("/%s/ListMarkets", tdexv2.TradeService_ServiceDesc.ServiceName),
+	} {
+		require.False(t, permissions.IsReplicaMethod(method), method)
+	}
+}
@@ -319,6 +319,9 @@ func (s *service) Stop() {
 	stopMacaroonSvc := true
 	s.stop(stopMacaroonSvc)
 
+	s.opts.AppConfig.OperatorService().Close()
+	log.Debug("stopped balance snapshots")
+
 	s.opts.AppConfig.FeederService().Close()
 	log.Debug("closed connection with feeder")
 
@@ -409,7 +412,7 @@ func (s *service) newOperatorServer(
 	tlsConfig *tls.Config, withOperatorHandler bool,
 ) (*http.Server, error) {
 	serverOpts := []grpc.ServerOption{
-		interceptor.UnaryInterceptor(s.macaroonSvc),
+		interceptor.UnaryInterceptor(s.macaroonSvc, nil),
 		interceptor.StreamInterceptor(s.macaroonSvc),
 	}
 
@@ -559,7 +562,9 @@ func (s *service) newOperatorServer(
 
 func (s *service) newTradeServer(tlsConfig *tls.Config) (*http.Server, error) {
 	serverOpts := []grpc.ServerOption{
-		interceptor.UnaryInterceptor(s.macaroonSvc),
+		interceptor.UnaryInterceptor(
+			s.macaroonSvc, newTradeRateLimiter(s.opts.AppConfig),
+		),
 		interceptor.StreamInterceptor(s.macaroonSvc),
 	}
 
@@ -573,6 +578,8 @@ func (s *service) newTradeServer(tlsConfig *tls.Config) (*http.Server, error) {
 	grpcServer := grpc.NewServer(serverOpts...)
 	tradeHandler := grpchandler.NewTradeHandler(s.opts.AppConfig.TradeService())
 	tdexv2.RegisterTradeServiceServer(grpcServer, tradeHandler)
+	dryRunHandler := grpchandler.NewDryRunHandler(s.opts.AppConfig.TradeService())
+	daemonv2.RegisterDryRunServiceServer(grpcServer, dryRunHandler)
 	transportHandler := grpchandler.NewTransportHandler()
 	tdexv2.RegisterTransportServiceServer(grpcServer, transportHandler)
 	healthHandler := grpchandler.NewHealthHandler()
@@ -597,6 +604,7 @@ func (s *service) newTradeServer(tlsConfig *tls.Config) (*http.Server, error) {
 	}
 	gwmux := runtime.NewServeMux(
 		runtime.WithHealthzEndpoint(grpchealth.NewHealthClient(conn)),
+		runtime.WithMetadata(interceptor.GatewayMetadata),
 		runtime.WithMarshalerOption("application/json+pretty", &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				Indent:    "  ",
@@ -617,6 +625,11 @@ func (s *service) newTradeServer(tlsConfig *tls.Config) (*http.Server, error) {
 	); err != nil {
 		return nil, err
 	}
+	if err := daemonv2.RegisterDryRunServiceHandler(
+		ctx, gwmux, conn,
+	); err != nil {
+		return nil, err
+	}
 	if err := reflectionv1.RegisterReflectionServiceHandler(
 		ctx, gwmux, conn,
 	); err != nil {
@@ -711,3 +724,13 @@ func (s *service) onChangePwd(oldPassword, newPassword string) {
 		log.WithError(err).Warn("failed to change password of macaroon store")
 	}
 }
+
+func newTradeRateLimiter(cfg *application.Config) *interceptor.TradeRateLimiter {
+	if cfg == nil {
+		return nil
+	}
+	return interceptor.NewTradeRateLimiter(
+		cfg.PreviewTradeRateLimit, cfg.ProposeTradeRateLimit,
+		cfg.CompleteTradeRateLimit, cfg.TradeRateLimitInterval,
+	)
+}
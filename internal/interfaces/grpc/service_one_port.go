@@ -205,6 +205,9 @@ func (s *serviceOnePort) Stop() {
 	stopMacaroonSvc := true
 	s.stop(stopMacaroonSvc)
 
+	s.opts.AppConfig.OperatorService().Close()
+	log.Debug("stopped balance snapshots")
+
 	s.opts.AppConfig.FeederService().Close()
 	log.Debug("closed connection with feeder")
 
@@ -267,7 +270,9 @@ func (s *serviceOnePort) newServer(
 	tlsConfig *tls.Config, withWalletOnly bool,
 ) (*http.Server, error) {
 	serverOpts := []grpc.ServerOption{
-		interceptor.UnaryInterceptor(s.macaroonSvc),
+		interceptor.UnaryInterceptor(
+			s.macaroonSvc, newTradeRateLimiter(s.opts.AppConfig),
+		),
 		interceptor.StreamInterceptor(s.macaroonSvc),
 	}
 
@@ -306,6 +311,7 @@ func (s *serviceOnePort) newServer(
 	// Reverse proxy grpc-gateway.
 	gwmux := runtime.NewServeMux(
 		runtime.WithHealthzEndpoint(grpchealth.NewHealthClient(conn)),
+		runtime.WithMetadata(interceptor.GatewayMetadata),
 		runtime.WithMarshalerOption("application/json+pretty", &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				Indent:    "  ",
@@ -387,6 +393,14 @@ func (s *serviceOnePort) newServer(
 		); err != nil {
 			return nil, err
 		}
+
+		dryRunHandler := grpchandler.NewDryRunHandler(s.opts.AppConfig.TradeService())
+		daemonv2.RegisterDryRunServiceServer(grpcServer, dryRunHandler)
+		if err := daemonv2.RegisterDryRunServiceHandler(
+			ctx, gwmux, conn,
+		); err != nil {
+			return nil, err
+		}
 	}
 	grpcGateway := http.Handler(gwmux)
 
@@ -0,0 +1,346 @@
+package grpcinterface
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	reflectionv1 "github.com/tdex-network/reflection/api-spec/protobuf/gen/reflection/v1"
+	daemonv2 "github.com/tdex-network/tdex-daemon/api-spec/protobuf/gen/tdex-daemon/v2"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tdex-network/reflection"
+	"github.com/tdex-network/tdex-daemon/internal/core/application"
+	"github.com/tdex-network/tdex-daemon/internal/interfaces"
+	grpchandler "github.com/tdex-network/tdex-daemon/internal/interfaces/grpc/handler"
+	"github.com/tdex-network/tdex-daemon/internal/interfaces/grpc/interceptor"
+	"github.com/tdex-network/tdex-daemon/internal/interfaces/grpc/permissions"
+	"github.com/tdex-network/tdex-daemon/pkg/macaroons"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	grpchealth "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// ReadOnlyDBFile is the name of the macaroon database file of a read-only
+	// replica. It's different from the one of the daemon so that the macaroons
+	// of the replica are never valid for the live daemon, and viceversa.
+	ReadOnlyDBFile = "replica-macaroons.db"
+	// ReadOnlyMacaroonsLocation is the subdirectory of the macaroons' datadir
+	// where the replica stores its read-only macaroon.
+	ReadOnlyMacaroonsLocation = "replica"
+)
+
+type readOnlyService struct {
+	opts        ReadOnlyServiceOpts
+	macaroonSvc *macaroons.Service
+	server      *http.Server
+}
+
+// ReadOnlyServiceOpts are the options of a read-only replica of the daemon,
+// serving only the read RPCs of the Operator interface on a copy of the
+// datadir.
+type ReadOnlyServiceOpts struct {
+	NoMacaroons bool
+
+	Datadir              string
+	DBLocation           string
+	TLSLocation          string
+	MacaroonsLocation    string
+	OperatorExtraIPs     []string
+	OperatorExtraDomains []string
+	// MacaroonsPasswordFile is the path of the file containing the password
+	// used to encrypt the macaroon database of the replica. Required unless
+	// macaroons are disabled.
+	MacaroonsPasswordFile string
+
+	OperatorPort  int
+	NoOperatorTls bool
+
+	AppConfig *application.Config
+}
+
+func (o ReadOnlyServiceOpts) validate() error {
+	if !pathExists(o.Datadir) {
+		return fmt.Errorf("%s: datadir must be an existing directory", o.Datadir)
+	}
+
+	if !o.NoMacaroons {
+		if o.MacaroonsPasswordFile == "" {
+			return fmt.Errorf(
+				"password file is required to generate macaroons in read-only mode",
+			)
+		}
+		if !pathExists(o.MacaroonsPasswordFile) {
+			return fmt.Errorf("password file not found")
+		}
+	}
+
+	if !o.NoOperatorTls {
+		for _, ip := range o.OperatorExtraIPs {
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("invalid operator extra ip %s", ip)
+			}
+		}
+	}
+
+	if ok := isValidPort(o.OperatorPort); !ok {
+		return fmt.Errorf("operator port must be in range [%d, %d]", minPort, maxPort)
+	}
+
+	if o.AppConfig == nil {
+		return fmt.Errorf("missing app config")
+	}
+	if !o.AppConfig.ReadOnly {
+		return fmt.Errorf("app config must be in read-only mode")
+	}
+	if err := o.AppConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid app config: %s", err)
+	}
+
+	return nil
+}
+
+func (o ReadOnlyServiceOpts) dbDatadir() string {
+	return filepath.Join(o.Datadir, o.DBLocation)
+}
+
+func (o ReadOnlyServiceOpts) macaroonsDatadir() string {
+	return filepath.Join(
+		o.Datadir, o.MacaroonsLocation, ReadOnlyMacaroonsLocation,
+	)
+}
+
+func (o ReadOnlyServiceOpts) tlsDatadir() string {
+	return filepath.Join(o.Datadir, o.TLSLocation)
+}
+
+func (o ReadOnlyServiceOpts) tlsConfig() (*tls.Config, error) {
+	if o.NoOperatorTls {
+		return nil, nil
+	}
+	return getTlsConfig(
+		filepath.Join(o.tlsDatadir(), OperatorTLSKeyFile),
+		filepath.Join(o.tlsDatadir(), OperatorTLSCertFile),
+	)
+}
+
+func (o ReadOnlyServiceOpts) serverAddr() string {
+	return fmt.Sprintf(":%d", o.OperatorPort)
+}
+
+func (o ReadOnlyServiceOpts) clientAddr() string {
+	return fmt.Sprintf("localhost:%d", o.OperatorPort)
+}
+
+// NewReadOnlyService returns the interface of a read-only replica of the
+// daemon. Only the Operator interface is served and every method requiring
+// write permissions or the wallet is rejected. If macaroons are enabled, a read-only
+// macaroon is generated from a dedicated macaroon database.
+func NewReadOnlyService(opts ReadOnlyServiceOpts) (interfaces.Service, error) {
+	if err := opts.validate(); err != nil {
+		return nil, fmt.Errorf("invalid opts: %s", err)
+	}
+
+	var macaroonSvc *macaroons.Service
+	if !opts.NoMacaroons {
+		passwordBytes, err := os.ReadFile(opts.MacaroonsPasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		password := bytes.TrimFunc(passwordBytes, func(r rune) bool {
+			return r == 10 || r == 13 || r == 32
+		})
+
+		macaroonSvc, err = macaroons.NewService(
+			opts.dbDatadir(), Location, ReadOnlyDBFile, false,
+			macaroons.IPLockChecker,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := macaroonSvc.CreateUnlock(&password); err != nil {
+			return nil, fmt.Errorf("failed to unlock macaroon store: %s", err)
+		}
+		if err := genReadOnlyMacaroon(
+			context.Background(), macaroonSvc, opts.macaroonsDatadir(),
+		); err != nil {
+			return nil, fmt.Errorf("failed to create macaroon: %s", err)
+		}
+	}
+
+	if !opts.NoOperatorTls {
+		if err := generateOperatorTLSKeyCert(
+			opts.tlsDatadir(), opts.OperatorExtraIPs, opts.OperatorExtraDomains,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return &readOnlyService{opts: opts, macaroonSvc: macaroonSvc}, nil
+}
+
+func (s *readOnlyService) Start() error {
+	tlsConfig, err := s.opts.tlsConfig()
+	if err != nil {
+		return err
+	}
+	server, err := s.newServer(tlsConfig)
+	if err != nil {
+		return err
+	}
+	s.server = server
+
+	if s.opts.NoOperatorTls {
+		//nolint
+		go s.server.ListenAndServe()
+	} else {
+		//nolint
+		go s.server.ListenAndServeTLS("", "")
+	}
+	log.Infof(
+		"read-only operator interface is listening on %s", s.opts.serverAddr(),
+	)
+
+	return nil
+}
+
+func (s *readOnlyService) Stop() {
+	if s.macaroonSvc != nil {
+		//nolint
+		s.macaroonSvc.Close()
+		log.Debug("closed connection with macaroon db")
+	}
+
+	if s.server != nil {
+		//nolint
+		s.server.Shutdown(context.Background())
+		log.Debug("stopped operator server")
+	}
+
+	s.opts.AppConfig.RepoManager().Close()
+	log.Debug("closed connection with database")
+
+	s.opts.AppConfig.WalletService().Close()
+}
+
+func (s *readOnlyService) newServer(tlsConfig *tls.Config) (*http.Server, error) {
+	serverOpts := []grpc.ServerOption{
+		interceptor.ReadOnlyUnaryInterceptor(s.macaroonSvc),
+		interceptor.ReadOnlyStreamInterceptor(s.macaroonSvc),
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	serverOpts = append(serverOpts, grpc.Creds(creds))
+
+	// Server grpc.
+	grpcServer := grpc.NewServer(serverOpts...)
+	operatorHandler := grpchandler.NewOperatorHandler(
+		s.opts.AppConfig.OperatorService(),
+	)
+	daemonv2.RegisterOperatorServiceServer(grpcServer, operatorHandler)
+	healthHandler := grpchandler.NewHealthHandler()
+	grpchealth.RegisterHealthServer(grpcServer, healthHandler)
+	reflection.Register(grpcServer)
+
+	// Reverse proxy grpc-gateway.
+	gatewayCreds := insecure.NewCredentials()
+	if !s.opts.NoOperatorTls {
+		// #nosec
+		gatewayCreds = credentials.NewTLS(&tls.Config{
+			InsecureSkipVerify: true,
+		})
+	}
+	ctx := context.Background()
+	gatewayOpts := grpc.WithTransportCredentials(gatewayCreds)
+	conn, err := grpc.DialContext(ctx, s.opts.clientAddr(), gatewayOpts)
+	if err != nil {
+		return nil, err
+	}
+	gwmux := runtime.NewServeMux(
+		runtime.WithHealthzEndpoint(grpchealth.NewHealthClient(conn)),
+		runtime.WithMarshalerOption("application/json+pretty", &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				Indent:    "  ",
+				Multiline: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+	if err := daemonv2.RegisterOperatorServiceHandler(
+		ctx, gwmux, conn,
+	); err != nil {
+		return nil, err
+	}
+	if err := reflectionv1.RegisterReflectionServiceHandler(
+		ctx, gwmux, conn,
+	); err != nil {
+		return nil, err
+	}
+	grpcGateway := http.Handler(gwmux)
+
+	// Wrapped server grpc-web.
+	grpcWebServer := grpcweb.WrapServer(
+		grpcServer,
+		grpcweb.WithCorsForRegisteredEndpointsOnly(false),
+		grpcweb.WithOriginFunc(func(origin string) bool { return true }),
+	)
+
+	// Server mux.
+	handler := router(grpcServer, grpcWebServer, grpcGateway, nil)
+	mux := http.NewServeMux()
+	mux.Handle("/", handler)
+
+	httpServerHandler := http.Handler(mux)
+	if s.opts.NoOperatorTls {
+		httpServerHandler = h2c.NewHandler(httpServerHandler, &http2.Server{})
+	}
+
+	return &http.Server{
+		Addr:      s.opts.serverAddr(),
+		Handler:   httpServerHandler,
+		TLSConfig: tlsConfig,
+	}, nil
+}
+
+// genReadOnlyMacaroon generates the only macaroon of a read-only replica,
+// granting the read action for all entities.
+func genReadOnlyMacaroon(
+	ctx context.Context, svc *macaroons.Service, datadir string,
+) error {
+	macFile := filepath.Join(datadir, ReadOnlyMacaroonFile)
+	if pathExists(macFile) {
+		return nil
+	}
+
+	if err := makeDirectoryIfNotExists(datadir); err != nil {
+		return err
+	}
+
+	macBytes, err := bakeMacaroon(ctx, svc, permissions.ReadOnlyPermissions())
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(macFile, macBytes, 0644); err != nil {
+		os.Remove(macFile)
+		return err
+	}
+	return nil
+}
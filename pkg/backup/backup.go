@@ -0,0 +1,407 @@
+// Package backup implements an encrypted, streamable archive format made of
+// a sequence of named entries, each holding the snapshot of a store.
+//
+// The archive starts with a header containing the parameters for deriving
+// the encryption key from a password. The rest of the stream is a sequence
+// of chunks encrypted with AES-GCM, each made of a 1-byte flag marking the
+// last chunk, the 4-byte length of the ciphertext and the ciphertext itself.
+// The nonce of every chunk is made of a random prefix and a counter, which
+// prevents chunks from being reordered, while the flag prevents the archive
+// from being truncated without being noticed.
+//
+// Once decrypted, every entry is made of its kind and its name, followed by
+// its content split in length-prefixed frames and terminated by an empty one.
+// An entry with an empty kind marks the end of the archive.
+package backup
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcwallet/snacl"
+)
+
+const (
+	magic           = "TDEXBAK\x01"
+	noncePrefixSize = 4
+	chunkSize       = 64 * 1024
+	maxStringLen    = 4096
+)
+
+var (
+	// ErrInvalidPassword is returned when trying to read a backup with the
+	// wrong password.
+	ErrInvalidPassword = errors.New("invalid backup password")
+	// ErrMalformed is returned when reading a backup that is not valid or has
+	// been tampered.
+	ErrMalformed = errors.New("malformed backup")
+)
+
+// Writer writes an encrypted backup to the underlying writer. The backup is
+// valid only once the writer is closed.
+type Writer struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint64
+	buf     []byte
+	closed  bool
+}
+
+// NewWriter returns a Writer for a backup encrypted with the given password.
+func NewWriter(w io.Writer, password []byte) (*Writer, error) {
+	pwd := append([]byte{}, password...)
+	key, err := snacl.NewSecretKey(
+		&pwd, snacl.DefaultN, snacl.DefaultR, snacl.DefaultP,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Zero()
+
+	aead, err := newAEAD(key.Key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce[:noncePrefixSize]); err != nil {
+		return nil, err
+	}
+
+	params := key.Marshal()
+	header := make([]byte, 0, len(magic)+2+len(params)+noncePrefixSize)
+	header = append(header, magic...)
+	header = append(header, byte(len(params)>>8), byte(len(params)))
+	header = append(header, params...)
+	header = append(header, nonce[:noncePrefixSize]...)
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		w:     w,
+		aead:  aead,
+		nonce: nonce,
+		buf:   make([]byte, 0, chunkSize),
+	}, nil
+}
+
+// WriteEntry adds an entry to the backup, whose content is written by fn.
+func (w *Writer) WriteEntry(
+	name, kind string, fn func(w io.Writer) error,
+) error {
+	if w.closed {
+		return fmt.Errorf("backup writer is closed")
+	}
+	if kind == "" {
+		return fmt.Errorf("missing entry kind")
+	}
+	if err := w.writeString(kind); err != nil {
+		return err
+	}
+	if err := w.writeString(name); err != nil {
+		return err
+	}
+	if err := fn(entryWriter{w}); err != nil {
+		return err
+	}
+	return w.writeUvarint(0)
+}
+
+// Close marks the end of the backup and flushes all pending data. It doesn't
+// close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	if err := w.writeUvarint(0); err != nil {
+		return err
+	}
+	w.closed = true
+	return w.flush(true)
+}
+
+func (w *Writer) write(p []byte) error {
+	for len(p) > 0 {
+		n := chunkSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		if len(w.buf) == chunkSize {
+			if err := w.flush(false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *Writer) writeUvarint(n uint64) error {
+	var buf [binary.MaxVarintLen64]byte
+	return w.write(buf[:binary.PutUvarint(buf[:], n)])
+}
+
+func (w *Writer) writeString(s string) error {
+	if len(s) > maxStringLen {
+		return fmt.Errorf("string exceeds max length %d", maxStringLen)
+	}
+	if err := w.writeUvarint(uint64(len(s))); err != nil {
+		return err
+	}
+	return w.write([]byte(s))
+}
+
+func (w *Writer) flush(last bool) error {
+	flag := []byte{0}
+	if last {
+		flag[0] = 1
+	}
+	binary.BigEndian.PutUint64(w.nonce[noncePrefixSize:], w.counter)
+	w.counter++
+
+	ciphertext := w.aead.Seal(nil, w.nonce, w.buf, flag)
+	chunk := make([]byte, 5, 5+len(ciphertext))
+	chunk[0] = flag[0]
+	binary.BigEndian.PutUint32(chunk[1:], uint32(len(ciphertext)))
+	chunk = append(chunk, ciphertext...)
+	w.buf = w.buf[:0]
+
+	_, err := w.w.Write(chunk)
+	return err
+}
+
+type entryWriter struct {
+	w *Writer
+}
+
+func (e entryWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := e.w.writeUvarint(uint64(len(p))); err != nil {
+		return 0, err
+	}
+	if err := e.w.write(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Reader reads the entries of an encrypted backup.
+type Reader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	nonce   []byte
+	counter uint64
+	buf     []byte
+	last    bool
+
+	inEntry   bool
+	frameLeft uint64
+	done      bool
+}
+
+// NewReader returns a Reader for a backup encrypted with the given password.
+// It returns ErrInvalidPassword if the password is wrong.
+func NewReader(r io.Reader, password []byte) (*Reader, error) {
+	header := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, ErrMalformed
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, ErrMalformed
+	}
+	params := make([]byte, int(header[len(magic)])<<8|int(header[len(magic)+1]))
+	if _, err := io.ReadFull(r, params); err != nil {
+		return nil, ErrMalformed
+	}
+
+	var key snacl.SecretKey
+	if err := key.Unmarshal(params); err != nil {
+		return nil, ErrMalformed
+	}
+	pwd := append([]byte{}, password...)
+	if err := key.DeriveKey(&pwd); err != nil {
+		if err == snacl.ErrInvalidPassword {
+			return nil, ErrInvalidPassword
+		}
+		return nil, err
+	}
+	defer key.Zero()
+
+	aead, err := newAEAD(key.Key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(r, nonce[:noncePrefixSize]); err != nil {
+		return nil, ErrMalformed
+	}
+
+	return &Reader{r: r, aead: aead, nonce: nonce}, nil
+}
+
+// Next advances to the next entry of the backup, skipping what's left of the
+// current one, and returns its name and kind. It returns io.EOF once the end
+// of the backup is reached.
+func (r *Reader) Next() (name, kind string, err error) {
+	if r.done {
+		return "", "", io.EOF
+	}
+	if r.inEntry {
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			return "", "", err
+		}
+	}
+
+	if kind, err = r.readString(); err != nil {
+		return
+	}
+	if kind == "" {
+		r.done = true
+		// Nothing is expected after the end of the backup.
+		if _, err := r.readByte(); err != io.EOF {
+			return "", "", ErrMalformed
+		}
+		return "", "", io.EOF
+	}
+	if name, err = r.readString(); err != nil {
+		return
+	}
+
+	r.inEntry = true
+	r.frameLeft = 0
+	return name, kind, nil
+}
+
+// Read reads the content of the current entry. It returns io.EOF at the end
+// of the entry.
+func (r *Reader) Read(p []byte) (int, error) {
+	if !r.inEntry {
+		return 0, io.EOF
+	}
+	if r.frameLeft == 0 {
+		n, err := binary.ReadUvarint(byteReader{r})
+		if err != nil {
+			return 0, unexpectedEOF(err)
+		}
+		if n == 0 {
+			r.inEntry = false
+			return 0, io.EOF
+		}
+		r.frameLeft = n
+	}
+
+	if uint64(len(p)) > r.frameLeft {
+		p = p[:r.frameLeft]
+	}
+	n, err := r.read(p)
+	r.frameLeft -= uint64(n)
+	return n, unexpectedEOF(err)
+}
+
+func (r *Reader) readString() (string, error) {
+	n, err := binary.ReadUvarint(byteReader{r})
+	if err != nil {
+		return "", unexpectedEOF(err)
+	}
+	if n > maxStringLen {
+		return "", ErrMalformed
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(readerFunc(r.read), buf); err != nil {
+		return "", unexpectedEOF(err)
+	}
+	return string(buf), nil
+}
+
+func (r *Reader) readByte() (byte, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(readerFunc(r.read), b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// read reads decrypted data, regardless of the entries' framing.
+func (r *Reader) read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.last {
+			return 0, io.EOF
+		}
+		if err := r.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *Reader) readChunk() error {
+	var header [5]byte
+	if _, err := io.ReadFull(r.r, header[:]); err != nil {
+		return ErrMalformed
+	}
+	flag := header[0]
+	if flag > 1 {
+		return ErrMalformed
+	}
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > chunkSize+uint32(r.aead.Overhead()) {
+		return ErrMalformed
+	}
+	ciphertext := make([]byte, size)
+	if _, err := io.ReadFull(r.r, ciphertext); err != nil {
+		return ErrMalformed
+	}
+
+	binary.BigEndian.PutUint64(r.nonce[noncePrefixSize:], r.counter)
+	r.counter++
+
+	plaintext, err := r.aead.Open(nil, r.nonce, ciphertext, header[:1])
+	if err != nil {
+		return ErrMalformed
+	}
+	r.buf = plaintext
+	r.last = flag == 1
+	return nil
+}
+
+type byteReader struct {
+	r *Reader
+}
+
+func (b byteReader) ReadByte() (byte, error) {
+	return b.r.readByte()
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return ErrMalformed
+	}
+	return err
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,111 @@
+package backup_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/pkg/backup"
+)
+
+var password = []byte("password")
+
+func TestBackup(t *testing.T) {
+	entries := []struct {
+		name, kind string
+		content    []byte
+	}{
+		{"markets", "badger", randomBytes(100)},
+		{"empty", "file", nil},
+		// Larger than a single chunk.
+		{"trades", "badger", randomBytes(200 * 1024)},
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := backup.NewWriter(buf, password)
+	require.NoError(t, err)
+	for _, e := range entries {
+		content := e.content
+		err := w.WriteEntry(e.name, e.kind, func(w io.Writer) error {
+			_, err := w.Write(content)
+			return err
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	data := buf.Bytes()
+
+	t.Run("valid", func(t *testing.T) {
+		r, err := backup.NewReader(bytes.NewReader(data), password)
+		require.NoError(t, err)
+
+		for _, e := range entries {
+			name, kind, err := r.Next()
+			require.NoError(t, err)
+			require.Equal(t, e.name, name)
+			require.Equal(t, e.kind, kind)
+
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, len(e.content), len(content))
+			require.True(t, bytes.Equal(e.content, content))
+		}
+
+		_, _, err = r.Next()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("skip_entries", func(t *testing.T) {
+		r, err := backup.NewReader(bytes.NewReader(data), password)
+		require.NoError(t, err)
+
+		for range entries {
+			_, _, err := r.Next()
+			require.NoError(t, err)
+		}
+		_, _, err = r.Next()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := backup.NewReader(bytes.NewReader(data), []byte("wrong"))
+		require.Equal(t, backup.ErrInvalidPassword, err)
+
+		_, err = backup.NewReader(bytes.NewReader(randomBytes(100)), password)
+		require.Equal(t, backup.ErrMalformed, err)
+
+		// Truncated or tampered backups are detected while reading the entries.
+		tampered := append([]byte{}, data...)
+		tampered[len(tampered)-1] ^= 1
+		for _, d := range [][]byte{data[:len(data)-100], tampered} {
+			r, err := backup.NewReader(bytes.NewReader(d), password)
+			require.NoError(t, err)
+
+			err = readAll(r)
+			require.Equal(t, backup.ErrMalformed, err)
+		}
+	})
+}
+
+func readAll(r *backup.Reader) error {
+	for {
+		if _, _, err := r.Next(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if _, err := io.ReadAll(r); err != nil {
+			return err
+		}
+	}
+}
+
+func randomBytes(len int) []byte {
+	b := make([]byte, len)
+	//nolint
+	rand.Read(b)
+	return b
+}
@@ -0,0 +1,115 @@
+package binancefeeder
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	baseUrl = "stream.binance.com:9443/ws"
+
+	subscribeId   = 1
+	unsubscribeId = 2
+)
+
+// tickerRegexp matches the symbols of the binance markets, like BTCUSDT.
+var tickerRegexp = regexp.MustCompile(`^[A-Z0-9]+$`)
+
+// handler parses the messages of the binance websocket API, the connection is
+// managed by pricefeeder.NewWebsocketFeeder. Prices come from the individual
+// symbol ticker streams, named after the lowercase symbol of the market.
+type handler struct{}
+
+func NewService() (pricefeeder.PriceFeeder, error) {
+	return pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Name:    "binance",
+		Url:     fmt.Sprintf("wss://%s", baseUrl),
+		Handler: handler{},
+	})
+}
+
+// ValidateTicker returns an error if the given ticker is not the symbol of a
+// binance market, like BTCUSDT.
+func ValidateTicker(ticker string) error {
+	if !tickerRegexp.MatchString(ticker) {
+		return fmt.Errorf("ticker must be an uppercase market symbol like BTCUSDT")
+	}
+	return nil
+}
+
+func (h handler) SubscribeMsgs(tickers []string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"method": "SUBSCRIBE",
+			"params": streams(tickers),
+			"id":     subscribeId,
+		},
+	}
+}
+
+func (h handler) UnsubscribeMsgs(tickers []string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"method": "UNSUBSCRIBE",
+			"params": streams(tickers),
+			"id":     unsubscribeId,
+		},
+	}
+}
+
+func (h handler) ParseMsg(buf []byte) (string, *pricefeeder.Price) {
+	// Keys are case sensitive, like "e" for the event type and "E" for the
+	// event time, hence the message can't be decoded into a struct.
+	msg := make(map[string]interface{})
+	if err := json.Unmarshal(buf, &msg); err != nil {
+		return "", nil
+	}
+	if e, ok := msg["error"].(map[string]interface{}); ok {
+		log.Warnf("error from binance: %v %v", e["code"], e["msg"])
+		return "", nil
+	}
+	if e, ok := msg["e"].(string); !ok || e != "24hrTicker" {
+		return "", nil
+	}
+	ticker, ok := msg["s"].(string)
+	if !ok || ticker == "" {
+		return "", nil
+	}
+	priceStr, ok := msg["c"].(string)
+	if !ok {
+		return "", nil
+	}
+
+	quotePrice, err := decimal.NewFromString(priceStr)
+	if err != nil || !quotePrice.IsPositive() {
+		return "", nil
+	}
+	basePrice := decimal.NewFromInt(1).Div(quotePrice).Round(8)
+
+	// The volume of the ticker stream refers to the last 24 hours.
+	var volume decimal.Decimal
+	if volumeStr, ok := msg["v"].(string); ok {
+		volume, _ = decimal.NewFromString(volumeStr)
+	}
+
+	return ticker, &pricefeeder.Price{
+		BasePrice:  basePrice,
+		QuotePrice: quotePrice,
+		Volume:     volume,
+	}
+}
+
+func streams(tickers []string) []string {
+	list := make([]string, 0, len(tickers))
+	for _, ticker := range tickers {
+		list = append(list, fmt.Sprintf("%s@ticker", strings.ToLower(ticker)))
+	}
+	return list
+}
@@ -0,0 +1,11 @@
+package bitfinexfeeder
+
+import (
+	"testing"
+
+	"github.com/tdex-network/tdex-daemon/pkg/price-feeder/pricefeedertest"
+)
+
+func TestHandler(t *testing.T) {
+	pricefeedertest.RunHandlerFixtures(t, newHandler(), "testdata/fixtures.json")
+}
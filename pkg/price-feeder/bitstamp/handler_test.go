@@ -0,0 +1,24 @@
+package bitstampfeeder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/pkg/price-feeder/pricefeedertest"
+)
+
+var invalidTickers = []string{"", "BTCUSD", "btc/usd", "btc_usd"}
+
+func TestHandler(t *testing.T) {
+	pricefeedertest.RunHandlerFixtures(t, handler{}, "testdata/fixtures.json")
+}
+
+func TestValidateTicker(t *testing.T) {
+	f := pricefeedertest.LoadFixtures(t, "testdata/fixtures.json")
+	for _, ticker := range f.Tickers() {
+		require.NoError(t, ValidateTicker(ticker))
+	}
+	for _, ticker := range invalidTickers {
+		require.Error(t, ValidateTicker(ticker), ticker)
+	}
+}
@@ -0,0 +1,116 @@
+package bitstampfeeder
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	baseUrl = "ws.bitstamp.net"
+
+	channelPrefix = "live_trades_"
+)
+
+// tickerRegexp matches the pairs of the bitstamp markets, like btcusd.
+var tickerRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// handler parses the messages of the bitstamp websocket API, the connection
+// is managed by pricefeeder.NewWebsocketFeeder. Bitstamp has no ticker
+// channel, prices are those of the trades of the live trades channels.
+type handler struct{}
+
+func NewService() (pricefeeder.PriceFeeder, error) {
+	return pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Name:    "bitstamp",
+		Url:     fmt.Sprintf("wss://%s", baseUrl),
+		Handler: handler{},
+	})
+}
+
+// ValidateTicker returns an error if the given ticker is not the pair of a
+// bitstamp market, like btcusd.
+func ValidateTicker(ticker string) error {
+	if !tickerRegexp.MatchString(ticker) {
+		return fmt.Errorf("ticker must be a lowercase market pair like btcusd")
+	}
+	return nil
+}
+
+func (h handler) SubscribeMsgs(tickers []string) []interface{} {
+	return channelMsgs("bts:subscribe", tickers)
+}
+
+func (h handler) UnsubscribeMsgs(tickers []string) []interface{} {
+	return channelMsgs("bts:unsubscribe", tickers)
+}
+
+func (h handler) ParseMsg(buf []byte) (string, *pricefeeder.Price) {
+	var msg struct {
+		Event   string          `json:"event"`
+		Channel string          `json:"channel"`
+		Data    json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(buf, &msg); err != nil {
+		return "", nil
+	}
+
+	switch msg.Event {
+	case "bts:error":
+		var data struct {
+			Code    interface{} `json:"code"`
+			Message string      `json:"message"`
+		}
+		// nolint
+		json.Unmarshal(msg.Data, &data)
+		log.Warnf("error from bitstamp: %v %s", data.Code, data.Message)
+		return "", nil
+	case "trade":
+	default:
+		return "", nil
+	}
+
+	if !strings.HasPrefix(msg.Channel, channelPrefix) {
+		return "", nil
+	}
+	ticker := strings.TrimPrefix(msg.Channel, channelPrefix)
+
+	var trade struct {
+		Price string `json:"price_str"`
+	}
+	if err := json.Unmarshal(msg.Data, &trade); err != nil {
+		return "", nil
+	}
+
+	quotePrice, err := decimal.NewFromString(trade.Price)
+	if err != nil || !quotePrice.IsPositive() {
+		return "", nil
+	}
+	basePrice := decimal.NewFromInt(1).Div(quotePrice).Round(8)
+
+	// Trades carry no 24h volume, hence composite price feeds weighting
+	// prices by volume don't take into account this source.
+	return ticker, &pricefeeder.Price{
+		BasePrice:  basePrice,
+		QuotePrice: quotePrice,
+	}
+}
+
+func channelMsgs(event string, tickers []string) []interface{} {
+	msgs := make([]interface{}, 0, len(tickers))
+	for _, ticker := range tickers {
+		msgs = append(msgs, map[string]interface{}{
+			"event": event,
+			"data": map[string]string{
+				"channel": fmt.Sprintf("%s%s", channelPrefix, ticker),
+			},
+		})
+	}
+	return msgs
+}
@@ -0,0 +1,59 @@
+package pricefeeder
+
+import (
+	"sync"
+	"time"
+)
+
+// Health reports the state of the connection of a price feeder with its
+// source.
+type Health struct {
+	// Reconnections is the number of times the connection with the source
+	// has been restored.
+	Reconnections uint64
+	// LastError is the last error occurred with the source, if any.
+	LastError     string
+	LastErrorTime time.Time
+}
+
+// HealthReporter is optionally implemented by the price feeders able to report
+// the health of their connection with the source.
+type HealthReporter interface {
+	Health() Health
+}
+
+// HealthTracker is a concurrency-safe Health that price feeders can update as
+// events happen and expose through HealthReporter.
+type HealthTracker struct {
+	lock   *sync.RWMutex
+	health Health
+}
+
+func NewHealthTracker() *HealthTracker {
+	return &HealthTracker{lock: &sync.RWMutex{}}
+}
+
+func (t *HealthTracker) Health() Health {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.health
+}
+
+// AddReconnection records that the connection with the source was restored.
+func (t *HealthTracker) AddReconnection() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.health.Reconnections++
+}
+
+// SetError records the given error as the last one occurred with the source.
+func (t *HealthTracker) SetError(err error) {
+	if err == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.health.LastError = err.Error()
+	t.health.LastErrorTime = time.Now()
+}
@@ -0,0 +1,11 @@
+package krakenfeeder
+
+import (
+	"testing"
+
+	"github.com/tdex-network/tdex-daemon/pkg/price-feeder/pricefeedertest"
+)
+
+func TestHandler(t *testing.T) {
+	pricefeedertest.RunHandlerFixtures(t, handler{}, "testdata/fixtures.json")
+}
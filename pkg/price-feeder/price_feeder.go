@@ -1,9 +1,17 @@
 package pricefeeder
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
+// DefaultRefreshInterval is the default max time a price feeder withholds an
+// unchanged price. The price feeders drop the prices equal to the last ones,
+// but send them again at least this often while the source confirms them,
+// so that consumers can tell a quiet market from a dead source.
+const DefaultRefreshInterval = 20 * time.Second
+
 type PriceFeed struct {
 	Market Market
 	Price  Price
@@ -13,11 +21,18 @@ type Market struct {
 	BaseAsset  string
 	QuoteAsset string
 	Ticker     string
+	// Headers are the request headers, like API keys, required by some sources
+	// to fetch the price of the ticker. These are kept apart from the ticker so
+	// that the latter never exposes them.
+	Headers map[string]string
 }
 
 type Price struct {
 	BasePrice  decimal.Decimal
 	QuotePrice decimal.Decimal
+	// Volume is the volume traded in the last 24h for the ticker, expressed in
+	// base asset. It's zero if not provided by the source.
+	Volume decimal.Decimal
 }
 
 type PriceFeeder interface {
@@ -28,3 +43,9 @@ type PriceFeeder interface {
 	UnsubscribeMarkets([]Market) error
 	ListSubscriptions() []Market
 }
+
+// PricePusher is optionally implemented by the price feeders that let the
+// operator set the price of a subscribed market on demand.
+type PricePusher interface {
+	PushPrice(ticker string, price Price) error
+}
@@ -0,0 +1,114 @@
+// Package pricefeedertest checks the exchange specific handlers of websocket
+// price feeders against messages recorded from the exchange APIs.
+package pricefeedertest
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+)
+
+// Fixtures are the messages a handler is expected to send to subscribe and
+// unsubscribe tickers, and those recorded from the websocket API of an
+// exchange along with the price a handler is expected to parse, if any.
+type Fixtures struct {
+	Subscribe   []MsgsFixture `json:"subscribe"`
+	Unsubscribe []MsgsFixture `json:"unsubscribe"`
+	Messages    []struct {
+		Description string          `json:"description"`
+		Msg         json.RawMessage `json:"msg"`
+		Ticker      string          `json:"ticker"`
+		BasePrice   string          `json:"basePrice"`
+		QuotePrice  string          `json:"quotePrice"`
+		Volume      string          `json:"volume"`
+	} `json:"messages"`
+}
+
+// MsgsFixture are the messages a handler is expected to send for the given
+// tickers.
+type MsgsFixture struct {
+	Tickers []string          `json:"tickers"`
+	Msgs    []json.RawMessage `json:"msgs"`
+}
+
+// Tickers returns the tickers of the subscribe fixtures.
+func (f Fixtures) Tickers() []string {
+	tickers := make([]string, 0)
+	for _, s := range f.Subscribe {
+		tickers = append(tickers, s.Tickers...)
+	}
+	return tickers
+}
+
+// LoadFixtures reads the fixtures from the given JSON file.
+func LoadFixtures(t *testing.T, path string) Fixtures {
+	file, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var f Fixtures
+	require.NoError(t, json.Unmarshal(file, &f))
+	return f
+}
+
+// RunHandlerFixtures checks the given handler against the fixtures read from
+// the given JSON file. The fixtures are run in order, subscriptions first and
+// unsubscriptions last, so that handlers keeping track of the subscriptions
+// confirmed by the server can be tested too.
+func RunHandlerFixtures(
+	t *testing.T, h pricefeeder.WebsocketHandler, path string,
+) {
+	f := LoadFixtures(t, path)
+
+	t.Run("subscribe", func(t *testing.T) {
+		for _, tt := range f.Subscribe {
+			requireMsgs(t, tt.Msgs, h.SubscribeMsgs(tt.Tickers))
+		}
+	})
+
+	for _, tt := range f.Messages {
+		tt := tt
+		t.Run(tt.Description, func(t *testing.T) {
+			ticker, price := h.ParseMsg(tt.Msg)
+			if tt.Ticker == "" {
+				require.Empty(t, ticker)
+				require.Nil(t, price)
+				return
+			}
+
+			require.Equal(t, tt.Ticker, ticker)
+			require.NotNil(t, price)
+			requireDecimal(t, tt.BasePrice, price.BasePrice)
+			requireDecimal(t, tt.QuotePrice, price.QuotePrice)
+			requireDecimal(t, tt.Volume, price.Volume)
+		})
+	}
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		for _, tt := range f.Unsubscribe {
+			requireMsgs(t, tt.Msgs, h.UnsubscribeMsgs(tt.Tickers))
+		}
+	})
+}
+
+func requireMsgs(t *testing.T, expected []json.RawMessage, msgs []interface{}) {
+	require.Len(t, msgs, len(expected))
+	for i, msg := range msgs {
+		buf, err := json.Marshal(msg)
+		require.NoError(t, err)
+		require.JSONEq(t, string(expected[i]), string(buf))
+	}
+}
+
+func requireDecimal(t *testing.T, expected string, actual decimal.Decimal) {
+	if expected == "" {
+		require.True(t, actual.IsZero(), actual.String())
+		return
+	}
+	require.True(
+		t, decimal.RequireFromString(expected).Equal(actual),
+		"expected %s, got %s", expected, actual,
+	)
+}
@@ -0,0 +1,250 @@
+package restfeeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxResponseSize is the max number of bytes read from a response body.
+const maxResponseSize = 1 << 20
+
+type service struct {
+	lock    *sync.RWMutex
+	pollers map[string]*poller
+	stopped bool
+
+	wg     *sync.WaitGroup
+	feedCh chan pricefeeder.PriceFeed
+
+	health *pricefeeder.HealthTracker
+}
+
+// poller periodically requests the price of a market to the endpoint defined
+// by its ticker.
+type poller struct {
+	market pricefeeder.Market
+	ticker Ticker
+	client *http.Client
+	cancel context.CancelFunc
+}
+
+// NewService returns a price feeder polling the http endpoints defined by the
+// tickers of the subscribed markets, see Ticker.
+func NewService() (pricefeeder.PriceFeeder, error) {
+	return &service{
+		lock:    &sync.RWMutex{},
+		pollers: make(map[string]*poller),
+		wg:      &sync.WaitGroup{},
+		feedCh:  make(chan pricefeeder.PriceFeed, 20),
+		health:  pricefeeder.NewHealthTracker(),
+	}, nil
+}
+
+func (s *service) Start() chan pricefeeder.PriceFeed {
+	return s.feedCh
+}
+
+func (s *service) Stop() {
+	s.lock.Lock()
+	if s.stopped {
+		s.lock.Unlock()
+		return
+	}
+	s.stopped = true
+	for ticker, p := range s.pollers {
+		p.cancel()
+		delete(s.pollers, ticker)
+	}
+	s.lock.Unlock()
+
+	s.wg.Wait()
+	close(s.feedCh)
+}
+
+func (s *service) SubscribeMarkets(markets []pricefeeder.Market) error {
+	tickers := make([]*Ticker, 0, len(markets))
+	for _, mkt := range markets {
+		ticker, err := ParseTicker(mkt.Ticker)
+		if err != nil {
+			return err
+		}
+		tickers = append(tickers, ticker)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stopped {
+		return fmt.Errorf("service is stopped")
+	}
+
+	for i, mkt := range markets {
+		if _, ok := s.pollers[mkt.Ticker]; ok {
+			continue
+		}
+
+		// The headers of the market are added to those of the ticker, if any,
+		// and are not retained by the subscribed market.
+		ticker := *tickers[i]
+		if len(mkt.Headers) > 0 {
+			headers := make(map[string]string)
+			for key, value := range ticker.Headers {
+				headers[key] = value
+			}
+			for key, value := range mkt.Headers {
+				headers[key] = value
+			}
+			ticker.Headers = headers
+			mkt.Headers = nil
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := &poller{
+			market: mkt,
+			ticker: ticker,
+			client: &http.Client{Timeout: ticker.timeout()},
+			cancel: cancel,
+		}
+		s.pollers[mkt.Ticker] = p
+
+		s.wg.Add(1)
+		go s.poll(ctx, p)
+	}
+	return nil
+}
+
+func (s *service) UnsubscribeMarkets(markets []pricefeeder.Market) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, mkt := range markets {
+		if p, ok := s.pollers[mkt.Ticker]; ok {
+			p.cancel()
+			delete(s.pollers, mkt.Ticker)
+		}
+	}
+	return nil
+}
+
+func (s *service) ListSubscriptions() []pricefeeder.Market {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	markets := make([]pricefeeder.Market, 0, len(s.pollers))
+	for _, p := range s.pollers {
+		markets = append(markets, p.market)
+	}
+	return markets
+}
+
+func (s *service) Health() pricefeeder.Health {
+	return s.health.Health()
+}
+
+func (s *service) poll(ctx context.Context, p *poller) {
+	defer s.wg.Done()
+
+	t := time.NewTicker(p.ticker.interval())
+	defer t.Stop()
+
+	var lastPrice *pricefeeder.Price
+	var lastSent time.Time
+	for {
+		price, err := p.fetchPrice(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			s.health.SetError(err)
+			log.WithError(err).Debugf(
+				"rest: failed to fetch price from %s", p.ticker.Url,
+			)
+		}
+
+		// Prevent updating a feed if it hasn't changed, unless the unchanged
+		// price, confirmed by the endpoint, hasn't been sent for a while.
+		if price != nil &&
+			(lastPrice == nil || !price.QuotePrice.Equal(lastPrice.QuotePrice) ||
+				time.Since(lastSent) >= pricefeeder.DefaultRefreshInterval) {
+			lastPrice, lastSent = price, time.Now()
+			select {
+			case s.feedCh <- pricefeeder.PriceFeed{Market: p.market, Price: *price}:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *poller) fetchPrice(ctx context.Context) (*pricefeeder.Price, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.ticker.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for key, value := range p.ticker.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	dec := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %s", err)
+	}
+
+	return parsePrice(doc, p.ticker)
+}
+
+func parsePrice(doc interface{}, ticker Ticker) (*pricefeeder.Price, error) {
+	var basePrice, quotePrice decimal.Decimal
+	var err error
+	if ticker.BasePricePath != "" {
+		if basePrice, err = extractPrice(doc, ticker.BasePricePath); err != nil {
+			return nil, err
+		}
+	}
+	if ticker.QuotePricePath != "" {
+		if quotePrice, err = extractPrice(doc, ticker.QuotePricePath); err != nil {
+			return nil, err
+		}
+	}
+
+	if basePrice.IsZero() {
+		basePrice = decimal.NewFromInt(1).Div(quotePrice).Round(8)
+	}
+	if quotePrice.IsZero() {
+		quotePrice = decimal.NewFromInt(1).Div(basePrice).Round(8)
+	}
+
+	return &pricefeeder.Price{
+		BasePrice:  basePrice,
+		QuotePrice: quotePrice,
+	}, nil
+}
@@ -0,0 +1,242 @@
+package restfeeder_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+	restfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/rest"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKey = "secret"
+
+func TestService(t *testing.T) {
+	server := newPriceServer()
+	defer server.Close()
+
+	feederSvc, err := restfeeder.NewService()
+	require.NoError(t, err)
+	require.NotNil(t, feederSvc)
+
+	feedCh := feederSvc.Start()
+	require.NotNil(t, feedCh)
+
+	quoteMarket := pricefeeder.Market{
+		BaseAsset:  "base",
+		QuoteAsset: "quote",
+		Ticker: newTicker(t, restfeeder.Ticker{
+			Url:            server.URL + "/rates",
+			QuotePricePath: "$.data.rates[1].price",
+			Headers:        map[string]string{"X-Api-Key": apiKey},
+			Interval:       1,
+		}),
+	}
+	// Headers can be also passed apart from the ticker.
+	baseMarket := pricefeeder.Market{
+		BaseAsset:  "base",
+		QuoteAsset: "quote",
+		Ticker: newTicker(t, restfeeder.Ticker{
+			Url:           server.URL + "/rates",
+			BasePricePath: "data.inverse",
+		}),
+		Headers: map[string]string{"X-Api-Key": apiKey},
+	}
+
+	err = feederSvc.SubscribeMarkets(
+		[]pricefeeder.Market{quoteMarket, baseMarket},
+	)
+	require.NoError(t, err)
+	require.Len(t, feederSvc.ListSubscriptions(), 2)
+
+	feedsByTicker := make(map[string]pricefeeder.PriceFeed)
+	for i := 0; i < 2; i++ {
+		feed := <-feedCh
+		feedsByTicker[feed.Market.Ticker] = feed
+	}
+
+	feed := feedsByTicker[quoteMarket.Ticker]
+	require.Equal(t, quoteMarket, feed.Market)
+	require.True(t, decimal.NewFromInt(20000).Equal(feed.Price.QuotePrice))
+	require.True(t, decimal.RequireFromString("0.00005").Equal(feed.Price.BasePrice))
+
+	feed = feedsByTicker[baseMarket.Ticker]
+	require.Equal(t, baseMarket.Ticker, feed.Market.Ticker)
+	require.Nil(t, feed.Market.Headers)
+	require.True(t, decimal.RequireFromString("0.5").Equal(feed.Price.BasePrice))
+	require.True(t, decimal.NewFromInt(2).Equal(feed.Price.QuotePrice))
+
+	// Only changed prices are notified.
+	server.setPrice("21000")
+	feed = <-feedCh
+	require.Equal(t, quoteMarket.Ticker, feed.Market.Ticker)
+	require.True(t, decimal.NewFromInt(21000).Equal(feed.Price.QuotePrice))
+
+	err = feederSvc.UnsubscribeMarkets([]pricefeeder.Market{quoteMarket})
+	require.NoError(t, err)
+	require.Len(t, feederSvc.ListSubscriptions(), 1)
+
+	feederSvc.Stop()
+	for range feedCh {
+	}
+}
+
+func TestSplitHeaders(t *testing.T) {
+	ticker := newTicker(t, restfeeder.Ticker{
+		Url:            "https://example.com/rates",
+		QuotePricePath: "$.data.price",
+		Headers:        map[string]string{"X-Api-Key": apiKey},
+	})
+
+	tickerWithoutHeaders, headers, err := restfeeder.SplitHeaders(ticker)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"X-Api-Key": apiKey}, headers)
+	require.NotContains(t, tickerWithoutHeaders, apiKey)
+
+	parsed, err := restfeeder.ParseTicker(tickerWithoutHeaders)
+	require.NoError(t, err)
+	require.Empty(t, parsed.Headers)
+	require.Equal(t, "https://example.com/rates", parsed.Url)
+	require.Equal(t, "$.data.price", parsed.QuotePricePath)
+
+	// A ticker without headers is returned unchanged.
+	got, headers, err := restfeeder.SplitHeaders(tickerWithoutHeaders)
+	require.NoError(t, err)
+	require.Empty(t, headers)
+	require.Equal(t, tickerWithoutHeaders, got)
+
+	_, _, err = restfeeder.SplitHeaders("XBT/USDT")
+	require.Error(t, err)
+}
+
+func TestFailingService(t *testing.T) {
+	feederSvc, err := restfeeder.NewService()
+	require.NoError(t, err)
+	defer feederSvc.Stop()
+
+	tests := []struct {
+		name   string
+		ticker string
+	}{
+		{
+			name:   "not json",
+			ticker: "XBT/USDT",
+		},
+		{
+			name:   "missing url",
+			ticker: `{"quote_price_path": "price"}`,
+		},
+		{
+			name:   "invalid url scheme",
+			ticker: `{"url": "ftp://example.com", "quote_price_path": "price"}`,
+		},
+		{
+			name:   "missing price paths",
+			ticker: `{"url": "https://example.com"}`,
+		},
+		{
+			name:   "malformed path",
+			ticker: `{"url": "https://example.com", "quote_price_path": "a[x]"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := feederSvc.SubscribeMarkets([]pricefeeder.Market{
+				{BaseAsset: "base", QuoteAsset: "quote", Ticker: tt.ticker},
+			})
+			require.Error(t, err)
+		})
+	}
+	require.Empty(t, feederSvc.ListSubscriptions())
+}
+
+func TestServiceHealth(t *testing.T) {
+	server := newPriceServer()
+	defer server.Close()
+
+	feederSvc, err := restfeeder.NewService()
+	require.NoError(t, err)
+	defer feederSvc.Stop()
+
+	reporter, ok := feederSvc.(pricefeeder.HealthReporter)
+	require.True(t, ok)
+	require.Empty(t, reporter.Health().LastError)
+
+	// Requests without api key are rejected by the server.
+	err = feederSvc.SubscribeMarkets([]pricefeeder.Market{
+		{
+			BaseAsset:  "base",
+			QuoteAsset: "quote",
+			Ticker: newTicker(t, restfeeder.Ticker{
+				Url:            server.URL + "/rates",
+				QuotePricePath: "$.data.rates[1].price",
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return reporter.Health().LastError != ""
+	}, 5*time.Second, 50*time.Millisecond)
+	require.False(t, reporter.Health().LastErrorTime.IsZero())
+	require.Zero(t, reporter.Health().Reconnections)
+}
+
+type priceServer struct {
+	*httptest.Server
+
+	lock  *sync.Mutex
+	price string
+}
+
+// newPriceServer returns a local stand-in of a price API, requiring an api
+// key header.
+func newPriceServer() *priceServer {
+	s := &priceServer{lock: &sync.Mutex{}, price: "20000"}
+	s.Server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Api-Key") != apiKey {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			s.lock.Lock()
+			price := s.price
+			s.lock.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{
+				"data": {
+					"rates": [
+						{"pair": "ETHUSD", "price": "1000"},
+						{"pair": "BTCUSD", "price": "%s"}
+					],
+					"inverse": 0.5
+				},
+				"timestamp": %d
+			}`, price, time.Now().Unix())
+		},
+	))
+	return s
+}
+
+func (s *priceServer) setPrice(price string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.price = price
+}
+
+func newTicker(t *testing.T, ticker restfeeder.Ticker) string {
+	buf, err := json.Marshal(ticker)
+	require.NoError(t, err)
+	return string(buf)
+}
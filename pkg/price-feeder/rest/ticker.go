@@ -0,0 +1,217 @@
+package restfeeder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultTimeout  = 5
+	defaultInterval = 10
+)
+
+// Ticker is the configuration of the endpoint to poll for the price of a
+// market. It's expected to be JSON encoded as the ticker of the subscribed
+// market, like for example:
+//
+//	{
+//	  "url": "https://api.example.com/v1/rates?pair=EURUSD",
+//	  "quote_price_path": "$.data.rates[0].price",
+//	  "headers": {"X-Api-Key": "secret"},
+//	  "timeout": 5,
+//	  "interval": 30
+//	}
+//
+// At least one between base and quote price paths is required, the missing
+// price is the inverse of the other one.
+//
+// Headers usually contain secrets like API keys, therefore these should be
+// split from the ticker with SplitHeaders and passed to the service along
+// with the subscribed market rather than within its ticker.
+type Ticker struct {
+	// Url is the http(s) endpoint returning a JSON document.
+	Url string `json:"url"`
+	// BasePricePath and QuotePricePath are the paths of the prices within the
+	// document, in the form $.key.nested_key[index]. The value can be either a
+	// JSON number or a string.
+	BasePricePath  string `json:"base_price_path,omitempty"`
+	QuotePricePath string `json:"quote_price_path,omitempty"`
+	// Headers are added to every request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// Timeout is the number of seconds after which a request is canceled,
+	// defaults to 5.
+	Timeout uint32 `json:"timeout,omitempty"`
+	// Interval is the number of seconds between two requests, defaults to 10.
+	Interval uint32 `json:"interval,omitempty"`
+}
+
+// ParseTicker decodes and validates the given JSON encoded ticker.
+func ParseTicker(ticker string) (*Ticker, error) {
+	t := &Ticker{}
+	if err := json.Unmarshal([]byte(ticker), t); err != nil {
+		return nil, fmt.Errorf("invalid ticker format: %s", err)
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// SplitHeaders removes the headers from the given JSON encoded ticker, and
+// returns them along with the resulting ticker. The ticker is returned
+// unchanged if it has no headers.
+func SplitHeaders(ticker string) (string, map[string]string, error) {
+	t, err := ParseTicker(ticker)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(t.Headers) <= 0 {
+		return ticker, nil, nil
+	}
+
+	headers := t.Headers
+	t.Headers = nil
+	buf, err := json.Marshal(t)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(buf), headers, nil
+}
+
+func (t Ticker) validate() error {
+	if t.Url == "" {
+		return fmt.Errorf("missing url")
+	}
+	u, err := url.Parse(t.Url)
+	if err != nil {
+		return fmt.Errorf("invalid url: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme must be either http or https")
+	}
+	if t.BasePricePath == "" && t.QuotePricePath == "" {
+		return fmt.Errorf("missing base and/or quote price path")
+	}
+	if t.BasePricePath != "" {
+		if _, err := parsePath(t.BasePricePath); err != nil {
+			return fmt.Errorf("invalid base price path: %s", err)
+		}
+	}
+	if t.QuotePricePath != "" {
+		if _, err := parsePath(t.QuotePricePath); err != nil {
+			return fmt.Errorf("invalid quote price path: %s", err)
+		}
+	}
+	return nil
+}
+
+func (t Ticker) timeout() time.Duration {
+	if t.Timeout == 0 {
+		return defaultTimeout * time.Second
+	}
+	return time.Duration(t.Timeout) * time.Second
+}
+
+func (t Ticker) interval() time.Duration {
+	if t.Interval == 0 {
+		return defaultInterval * time.Second
+	}
+	return time.Duration(t.Interval) * time.Second
+}
+
+// pathStep is either a key of an object or an index of an array.
+type pathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parsePath splits an expression like $.data.rates[0].price into its steps.
+// The leading $ is optional.
+func parsePath(path string) ([]pathStep, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if path == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	steps := make([]pathStep, 0)
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		indexes := ""
+		if i := strings.Index(part, "["); i >= 0 {
+			key, indexes = part[:i], part[i:]
+		}
+		if key == "" && indexes == "" {
+			return nil, fmt.Errorf("empty key")
+		}
+		if key != "" {
+			steps = append(steps, pathStep{key: key})
+		}
+
+		for len(indexes) > 0 {
+			end := strings.Index(indexes, "]")
+			if indexes[0] != '[' || end < 0 {
+				return nil, fmt.Errorf("malformed index in %s", part)
+			}
+			index, err := strconv.Atoi(indexes[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index in %s", part)
+			}
+			steps = append(steps, pathStep{index: index, isIndex: true})
+			indexes = indexes[end+1:]
+		}
+	}
+	return steps, nil
+}
+
+// extractPrice returns the positive price found at the given path of the
+// decoded JSON document.
+func extractPrice(doc interface{}, path string) (decimal.Decimal, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	value := doc
+	for _, step := range steps {
+		if step.isIndex {
+			list, ok := value.([]interface{})
+			if !ok || step.index >= len(list) {
+				return decimal.Zero, fmt.Errorf("index %d not found", step.index)
+			}
+			value = list[step.index]
+			continue
+		}
+
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return decimal.Zero, fmt.Errorf("key %s not found", step.key)
+		}
+		if value, ok = obj[step.key]; !ok {
+			return decimal.Zero, fmt.Errorf("key %s not found", step.key)
+		}
+	}
+
+	var price decimal.Decimal
+	switch v := value.(type) {
+	case json.Number:
+		price, err = decimal.NewFromString(v.String())
+	case string:
+		price, err = decimal.NewFromString(v)
+	default:
+		return decimal.Zero, fmt.Errorf("value at %s is not a number", path)
+	}
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid price at %s: %s", path, err)
+	}
+	if !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("price at %s must be positive", path)
+	}
+	return price, nil
+}
@@ -0,0 +1,291 @@
+package staticfeeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+
+	"github.com/shopspring/decimal"
+	log "github.com/sirupsen/logrus"
+)
+
+type service struct {
+	lock    *sync.RWMutex
+	markets map[string]*market
+	stopped bool
+
+	wg     *sync.WaitGroup
+	feedCh chan pricefeeder.PriceFeed
+
+	health *pricefeeder.HealthTracker
+}
+
+// market is a subscribed market, whose prices are notified by a dedicated
+// goroutine according to its ticker.
+type market struct {
+	market pricefeeder.Market
+	ticker Ticker
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService returns a price feeder that doesn't connect to any exchange, but
+// notifies the prices defined by the tickers of the subscribed markets, see
+// Ticker, or pushed by the operator. It's meant for testing and regtest
+// environments.
+func NewService() (pricefeeder.PriceFeeder, error) {
+	return &service{
+		lock:    &sync.RWMutex{},
+		markets: make(map[string]*market),
+		wg:      &sync.WaitGroup{},
+		feedCh:  make(chan pricefeeder.PriceFeed, 20),
+		health:  pricefeeder.NewHealthTracker(),
+	}, nil
+}
+
+func (s *service) Start() chan pricefeeder.PriceFeed {
+	return s.feedCh
+}
+
+func (s *service) Stop() {
+	s.lock.Lock()
+	if s.stopped {
+		s.lock.Unlock()
+		return
+	}
+	s.stopped = true
+	for ticker, m := range s.markets {
+		m.cancel()
+		delete(s.markets, ticker)
+	}
+	s.lock.Unlock()
+
+	s.wg.Wait()
+	close(s.feedCh)
+}
+
+func (s *service) SubscribeMarkets(markets []pricefeeder.Market) error {
+	tickers := make([]*Ticker, 0, len(markets))
+	for _, mkt := range markets {
+		ticker, err := ParseTicker(mkt.Ticker)
+		if err != nil {
+			return err
+		}
+		tickers = append(tickers, ticker)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.stopped {
+		return fmt.Errorf("service is stopped")
+	}
+
+	for i, mkt := range markets {
+		if _, ok := s.markets[mkt.Ticker]; ok {
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		m := &market{
+			market: mkt,
+			ticker: *tickers[i],
+			ctx:    ctx,
+			cancel: cancel,
+		}
+		s.markets[mkt.Ticker] = m
+
+		s.wg.Add(1)
+		go s.run(m)
+	}
+	return nil
+}
+
+func (s *service) UnsubscribeMarkets(markets []pricefeeder.Market) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, mkt := range markets {
+		if m, ok := s.markets[mkt.Ticker]; ok {
+			m.cancel()
+			delete(s.markets, mkt.Ticker)
+		}
+	}
+	return nil
+}
+
+func (s *service) ListSubscriptions() []pricefeeder.Market {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	markets := make([]pricefeeder.Market, 0, len(s.markets))
+	for _, m := range s.markets {
+		markets = append(markets, m.market)
+	}
+	return markets
+}
+
+func (s *service) Health() pricefeeder.Health {
+	return s.health.Health()
+}
+
+// PushPrice notifies the given price for the subscribed market with the given
+// ticker. The missing price between base and quote is the inverse of the
+// other one.
+func (s *service) PushPrice(ticker string, price pricefeeder.Price) error {
+	if price.BasePrice.IsZero() && price.QuotePrice.IsZero() {
+		return fmt.Errorf("missing price")
+	}
+	if price.BasePrice.IsNegative() || price.QuotePrice.IsNegative() {
+		return fmt.Errorf("price must be positive")
+	}
+	if price.BasePrice.IsZero() {
+		price.BasePrice = decimal.NewFromInt(1).Div(price.QuotePrice).Round(8)
+	}
+	if price.QuotePrice.IsZero() {
+		price.QuotePrice = decimal.NewFromInt(1).Div(price.BasePrice).Round(8)
+	}
+
+	s.lock.RLock()
+	if s.stopped {
+		s.lock.RUnlock()
+		return fmt.Errorf("service is stopped")
+	}
+	m, ok := s.markets[ticker]
+	if !ok {
+		s.lock.RUnlock()
+		return fmt.Errorf("market with ticker %s not subscribed", ticker)
+	}
+	s.wg.Add(1)
+	s.lock.RUnlock()
+	defer s.wg.Done()
+
+	if !s.notify(m, price.QuotePrice, price.BasePrice) {
+		return fmt.Errorf("market with ticker %s not subscribed", ticker)
+	}
+	return nil
+}
+
+func (s *service) run(m *market) {
+	defer s.wg.Done()
+
+	switch {
+	case m.ticker.Price != "":
+		price, _ := parsePrice(m.ticker.Price)
+		s.notify(m, price, decimal.Zero)
+	case len(m.ticker.Path) > 0:
+		s.followPath(m)
+	case m.ticker.File != "":
+		s.watchFile(m)
+	}
+}
+
+// followPath notifies the prices of the path one after the other, starting
+// over from the first one if the path loops.
+func (s *service) followPath(m *market) {
+	t := time.NewTicker(m.ticker.interval())
+	defer t.Stop()
+
+	for i := 0; ; i++ {
+		if i >= len(m.ticker.Path) {
+			if !m.ticker.Loop {
+				return
+			}
+			i = 0
+		}
+
+		price, _ := parsePrice(m.ticker.Path[i])
+		if !s.notify(m, price, decimal.Zero) {
+			return
+		}
+
+		select {
+		case <-t.C:
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// watchFile notifies the price found in the file every time it changes.
+func (s *service) watchFile(m *market) {
+	t := time.NewTicker(m.ticker.interval())
+	defer t.Stop()
+
+	var lastModTime time.Time
+	var lastPrice decimal.Decimal
+	for {
+		info, err := os.Stat(m.ticker.File)
+		if err == nil && !info.ModTime().Equal(lastModTime) {
+			var price decimal.Decimal
+			price, err = readPrice(m.ticker.File, m.ticker.Key)
+			if err == nil {
+				lastModTime = info.ModTime()
+				// Prevent updating a feed if it hasn't changed.
+				if !price.Equal(lastPrice) {
+					lastPrice = price
+					if !s.notify(m, price, decimal.Zero) {
+						return
+					}
+				}
+			}
+		}
+		if err != nil {
+			s.health.SetError(err)
+			log.WithError(err).Debugf(
+				"static: failed to read price from %s", m.ticker.File,
+			)
+		}
+
+		select {
+		case <-t.C:
+		case <-m.ctx.Done():
+			return
+		}
+	}
+}
+
+// notify sends the given price for the market, computing the base price if
+// zero. It returns false if the market has been unsubscribed meanwhile.
+func (s *service) notify(
+	m *market, quotePrice, basePrice decimal.Decimal,
+) bool {
+	if basePrice.IsZero() {
+		basePrice = decimal.NewFromInt(1).Div(quotePrice).Round(8)
+	}
+
+	select {
+	case s.feedCh <- pricefeeder.PriceFeed{
+		Market: m.market,
+		Price: pricefeeder.Price{
+			BasePrice:  basePrice,
+			QuotePrice: quotePrice,
+		},
+	}:
+		return true
+	case <-m.ctx.Done():
+		return false
+	}
+}
+
+func readPrice(file, key string) (decimal.Decimal, error) {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	prices := make(map[string]json.Number)
+	if err := json.Unmarshal(buf, &prices); err != nil {
+		return decimal.Zero, fmt.Errorf("invalid prices file format: %s", err)
+	}
+	price, ok := prices[key]
+	if !ok {
+		return decimal.Zero, fmt.Errorf("price for key %s not found", key)
+	}
+	return parsePrice(price)
+}
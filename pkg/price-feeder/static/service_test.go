@@ -0,0 +1,177 @@
+package staticfeeder_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+	staticfeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder/static"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	pricesFile := filepath.Join(t.TempDir(), "prices.json")
+	writePrices(t, pricesFile, `{"BTC/USDT": "20000", "EUR/USDT": 1.08}`)
+
+	feederSvc, err := staticfeeder.NewService()
+	require.NoError(t, err)
+	require.NotNil(t, feederSvc)
+
+	feedCh := feederSvc.Start()
+	require.NotNil(t, feedCh)
+
+	fixedMarket := newMarket(`{"price": "30000"}`)
+	fileMarket := newMarket(
+		`{"file": "` + pricesFile + `", "key": "BTC/USDT", "interval": 1}`,
+	)
+	pathMarket := newMarket(`{"path": [1, 2], "interval": 1, "loop": true}`)
+	pushMarket := newMarket("LBTC/USDT")
+
+	err = feederSvc.SubscribeMarkets([]pricefeeder.Market{
+		fixedMarket, fileMarket, pathMarket, pushMarket,
+	})
+	require.NoError(t, err)
+	require.Len(t, feederSvc.ListSubscriptions(), 4)
+
+	pusher, ok := feederSvc.(pricefeeder.PricePusher)
+	require.True(t, ok)
+	err = pusher.PushPrice(pushMarket.Ticker, pricefeeder.Price{
+		QuotePrice: decimal.NewFromInt(25000),
+	})
+	require.NoError(t, err)
+
+	// The first price of every market is notified right away, followed by
+	// the second one of the path after its interval.
+	pricesByTicker := make(map[string][]decimal.Decimal)
+	for i := 0; i < 5; i++ {
+		feed := <-feedCh
+		pricesByTicker[feed.Market.Ticker] = append(
+			pricesByTicker[feed.Market.Ticker], feed.Price.QuotePrice,
+		)
+	}
+	requirePrices(t, []string{"30000"}, pricesByTicker[fixedMarket.Ticker])
+	requirePrices(t, []string{"20000"}, pricesByTicker[fileMarket.Ticker])
+	requirePrices(t, []string{"1", "2"}, pricesByTicker[pathMarket.Ticker])
+	requirePrices(t, []string{"25000"}, pricesByTicker[pushMarket.Ticker])
+
+	err = feederSvc.UnsubscribeMarkets([]pricefeeder.Market{pathMarket})
+	require.NoError(t, err)
+	require.Len(t, feederSvc.ListSubscriptions(), 3)
+
+	// Changes of the file are picked up.
+	writePrices(t, pricesFile, `{"BTC/USDT": "21000"}`)
+	feed := <-feedCh
+	require.Equal(t, fileMarket.Ticker, feed.Market.Ticker)
+	require.True(t, decimal.NewFromInt(21000).Equal(feed.Price.QuotePrice))
+
+	// An invalid file doesn't stop the feed but is reported.
+	writePrices(t, pricesFile, `{"ETH/USDT": "1000"}`)
+	reporter, ok := feederSvc.(pricefeeder.HealthReporter)
+	require.True(t, ok)
+	require.Eventually(t, func() bool {
+		return reporter.Health().LastError != ""
+	}, 5*time.Second, 50*time.Millisecond)
+
+	err = pusher.PushPrice(pathMarket.Ticker, pricefeeder.Price{
+		QuotePrice: decimal.NewFromInt(1),
+	})
+	require.Error(t, err)
+
+	feederSvc.Stop()
+	for range feedCh {
+	}
+
+	err = pusher.PushPrice(pushMarket.Ticker, pricefeeder.Price{
+		QuotePrice: decimal.NewFromInt(1),
+	})
+	require.Error(t, err)
+}
+
+func TestFailingService(t *testing.T) {
+	feederSvc, err := staticfeeder.NewService()
+	require.NoError(t, err)
+	defer feederSvc.Stop()
+
+	tests := []struct {
+		name   string
+		ticker string
+	}{
+		{
+			name:   "empty ticker",
+			ticker: "",
+		},
+		{
+			name:   "malformed json",
+			ticker: `{"price": }`,
+		},
+		{
+			name:   "invalid price",
+			ticker: `{"price": "-1"}`,
+		},
+		{
+			name:   "missing file key",
+			ticker: `{"file": "prices.json"}`,
+		},
+		{
+			name:   "invalid path",
+			ticker: `{"path": ["1", "abc"]}`,
+		},
+		{
+			name:   "price and path",
+			ticker: `{"price": "1", "path": ["1"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := feederSvc.SubscribeMarkets([]pricefeeder.Market{
+				newMarket(tt.ticker),
+			})
+			require.Error(t, err)
+		})
+	}
+	require.Empty(t, feederSvc.ListSubscriptions())
+
+	pushMarket := newMarket("LBTC/USDT")
+	err = feederSvc.SubscribeMarkets([]pricefeeder.Market{pushMarket})
+	require.NoError(t, err)
+
+	pusher := feederSvc.(pricefeeder.PricePusher)
+	err = pusher.PushPrice(pushMarket.Ticker, pricefeeder.Price{})
+	require.Error(t, err)
+	err = pusher.PushPrice(pushMarket.Ticker, pricefeeder.Price{
+		QuotePrice: decimal.NewFromInt(-1),
+	})
+	require.Error(t, err)
+}
+
+func newMarket(ticker string) pricefeeder.Market {
+	return pricefeeder.Market{
+		BaseAsset:  "base",
+		QuoteAsset: "quote",
+		Ticker:     ticker,
+	}
+}
+
+// writePrices replaces the content of the file, making sure its modification
+// time changes.
+func writePrices(t *testing.T, file, prices string) {
+	modTime := time.Now()
+	if info, err := os.Stat(file); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+	require.NoError(t, os.WriteFile(file, []byte(prices), 0600))
+	require.NoError(t, os.Chtimes(file, modTime, modTime))
+}
+
+func requirePrices(t *testing.T, expected []string, prices []decimal.Decimal) {
+	require.Len(t, prices, len(expected))
+	for i, price := range expected {
+		require.True(t, decimal.RequireFromString(price).Equal(prices[i]))
+	}
+}
@@ -0,0 +1,105 @@
+package staticfeeder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const defaultInterval = 1
+
+// Ticker defines where the prices of a market come from. It's expected to be
+// JSON encoded as the ticker of the subscribed market, like for example:
+//
+//	{"price": "20000"}
+//	{"file": "/path/to/prices.json", "key": "BTC/USDT", "interval": 1}
+//	{"path": ["20000", "20500", "19800"], "interval": 10, "loop": true}
+//
+// At most one between price, file and path can be defined. A ticker that is
+// not a JSON object, like for example "BTC/USDT", is a market whose prices
+// are only pushed by the operator, as well as one without any of the above.
+type Ticker struct {
+	// Price is the fixed quote price of the market.
+	Price json.Number `json:"price,omitempty"`
+	// File is the path of a JSON object mapping keys to quote prices, like
+	// {"BTC/USDT": "20000"}. The file is reloaded whenever it changes.
+	File string `json:"file,omitempty"`
+	// Key is the key of the price of the market within File.
+	Key string `json:"key,omitempty"`
+	// Path is the list of quote prices notified one after the other.
+	Path []json.Number `json:"path,omitempty"`
+	// Loop makes Path restart once the last price has been notified.
+	Loop bool `json:"loop,omitempty"`
+	// Interval is the number of seconds between two prices of Path, or between
+	// two checks for changes of File, defaults to 1.
+	Interval uint32 `json:"interval,omitempty"`
+}
+
+// ParseTicker decodes and validates the given ticker.
+func ParseTicker(ticker string) (*Ticker, error) {
+	ticker = strings.TrimSpace(ticker)
+	if ticker == "" {
+		return nil, fmt.Errorf("missing ticker")
+	}
+	if !strings.HasPrefix(ticker, "{") {
+		return &Ticker{}, nil
+	}
+
+	t := &Ticker{}
+	if err := json.Unmarshal([]byte(ticker), t); err != nil {
+		return nil, fmt.Errorf("invalid ticker format: %s", err)
+	}
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t Ticker) validate() error {
+	count := 0
+	if t.Price != "" {
+		count++
+		if _, err := parsePrice(t.Price); err != nil {
+			return err
+		}
+	}
+	if t.File != "" {
+		count++
+		if t.Key == "" {
+			return fmt.Errorf("missing key of the price within file")
+		}
+	}
+	if len(t.Path) > 0 {
+		count++
+		for _, price := range t.Path {
+			if _, err := parsePrice(price); err != nil {
+				return fmt.Errorf("invalid path: %s", err)
+			}
+		}
+	}
+	if count > 1 {
+		return fmt.Errorf("price, file and path are mutually exclusive")
+	}
+	return nil
+}
+
+func (t Ticker) interval() time.Duration {
+	if t.Interval == 0 {
+		return defaultInterval * time.Second
+	}
+	return time.Duration(t.Interval) * time.Second
+}
+
+func parsePrice(price json.Number) (decimal.Decimal, error) {
+	p, err := decimal.NewFromString(price.String())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid price %s: %s", price, err)
+	}
+	if !p.IsPositive() {
+		return decimal.Zero, fmt.Errorf("price %s must be positive", price)
+	}
+	return p, nil
+}
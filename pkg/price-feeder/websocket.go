@@ -0,0 +1,634 @@
+package pricefeeder
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultPingInterval = 15 * time.Second
+	defaultReadTimeout  = 30 * time.Second
+	defaultMinBackoff   = 500 * time.Millisecond
+	defaultMaxBackoff   = time.Minute
+	writeTimeout        = 10 * time.Second
+)
+
+// WebsocketStatus is the state of the connection of a websocket price feeder.
+type WebsocketStatus int
+
+const (
+	// WebsocketConnected means the connection is up and tickers subscribed.
+	WebsocketConnected WebsocketStatus = iota
+	// WebsocketReconnecting means the connection dropped and is being
+	// restored.
+	WebsocketReconnecting
+	// WebsocketStopped means the price feeder has been stopped.
+	WebsocketStopped
+)
+
+func (s WebsocketStatus) String() string {
+	switch s {
+	case WebsocketConnected:
+		return "connected"
+	case WebsocketReconnecting:
+		return "reconnecting"
+	case WebsocketStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
+// WebsocketHandler is the exchange specific part of a websocket price
+// feeder, that only has to define the messages to exchange with the server.
+type WebsocketHandler interface {
+	// SubscribeMsgs returns the messages to send to subscribe to the given
+	// tickers.
+	SubscribeMsgs(tickers []string) []interface{}
+	// UnsubscribeMsgs returns the messages to send to unsubscribe from the
+	// given tickers.
+	UnsubscribeMsgs(tickers []string) []interface{}
+	// ParseMsg returns the ticker and the price contained in the given
+	// message, or a nil price if it's not a price update.
+	ParseMsg(msg []byte) (string, *Price)
+}
+
+// WebsocketConfig is the configuration of a websocket price feeder. Only Url
+// and Handler are required.
+type WebsocketConfig struct {
+	// Name identifies the price feeder in logs.
+	Name    string
+	Url     string
+	Handler WebsocketHandler
+	// PingInterval is the interval between two pings sent to the server,
+	// defaults to 15 seconds.
+	PingInterval time.Duration
+	// ReadTimeout is the max time without receiving any message or pong from
+	// the server, after which the connection is considered dead and restored.
+	// Defaults to 30 seconds.
+	ReadTimeout time.Duration
+	// MinBackoff and MaxBackoff bound the exponential delay between two
+	// reconnection attempts, default to 500 milliseconds and 1 minute.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RefreshInterval is the max time the last price of a ticker is withheld
+	// if unchanged. As long as the connection is alive, meaning the price is
+	// still the current one, it's sent again once this is elapsed since the
+	// last time. Defaults to DefaultRefreshInterval.
+	RefreshInterval time.Duration
+	// OnStatusChange, if defined, is called every time the status of the
+	// connection changes, along with the error that caused it, if any.
+	OnStatusChange func(status WebsocketStatus, err error)
+}
+
+func (c WebsocketConfig) validate() error {
+	if c.Url == "" {
+		return fmt.Errorf("missing websocket url")
+	}
+	if c.Handler == nil {
+		return fmt.Errorf("missing websocket handler")
+	}
+	if c.ReadTimeout > 0 && c.PingInterval >= c.ReadTimeout {
+		return fmt.Errorf("ping interval must be lower than read timeout")
+	}
+	if c.MaxBackoff > 0 && c.MinBackoff > c.MaxBackoff {
+		return fmt.Errorf("min backoff must not be greater than max backoff")
+	}
+	return nil
+}
+
+// lastPrice is the last price sent for a ticker, along with when.
+type lastPrice struct {
+	price  Price
+	sentAt time.Time
+}
+
+type websocketFeeder struct {
+	cfg WebsocketConfig
+
+	connLock  *sync.RWMutex
+	conn      *websocket.Conn
+	writeLock *sync.Mutex
+
+	marketLock        *sync.RWMutex
+	marketsByTicker   map[string]Market
+	lastPriceByTicker map[string]lastPrice
+
+	lock    *sync.Mutex
+	ctx     context.Context
+	cancel  context.CancelFunc
+	started bool
+	wg      *sync.WaitGroup
+	feedCh  chan PriceFeed
+
+	health *HealthTracker
+}
+
+// NewWebsocketFeeder returns a price feeder for the given websocket server.
+// The connection is established once started, retrying with an exponential
+// backoff if the server is unreachable. It is then kept alive with pings, and
+// restored the same way whenever it drops, resubscribing to all tickers.
+func NewWebsocketFeeder(cfg WebsocketConfig) (PriceFeeder, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.PingInterval <= 0 {
+		cfg.PingInterval = defaultPingInterval
+	}
+	if cfg.ReadTimeout <= 0 {
+		cfg.ReadTimeout = defaultReadTimeout
+	}
+	if cfg.PingInterval >= cfg.ReadTimeout {
+		cfg.PingInterval = cfg.ReadTimeout / 2
+	}
+	if cfg.MinBackoff <= 0 {
+		cfg.MinBackoff = defaultMinBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+	if cfg.MaxBackoff < cfg.MinBackoff {
+		cfg.MaxBackoff = cfg.MinBackoff
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = DefaultRefreshInterval
+	}
+	if cfg.Name == "" {
+		cfg.Name = cfg.Url
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &websocketFeeder{
+		cfg:               cfg,
+		connLock:          &sync.RWMutex{},
+		writeLock:         &sync.Mutex{},
+		marketLock:        &sync.RWMutex{},
+		marketsByTicker:   make(map[string]Market),
+		lastPriceByTicker: make(map[string]lastPrice),
+		lock:              &sync.Mutex{},
+		ctx:               ctx,
+		cancel:            cancel,
+		wg:                &sync.WaitGroup{},
+		feedCh:            make(chan PriceFeed, 20),
+		health:            NewHealthTracker(),
+	}, nil
+}
+
+func (w *websocketFeeder) Start() chan PriceFeed {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if !w.started && w.ctx.Err() == nil {
+		w.started = true
+		w.wg.Add(1)
+		go w.listen()
+	}
+	return w.feedCh
+}
+
+func (w *websocketFeeder) Stop() {
+	w.lock.Lock()
+	if w.ctx.Err() != nil {
+		w.lock.Unlock()
+		return
+	}
+	w.cancel()
+	w.lock.Unlock()
+
+	if conn := w.getConn(); conn != nil {
+		conn.Close()
+	}
+	w.wg.Wait()
+	close(w.feedCh)
+
+	w.notifyStatus(WebsocketStopped, nil)
+}
+
+func (w *websocketFeeder) SubscribeMarkets(markets []Market) error {
+	tickers := make([]string, 0, len(markets))
+	marketsToAdd := make([]Market, 0, len(markets))
+	for _, mkt := range markets {
+		if _, ok := w.getMarketByTicker(mkt.Ticker); !ok {
+			tickers = append(tickers, mkt.Ticker)
+			marketsToAdd = append(marketsToAdd, mkt)
+		}
+	}
+	if len(tickers) <= 0 {
+		return nil
+	}
+
+	// Markets are added before subscribing to not miss the first prices. If
+	// not connected yet, they're subscribed once the connection is established.
+	w.addMarkets(marketsToAdd)
+	conn := w.getConn()
+	if conn == nil {
+		return nil
+	}
+	if err := w.send(
+		conn, w.cfg.Handler.SubscribeMsgs(tickers),
+	); err != nil {
+		w.dropConn(fmt.Errorf("cannot subscribe to markets: %s", err))
+	}
+	return nil
+}
+
+func (w *websocketFeeder) UnsubscribeMarkets(markets []Market) error {
+	tickers := make([]string, 0, len(markets))
+	for _, mkt := range markets {
+		if _, ok := w.getMarketByTicker(mkt.Ticker); ok {
+			tickers = append(tickers, mkt.Ticker)
+		}
+	}
+	if len(tickers) <= 0 {
+		return nil
+	}
+
+	w.removeMarkets(tickers)
+	conn := w.getConn()
+	if conn == nil {
+		return nil
+	}
+	if err := w.send(
+		conn, w.cfg.Handler.UnsubscribeMsgs(tickers),
+	); err != nil {
+		w.dropConn(fmt.Errorf("cannot unsubscribe from markets: %s", err))
+	}
+	return nil
+}
+
+func (w *websocketFeeder) ListSubscriptions() []Market {
+	w.marketLock.RLock()
+	defer w.marketLock.RUnlock()
+
+	markets := make([]Market, 0, len(w.marketsByTicker))
+	for _, mkt := range w.marketsByTicker {
+		markets = append(markets, mkt)
+	}
+	return markets
+}
+
+func (w *websocketFeeder) Health() Health {
+	return w.health.Health()
+}
+
+// listen connects to the server and reads its messages until the feeder is
+// stopped, restoring the connection whenever it drops. The first connection
+// is retried like the others, so that a server down when the feeder is
+// started doesn't prevent it from ever coming up.
+// The backoff is kept across connections and reset only once one has proven
+// to work, by either delivering a price or staying up for the read timeout.
+// This way, a server accepting connections and closing them straight away,
+// like during maintenance, isn't redialed in a loop.
+func (w *websocketFeeder) listen() {
+	defer w.wg.Done()
+
+	backoff := w.cfg.MinBackoff
+	for reconnecting := false; ; reconnecting = true {
+		var ok bool
+		if backoff, ok = w.reconnect(backoff); !ok {
+			return
+		}
+		if reconnecting {
+			w.health.AddReconnection()
+		}
+		w.notifyStatus(WebsocketConnected, nil)
+
+		connectedAt := time.Now()
+		gotPrice, err := w.read(w.getConn())
+		if w.ctx.Err() != nil {
+			return
+		}
+		// The prices might change while disconnected, the last ones can't be
+		// confirmed by the next connection.
+		w.resetLastPrices()
+
+		w.health.SetError(err)
+		w.notifyStatus(WebsocketReconnecting, err)
+
+		if gotPrice || time.Since(connectedAt) >= w.cfg.ReadTimeout {
+			backoff = w.cfg.MinBackoff
+			continue
+		}
+
+		log.WithError(err).Debugf(
+			"%s: connection closed right after being established, retrying in %s",
+			w.cfg.Name, backoff,
+		)
+		if !w.wait(backoff) {
+			return
+		}
+		backoff = w.nextBackoff(backoff)
+	}
+}
+
+// read forwards the prices received through the given connection until an
+// error occurs, and returns whether any price has been received. The
+// connection is considered dead, and closed, if nothing is received within
+// the read timeout, pongs included. Anything received confirms that the last
+// prices are still current, and those withheld for longer than the refresh
+// interval are sent again.
+func (w *websocketFeeder) read(conn *websocket.Conn) (bool, error) {
+	defer conn.Close()
+
+	extendDeadline := func() error {
+		return conn.SetReadDeadline(time.Now().Add(w.cfg.ReadTimeout))
+	}
+	if err := extendDeadline(); err != nil {
+		return false, err
+	}
+	conn.SetPongHandler(func(string) error {
+		if err := extendDeadline(); err != nil {
+			return err
+		}
+		w.refreshPrices()
+		return nil
+	})
+
+	done := make(chan struct{})
+	defer close(done)
+	go w.ping(conn, done)
+
+	gotPrice := false
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return gotPrice, err
+		}
+		if err := extendDeadline(); err != nil {
+			return gotPrice, err
+		}
+
+		ticker, price := w.cfg.Handler.ParseMsg(msg)
+		if price == nil {
+			w.refreshPrices()
+			continue
+		}
+		mkt, ok := w.getMarketByTicker(ticker)
+		if !ok {
+			w.refreshPrices()
+			continue
+		}
+		gotPrice = true
+		// Prevent updating a feed if it hasn't changed.
+		if !w.updateLastPrice(ticker, *price) {
+			w.refreshPrices()
+			continue
+		}
+
+		if !w.sendFeed(PriceFeed{Market: mkt, Price: *price}) {
+			return gotPrice, nil
+		}
+	}
+}
+
+// refreshPrices sends again the last prices not sent within the refresh
+// interval.
+func (w *websocketFeeder) refreshPrices() {
+	for _, feed := range w.pricesToRefresh(time.Now()) {
+		if !w.sendFeed(feed) {
+			return
+		}
+	}
+}
+
+// sendFeed forwards the given price feed, it returns false if the feeder has
+// been stopped in the meantime.
+func (w *websocketFeeder) sendFeed(feed PriceFeed) bool {
+	select {
+	case w.feedCh <- feed:
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+func (w *websocketFeeder) ping(conn *websocket.Conn, done chan struct{}) {
+	t := time.NewTicker(w.cfg.PingInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			if err := conn.WriteControl(
+				websocket.PingMessage, nil, time.Now().Add(writeTimeout),
+			); err != nil {
+				log.WithError(err).Debugf("%s: failed to send ping", w.cfg.Name)
+			}
+		case <-done:
+			return
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
+
+// reconnect attempts to establish a new connection and subscribe to all
+// tickers with an exponential backoff starting from the given one, until
+// either it succeeds or the feeder is stopped. It returns the backoff to wait
+// before the next attempt.
+func (w *websocketFeeder) reconnect(backoff time.Duration) (time.Duration, bool) {
+	for attempt := 1; ; attempt++ {
+		err := w.connect()
+		if err == nil {
+			log.Debugf("%s: connection with server established", w.cfg.Name)
+			return backoff, true
+		}
+
+		w.health.SetError(err)
+		log.WithError(err).Debugf(
+			"%s: connection attempt %d failed, retrying in %s",
+			w.cfg.Name, attempt, backoff,
+		)
+
+		if !w.wait(backoff) {
+			return backoff, false
+		}
+		backoff = w.nextBackoff(backoff)
+	}
+}
+
+// wait waits for the given time, it returns false if the feeder is stopped in
+// the meantime.
+func (w *websocketFeeder) wait(d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-w.ctx.Done():
+		return false
+	}
+}
+
+func (w *websocketFeeder) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > w.cfg.MaxBackoff {
+		backoff = w.cfg.MaxBackoff
+	}
+	return backoff
+}
+
+func (w *websocketFeeder) connect() error {
+	conn, err := dial(w.cfg.Url)
+	if err != nil {
+		return err
+	}
+
+	w.connLock.Lock()
+	// The feeder might have been stopped while dialing.
+	if w.ctx.Err() != nil {
+		w.connLock.Unlock()
+		conn.Close()
+		return w.ctx.Err()
+	}
+	w.conn = conn
+	w.connLock.Unlock()
+
+	if tickers := w.getMarketTickers(); len(tickers) > 0 {
+		if err := w.send(
+			conn, w.cfg.Handler.SubscribeMsgs(tickers),
+		); err != nil {
+			conn.Close()
+			return fmt.Errorf("failed to restore subscriptions: %s", err)
+		}
+	}
+	return nil
+}
+
+func (w *websocketFeeder) send(conn *websocket.Conn, msgs []interface{}) error {
+	w.writeLock.Lock()
+	defer w.writeLock.Unlock()
+
+	for _, msg := range msgs {
+		if err := conn.SetWriteDeadline(
+			time.Now().Add(writeTimeout),
+		); err != nil {
+			return err
+		}
+		if err := conn.WriteJSON(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropConn closes the current connection because of the given error, so that
+// it's restored along with the subscriptions of all the current markets.
+func (w *websocketFeeder) dropConn(err error) {
+	w.health.SetError(err)
+	log.WithError(err).Debugf("%s: dropping connection", w.cfg.Name)
+	w.getConn().Close()
+}
+
+func (w *websocketFeeder) notifyStatus(status WebsocketStatus, err error) {
+	if w.cfg.OnStatusChange != nil {
+		w.cfg.OnStatusChange(status, err)
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Warnf("%s: connection %s", w.cfg.Name, status)
+		return
+	}
+	log.Debugf("%s: connection %s", w.cfg.Name, status)
+}
+
+func (w *websocketFeeder) getConn() *websocket.Conn {
+	w.connLock.RLock()
+	defer w.connLock.RUnlock()
+	return w.conn
+}
+
+func (w *websocketFeeder) addMarkets(markets []Market) {
+	w.marketLock.Lock()
+	defer w.marketLock.Unlock()
+
+	for _, mkt := range markets {
+		w.marketsByTicker[mkt.Ticker] = mkt
+	}
+}
+
+func (w *websocketFeeder) removeMarkets(tickers []string) {
+	w.marketLock.Lock()
+	defer w.marketLock.Unlock()
+
+	for _, ticker := range tickers {
+		delete(w.marketsByTicker, ticker)
+		delete(w.lastPriceByTicker, ticker)
+	}
+}
+
+func (w *websocketFeeder) getMarketByTicker(ticker string) (Market, bool) {
+	w.marketLock.RLock()
+	defer w.marketLock.RUnlock()
+
+	mkt, ok := w.marketsByTicker[ticker]
+	return mkt, ok
+}
+
+func (w *websocketFeeder) getMarketTickers() []string {
+	w.marketLock.RLock()
+	defer w.marketLock.RUnlock()
+
+	tickers := make([]string, 0, len(w.marketsByTicker))
+	for ticker := range w.marketsByTicker {
+		tickers = append(tickers, ticker)
+	}
+	return tickers
+}
+
+// updateLastPrice stores the given price for the ticker and returns whether
+// it differs from the previous one.
+func (w *websocketFeeder) updateLastPrice(ticker string, price Price) bool {
+	w.marketLock.Lock()
+	defer w.marketLock.Unlock()
+
+	if _, ok := w.marketsByTicker[ticker]; !ok {
+		return false
+	}
+	if last, ok := w.lastPriceByTicker[ticker]; ok &&
+		last.price.BasePrice.Equal(price.BasePrice) {
+		return false
+	}
+	w.lastPriceByTicker[ticker] = lastPrice{price, time.Now()}
+	return true
+}
+
+func (w *websocketFeeder) resetLastPrices() {
+	w.marketLock.Lock()
+	defer w.marketLock.Unlock()
+
+	w.lastPriceByTicker = make(map[string]lastPrice)
+}
+
+// pricesToRefresh returns the last prices not sent within the refresh
+// interval, marking them as sent at the given time.
+func (w *websocketFeeder) pricesToRefresh(now time.Time) []PriceFeed {
+	w.marketLock.Lock()
+	defer w.marketLock.Unlock()
+
+	feeds := make([]PriceFeed, 0)
+	for ticker, last := range w.lastPriceByTicker {
+		if now.Sub(last.sentAt) < w.cfg.RefreshInterval {
+			continue
+		}
+		mkt, ok := w.marketsByTicker[ticker]
+		if !ok {
+			continue
+		}
+		w.lastPriceByTicker[ticker] = lastPrice{last.price, now}
+		feeds = append(feeds, PriceFeed{Market: mkt, Price: last.price})
+	}
+	return feeds
+}
+
+func dial(url string) (*websocket.Conn, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
@@ -0,0 +1,459 @@
+package pricefeeder_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pricefeeder "github.com/tdex-network/tdex-daemon/pkg/price-feeder"
+
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketFeeder(t *testing.T) {
+	server := newWsServer()
+	defer server.Close()
+
+	statusCh := make(chan pricefeeder.WebsocketStatus, 10)
+	feederSvc, err := pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Name:         "test",
+		Url:          server.url(),
+		Handler:      testHandler{},
+		PingInterval: 100 * time.Millisecond,
+		ReadTimeout:  300 * time.Millisecond,
+		MinBackoff:   10 * time.Millisecond,
+		MaxBackoff:   50 * time.Millisecond,
+		OnStatusChange: func(status pricefeeder.WebsocketStatus, _ error) {
+			statusCh <- status
+		},
+	})
+	require.NoError(t, err)
+
+	feedCh := feederSvc.Start()
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+
+	markets := []pricefeeder.Market{
+		{BaseAsset: "base", QuoteAsset: "quote", Ticker: "BTCUSD"},
+		{BaseAsset: "base", QuoteAsset: "quote", Ticker: "ETHUSD"},
+	}
+	err = feederSvc.SubscribeMarkets(markets)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"BTCUSD", "ETHUSD"}, <-server.subscriptions)
+	require.Len(t, feederSvc.ListSubscriptions(), 2)
+
+	// Unchanged prices and prices of unknown tickers are not notified.
+	server.sendPrice("BTCUSD", "20000")
+	server.sendPrice("BTCUSD", "20000")
+	server.sendPrice("XRPUSD", "1")
+	server.sendPrice("ETHUSD", "1000")
+	requirePrice(t, feedCh, "BTCUSD", "20000")
+	requirePrice(t, feedCh, "ETHUSD", "1000")
+
+	// Idle connections are kept alive by pings.
+	time.Sleep(500 * time.Millisecond)
+	require.Empty(t, statusCh)
+
+	// Dropped connections are restored along with all subscriptions.
+	server.dropConn()
+	require.Equal(t, pricefeeder.WebsocketReconnecting, <-statusCh)
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+	require.ElementsMatch(t, []string{"BTCUSD", "ETHUSD"}, <-server.subscriptions)
+
+	server.sendPrice("BTCUSD", "21000")
+	requirePrice(t, feedCh, "BTCUSD", "21000")
+
+	// Connections not answering pings are considered dead.
+	server.mute(1)
+	server.dropConn()
+	require.Equal(t, pricefeeder.WebsocketReconnecting, <-statusCh)
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+	require.Equal(t, pricefeeder.WebsocketReconnecting, <-statusCh)
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+
+	reporter, ok := feederSvc.(pricefeeder.HealthReporter)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), reporter.Health().Reconnections)
+	require.NotEmpty(t, reporter.Health().LastError)
+
+	err = feederSvc.UnsubscribeMarkets(markets[:1])
+	require.NoError(t, err)
+	require.Len(t, feederSvc.ListSubscriptions(), 1)
+
+	feederSvc.Stop()
+	require.Equal(t, pricefeeder.WebsocketStopped, <-statusCh)
+	for range feedCh {
+	}
+}
+
+func TestWebsocketFeederRefresh(t *testing.T) {
+	server := newWsServer()
+	defer server.Close()
+
+	statusCh := make(chan pricefeeder.WebsocketStatus, 10)
+	feederSvc, err := pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Url:             server.url(),
+		Handler:         testHandler{},
+		PingInterval:    50 * time.Millisecond,
+		ReadTimeout:     300 * time.Millisecond,
+		MinBackoff:      10 * time.Millisecond,
+		MaxBackoff:      50 * time.Millisecond,
+		RefreshInterval: 200 * time.Millisecond,
+		OnStatusChange: func(status pricefeeder.WebsocketStatus, _ error) {
+			statusCh <- status
+		},
+	})
+	require.NoError(t, err)
+
+	feedCh := feederSvc.Start()
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+
+	err = feederSvc.SubscribeMarkets([]pricefeeder.Market{
+		{BaseAsset: "base", QuoteAsset: "quote", Ticker: "BTCUSD"},
+	})
+	require.NoError(t, err)
+	<-server.subscriptions
+
+	// The unchanged price is sent again while the connection is alive, pongs
+	// confirming it's still the current one.
+	server.sendPrice("BTCUSD", "20000")
+	requirePrice(t, feedCh, "BTCUSD", "20000")
+	start := time.Now()
+	requirePrice(t, feedCh, "BTCUSD", "20000")
+	require.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	// The prices received before a drop are not confirmed by the next
+	// connection.
+	server.dropConn()
+	require.Equal(t, pricefeeder.WebsocketReconnecting, <-statusCh)
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+	<-server.subscriptions
+	for len(feedCh) > 0 {
+		<-feedCh
+	}
+	select {
+	case feed := <-feedCh:
+		t.Fatalf("unexpected price %s", feed.Price.QuotePrice)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	feederSvc.Stop()
+	for range feedCh {
+	}
+}
+
+func TestWebsocketFeederServerDown(t *testing.T) {
+	server := newWsServer()
+	defer server.Close()
+	server.reject(2)
+
+	statusCh := make(chan pricefeeder.WebsocketStatus, 10)
+	feederSvc, err := pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Name:       "test",
+		Url:        server.url(),
+		Handler:    testHandler{},
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 50 * time.Millisecond,
+		OnStatusChange: func(status pricefeeder.WebsocketStatus, _ error) {
+			statusCh <- status
+		},
+	})
+	require.NoError(t, err)
+
+	// Markets subscribed before connecting are subscribed once connected.
+	markets := []pricefeeder.Market{
+		{BaseAsset: "base", QuoteAsset: "quote", Ticker: "BTCUSD"},
+	}
+	err = feederSvc.SubscribeMarkets(markets)
+	require.NoError(t, err)
+
+	feedCh := feederSvc.Start()
+	require.Equal(t, pricefeeder.WebsocketConnected, <-statusCh)
+	require.Equal(t, []string{"BTCUSD"}, <-server.subscriptions)
+
+	server.sendPrice("BTCUSD", "20000")
+	requirePrice(t, feedCh, "BTCUSD", "20000")
+
+	reporter, ok := feederSvc.(pricefeeder.HealthReporter)
+	require.True(t, ok)
+	require.Zero(t, reporter.Health().Reconnections)
+	require.NotEmpty(t, reporter.Health().LastError)
+
+	feederSvc.Stop()
+	require.Equal(t, pricefeeder.WebsocketStopped, <-statusCh)
+	for range feedCh {
+	}
+}
+
+func TestWebsocketFeederClosedConnections(t *testing.T) {
+	server := newWsServer()
+	defer server.Close()
+	server.close(4)
+
+	feederSvc, err := pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Name:       "test",
+		Url:        server.url(),
+		Handler:    testHandler{},
+		MinBackoff: 50 * time.Millisecond,
+		MaxBackoff: 400 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	err = feederSvc.SubscribeMarkets([]pricefeeder.Market{
+		{BaseAsset: "base", QuoteAsset: "quote", Ticker: "BTCUSD"},
+	})
+	require.NoError(t, err)
+
+	// Connections closed right after being established are retried with the
+	// backoff rather than straight away: 50+100+200+400 ms must elapse before
+	// the 5th connection is accepted.
+	start := time.Now()
+	feedCh := feederSvc.Start()
+	require.Equal(t, []string{"BTCUSD"}, <-server.subscriptions)
+	require.GreaterOrEqual(t, time.Since(start), 750*time.Millisecond)
+	require.Equal(t, 5, server.acceptedConns())
+
+	// Once a connection delivers a price, the backoff is reset.
+	server.sendPrice("BTCUSD", "20000")
+	requirePrice(t, feedCh, "BTCUSD", "20000")
+	start = time.Now()
+	server.dropConn()
+	<-server.subscriptions
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	feederSvc.Stop()
+	for range feedCh {
+	}
+}
+
+func TestWebsocketFeederNeverConnected(t *testing.T) {
+	feederSvc, err := pricefeeder.NewWebsocketFeeder(pricefeeder.WebsocketConfig{
+		Url:     "ws://127.0.0.1:1",
+		Handler: testHandler{},
+	})
+	require.NoError(t, err)
+
+	feedCh := feederSvc.Start()
+	err = feederSvc.SubscribeMarkets([]pricefeeder.Market{
+		{BaseAsset: "base", QuoteAsset: "quote", Ticker: "BTCUSD"},
+	})
+	require.NoError(t, err)
+	require.Len(t, feederSvc.ListSubscriptions(), 1)
+
+	feederSvc.Stop()
+	for range feedCh {
+	}
+}
+
+func TestFailingWebsocketFeeder(t *testing.T) {
+	server := newWsServer()
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		cfg  pricefeeder.WebsocketConfig
+	}{
+		{
+			name: "missing url",
+			cfg:  pricefeeder.WebsocketConfig{Handler: testHandler{}},
+		},
+		{
+			name: "missing handler",
+			cfg:  pricefeeder.WebsocketConfig{Url: server.url()},
+		},
+		{
+			name: "ping interval not lower than read timeout",
+			cfg: pricefeeder.WebsocketConfig{
+				Url:          server.url(),
+				Handler:      testHandler{},
+				PingInterval: time.Second,
+				ReadTimeout:  time.Second,
+			},
+		},
+		{
+			name: "min backoff greater than max",
+			cfg: pricefeeder.WebsocketConfig{
+				Url:        server.url(),
+				Handler:    testHandler{},
+				MinBackoff: time.Minute,
+				MaxBackoff: time.Second,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			feederSvc, err := pricefeeder.NewWebsocketFeeder(tt.cfg)
+			require.Error(t, err)
+			require.Nil(t, feederSvc)
+		})
+	}
+}
+
+// testHandler speaks a minimal protocol where subscriptions are like
+// {"op": "subscribe", "tickers": [...]} and prices like
+// {"ticker": "BTCUSD", "price": "20000"}.
+type testHandler struct{}
+
+type testMsg struct {
+	Op      string   `json:"op,omitempty"`
+	Tickers []string `json:"tickers,omitempty"`
+	Ticker  string   `json:"ticker,omitempty"`
+	Price   string   `json:"price,omitempty"`
+}
+
+func (testHandler) SubscribeMsgs(tickers []string) []interface{} {
+	return []interface{}{testMsg{Op: "subscribe", Tickers: tickers}}
+}
+
+func (testHandler) UnsubscribeMsgs(tickers []string) []interface{} {
+	return []interface{}{testMsg{Op: "unsubscribe", Tickers: tickers}}
+}
+
+func (testHandler) ParseMsg(buf []byte) (string, *pricefeeder.Price) {
+	var msg testMsg
+	if err := json.Unmarshal(buf, &msg); err != nil || msg.Price == "" {
+		return "", nil
+	}
+	quotePrice, err := decimal.NewFromString(msg.Price)
+	if err != nil {
+		return "", nil
+	}
+	return msg.Ticker, &pricefeeder.Price{
+		BasePrice:  decimal.NewFromInt(1).Div(quotePrice).Round(8),
+		QuotePrice: quotePrice,
+	}
+}
+
+type wsServer struct {
+	*httptest.Server
+
+	lock          *sync.Mutex
+	conn          *websocket.Conn
+	mutedConns    int
+	rejectedConns int
+	closedConns   int
+	accepted      int
+
+	subscriptions chan []string
+}
+
+// newWsServer returns a local websocket server whose connections answer
+// pings unless muted. Rejected connections fail the websocket handshake,
+// closed ones are closed right after it.
+func newWsServer() *wsServer {
+	s := &wsServer{
+		lock:          &sync.Mutex{},
+		subscriptions: make(chan []string, 10),
+	}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			s.lock.Lock()
+			rejected := s.rejectedConns > 0
+			if rejected {
+				s.rejectedConns--
+			}
+			s.lock.Unlock()
+			if rejected {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			s.lock.Lock()
+			s.conn = conn
+			s.accepted++
+			muted := s.mutedConns > 0
+			if muted {
+				s.mutedConns--
+			}
+			closed := s.closedConns > 0
+			if closed {
+				s.closedConns--
+			}
+			s.lock.Unlock()
+
+			if closed {
+				return
+			}
+			if muted {
+				conn.SetPingHandler(func(string) error { return nil })
+			}
+
+			for {
+				var msg testMsg
+				if err := conn.ReadJSON(&msg); err != nil {
+					return
+				}
+				if msg.Op == "subscribe" {
+					s.subscriptions <- msg.Tickers
+				}
+			}
+		},
+	))
+	return s
+}
+
+func (s *wsServer) url() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func (s *wsServer) sendPrice(ticker, price string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	// nolint
+	s.conn.WriteJSON(testMsg{Ticker: ticker, Price: price})
+}
+
+func (s *wsServer) dropConn() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.conn.Close()
+}
+
+func (s *wsServer) mute(conns int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.mutedConns = conns
+}
+
+func (s *wsServer) reject(conns int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.rejectedConns = conns
+}
+
+func (s *wsServer) close(conns int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closedConns = conns
+}
+
+func (s *wsServer) acceptedConns() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.accepted
+}
+
+func requirePrice(
+	t *testing.T, feedCh chan pricefeeder.PriceFeed, ticker, price string,
+) {
+	select {
+	case feed := <-feedCh:
+		require.Equal(t, ticker, feed.Market.Ticker)
+		require.True(t, decimal.RequireFromString(price).Equal(feed.Price.QuotePrice))
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timeout waiting for price of %s", ticker)
+	}
+}
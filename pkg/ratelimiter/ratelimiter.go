@@ -0,0 +1,82 @@
+package ratelimiter
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter is a fixed-window rate limiter that allows at most a certain
+// number of events for every key within every time interval.
+// A RateLimiter with a zero limit allows any number of events.
+type RateLimiter struct {
+	limit    int
+	interval time.Duration
+
+	windows   map[string]*window
+	lastSweep time.Time
+	lock      *sync.Mutex
+}
+
+type window struct {
+	start time.Time
+	count int
+}
+
+// NewRateLimiter returns a new RateLimiter allowing at most limit events
+// per key for every interval.
+func NewRateLimiter(limit int, interval time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:     limit,
+		interval:  interval,
+		windows:   make(map[string]*window),
+		lastSweep: time.Now(),
+		lock:      &sync.Mutex{},
+	}
+}
+
+// Allow returns whether a new event can happen for all the given keys, and
+// if so, counts it for each one of them. If the limit is reached for any of
+// the keys, the event is rejected and not counted at all.
+func (r *RateLimiter) Allow(keys ...string) bool {
+	if r.limit <= 0 || len(keys) <= 0 {
+		return true
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+	r.sweep(now)
+
+	for _, key := range keys {
+		w, ok := r.windows[key]
+		if ok && now.Sub(w.start) < r.interval && w.count >= r.limit {
+			return false
+		}
+	}
+
+	for _, key := range keys {
+		w, ok := r.windows[key]
+		if !ok || now.Sub(w.start) >= r.interval {
+			r.windows[key] = &window{now, 1}
+			continue
+		}
+		w.count++
+	}
+	return true
+}
+
+// sweep removes the expired windows to prevent the map from growing
+// indefinitely. This happens at most once per interval.
+func (r *RateLimiter) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < r.interval {
+		return
+	}
+
+	for key, w := range r.windows {
+		if now.Sub(w.start) >= r.interval {
+			delete(r.windows, key)
+		}
+	}
+	r.lastSweep = now
+}
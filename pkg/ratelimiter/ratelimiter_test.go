@@ -0,0 +1,39 @@
+package ratelimiter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tdex-network/tdex-daemon/pkg/ratelimiter"
+)
+
+func TestRateLimiter(t *testing.T) {
+	interval := 500 * time.Millisecond
+	limiter := ratelimiter.NewRateLimiter(2, interval)
+
+	require.True(t, limiter.Allow("a"))
+	require.True(t, limiter.Allow("a"))
+	require.False(t, limiter.Allow("a"))
+
+	// Other keys are not affected.
+	require.True(t, limiter.Allow("b"))
+
+	// An event is rejected if any of the keys has reached the limit, and is not
+	// counted for the others.
+	require.False(t, limiter.Allow("b", "a"))
+	require.True(t, limiter.Allow("b"))
+	require.False(t, limiter.Allow("b"))
+
+	time.Sleep(interval)
+
+	require.True(t, limiter.Allow("a", "b"))
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter := ratelimiter.NewRateLimiter(0, time.Minute)
+
+	for i := 0; i < 100; i++ {
+		require.True(t, limiter.Allow("a"))
+	}
+}
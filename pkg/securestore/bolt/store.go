@@ -3,6 +3,7 @@ package boltsecurestore
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -30,7 +31,10 @@ var (
 )
 
 type boltSecureStorage struct {
-	db kvdb.Backend
+	// dbMtx guards the db, which is reopened once compacted.
+	dbMtx  sync.RWMutex
+	db     kvdb.Backend
+	dbPath string
 
 	encKeyMtx sync.RWMutex
 	encKey    *snacl.SecretKey
@@ -42,9 +46,10 @@ func NewSecureStorage(datadir, filename string) (securestore.SecureStorage, erro
 		os.Mkdir(datadir, os.ModeDir|0755)
 	}
 
+	dbPath := filepath.Join(datadir, filename)
 	db, err := kvdb.Create(
 		kvdb.BoltBackendName,
-		filepath.Join(datadir, filename),
+		dbPath,
 		true,
 		kvdb.DefaultDBTimeout,
 	)
@@ -61,7 +66,7 @@ func NewSecureStorage(datadir, filename string) (securestore.SecureStorage, erro
 	}
 
 	// Return the DB wrapped in a SecureStorage object.
-	return &boltSecureStorage{db: db, encKey: nil}, nil
+	return &boltSecureStorage{db: db, dbPath: dbPath, encKey: nil}, nil
 }
 
 // IsLocked returns whether the store is locked by checking if the encryption
@@ -93,7 +98,7 @@ func (s *boltSecureStorage) CreateUnlock(password *[]byte) error {
 	s.encKeyMtx.Lock()
 	defer s.encKeyMtx.Unlock()
 
-	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -147,7 +152,7 @@ func (s *boltSecureStorage) ChangePassword(oldPw, newPw []byte) error {
 	}
 
 	// Check that old password is correct.
-	if err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+	if err := s.view(func(tx kvdb.RTx) error {
 		bucket := tx.ReadBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -177,7 +182,7 @@ func (s *boltSecureStorage) ChangePassword(oldPw, newPw []byte) error {
 
 	// Finally, store the new encryption key parameters in the DB
 	// as well.
-	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -205,7 +210,7 @@ func (s *boltSecureStorage) CreateBucket(key []byte) error {
 		return ErrForbiddenBucketKey
 	}
 
-	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -232,7 +237,7 @@ func (s *boltSecureStorage) AddToBucket(bucketKey, key, value []byte) error {
 		return ErrMissingData
 	}
 
-	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -271,7 +276,7 @@ func (s *boltSecureStorage) GetFromBucket(bucketKey, key []byte) ([]byte, error)
 	}
 
 	var value []byte
-	if err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+	if err := s.view(func(tx kvdb.RTx) error {
 		bucket := tx.ReadBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -312,7 +317,7 @@ func (s *boltSecureStorage) GetAllFromBucket(bucketKey []byte) (map[string][]byt
 	}
 
 	res := make(map[string][]byte)
-	if err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+	if err := s.view(func(tx kvdb.RTx) error {
 		bucket := tx.ReadBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -345,7 +350,7 @@ func (s *boltSecureStorage) GetAllFromBucket(bucketKey []byte) (map[string][]byt
 
 func (s *boltSecureStorage) ListBuckets() ([][]byte, error) {
 	var bucketKeys [][]byte
-	if err := kvdb.View(s.db, func(tx walletdb.ReadTx) error {
+	if err := s.view(func(tx walletdb.ReadTx) error {
 		bucket := tx.ReadBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -374,9 +379,82 @@ func (s *boltSecureStorage) Close() error {
 
 	s.Lock()
 
+	s.dbMtx.Lock()
+	defer s.dbMtx.Unlock()
+
 	return s.db.Close()
 }
 
+// Backup writes a consistent copy of the whole DB to w. The values of the
+// entries remain encrypted.
+func (s *boltSecureStorage) Backup(w io.Writer) error {
+	s.dbMtx.RLock()
+	defer s.dbMtx.RUnlock()
+
+	return s.db.Copy(w)
+}
+
+// Stats returns the size of the DB file, the number of entries stored and
+// the time of the last compaction.
+func (s *boltSecureStorage) Stats() (securestore.StorageStats, error) {
+	s.dbMtx.RLock()
+	defer s.dbMtx.RUnlock()
+
+	fi, err := os.Stat(s.dbPath)
+	if err != nil {
+		return securestore.StorageStats{}, err
+	}
+	lastCompaction, err := kvdb.LastCompactionDate(s.dbPath)
+	if err != nil {
+		return securestore.StorageStats{}, err
+	}
+
+	var count uint64
+	if err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(RootKeyBucketName)
+		if bucket == nil {
+			return ErrRootKeyBucketNotFound
+		}
+		count = countKeys(bucket)
+		return nil
+	}, func() { count = 0 }); err != nil {
+		return securestore.StorageStats{}, err
+	}
+
+	return securestore.StorageStats{
+		DiskUsage:      fi.Size(),
+		KeyCount:       count,
+		LastCompaction: lastCompaction,
+	}, nil
+}
+
+// Compact closes the DB, compacts its file and then opens it again. Any other
+// operation waits for the compaction to complete.
+func (s *boltSecureStorage) Compact() error {
+	s.dbMtx.Lock()
+	defer s.dbMtx.Unlock()
+
+	if err := s.db.Close(); err != nil {
+		return err
+	}
+
+	_, _, compactErr := kvdb.CompactBoltDB(
+		filepath.Dir(s.dbPath), filepath.Base(s.dbPath), kvdb.DefaultDBTimeout,
+	)
+
+	// The DB must be reopened even if the compaction failed, in which case the
+	// original file is left untouched.
+	db, err := kvdb.Open(
+		kvdb.BoltBackendName, s.dbPath, true, kvdb.DefaultDBTimeout,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to reopen db after compaction: %w", err)
+	}
+	s.db = db
+
+	return compactErr
+}
+
 // RemoveFromBucket removes the entry identified by the given key for the given
 // bucket. If bucket key is nil, the entry is removed from the root bucket.
 func (s *boltSecureStorage) RemoveFromBucket(bucketKey, key []byte) error {
@@ -391,7 +469,7 @@ func (s *boltSecureStorage) RemoveFromBucket(bucketKey, key []byte) error {
 		return ErrForbiddenDataKey
 	}
 
-	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -420,7 +498,7 @@ func (s *boltSecureStorage) RemoveBucket(key []byte) error {
 		return ErrForbiddenBucketKey
 	}
 
-	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(RootKeyBucketName)
 		if bucket == nil {
 			return ErrRootKeyBucketNotFound
@@ -430,6 +508,48 @@ func (s *boltSecureStorage) RemoveBucket(key []byte) error {
 	}, func() {})
 }
 
+func (s *boltSecureStorage) update(
+	f func(tx kvdb.RwTx) error, reset func(),
+) error {
+	s.dbMtx.RLock()
+	defer s.dbMtx.RUnlock()
+
+	return kvdb.Update(s.db, f, reset)
+}
+
+func (s *boltSecureStorage) view(f func(tx kvdb.RTx) error, reset func()) error {
+	s.dbMtx.RLock()
+	defer s.dbMtx.RUnlock()
+
+	return kvdb.View(s.db, f, reset)
+}
+
+func (s *boltSecureStorage) batch(f func(tx kvdb.RwTx) error) error {
+	s.dbMtx.RLock()
+	defer s.dbMtx.RUnlock()
+
+	return kvdb.Batch(s.db, f)
+}
+
+// countKeys returns the number of entries of the given bucket and of all its
+// nested ones, the encryption key excluded.
+func countKeys(bucket kvdb.RBucket) uint64 {
+	var count uint64
+	bucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			if nested := bucket.NestedReadBucket(k); nested != nil {
+				count += countKeys(nested)
+			}
+			return nil
+		}
+		if !bytes.Equal(k, encryptionKeyID) {
+			count++
+		}
+		return nil
+	})
+	return count
+}
+
 type bucketData struct {
 	bucketKey []byte
 	data      map[string][]byte
@@ -481,7 +601,7 @@ func (s *boltSecureStorage) updateEncryptedDb(newKey *snacl.SecretKey) error {
 			bucketKey := []byte(kk[0])
 			key := []byte(kk[1])
 
-			kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+			s.batch(func(tx kvdb.RwTx) error {
 				bucket := tx.ReadWriteBucket(RootKeyBucketName)
 				if len(bucketKey) > 0 {
 					bucket = bucket.NestedReadWriteBucket(bucketKey)
@@ -1,6 +1,7 @@
 package boltsecurestore_test
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -489,6 +490,50 @@ func TestChangePassword(t *testing.T) {
 	require.Equal(t, nestedDataValue, nestedVal)
 }
 
+func TestStatsAndCompact(t *testing.T) {
+	store, clean, err := newTestStoreUnlocked()
+	require.NoError(t, err)
+
+	t.Cleanup(clean)
+
+	compactable, ok := store.(securestore.CompactableStorage)
+	require.True(t, ok)
+
+	stats, err := compactable.Stats()
+	require.NoError(t, err)
+	require.Zero(t, stats.KeyCount)
+	require.Zero(t, stats.LastCompaction.Unix())
+
+	bucketKey := []byte("nested")
+	err = store.CreateBucket(bucketKey)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		err = store.AddToBucket(bucketKey, key, []byte("value"))
+		require.NoError(t, err)
+	}
+	err = store.AddToBucket(nil, []byte("key"), []byte("value"))
+	require.NoError(t, err)
+
+	stats, err = compactable.Stats()
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), stats.KeyCount)
+	require.Positive(t, stats.DiskUsage)
+
+	err = compactable.Compact()
+	require.NoError(t, err)
+
+	// The store must still be usable once compacted.
+	val, err := store.GetFromBucket(bucketKey, []byte("key0"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), val)
+
+	compactedStats, err := compactable.Stats()
+	require.NoError(t, err)
+	require.Equal(t, stats.KeyCount, compactedStats.KeyCount)
+	require.NotZero(t, compactedStats.LastCompaction.Unix())
+}
+
 func TestFailingChangePassword(t *testing.T) {
 	store, clean, err := newTestStoreUnlocked()
 	require.NoError(t, err)
@@ -114,11 +114,10 @@ func compactAndSwap(cfg *BoltBackendConfig) error {
 		return fmt.Errorf("cannot compact DB with empty name")
 	}
 	sourceFilePath := filepath.Join(cfg.DBPath, sourceName)
-	tempDestFilePath := filepath.Join(cfg.DBPath, DefaultTempDBFileName)
 
 	// Let's find out how long ago the last compaction of the source file
 	// occurred and possibly skip compacting it again now.
-	lastCompactionDate, err := lastCompactionDate(sourceFilePath)
+	lastCompactionDate, err := LastCompactionDate(sourceFilePath)
 	if err != nil {
 		return fmt.Errorf("cannot determine last compaction date of "+
 			"source DB file: %v", err)
@@ -132,6 +131,23 @@ func compactAndSwap(cfg *BoltBackendConfig) error {
 		return nil
 	}
 
+	_, _, err = CompactBoltDB(cfg.DBPath, sourceName, cfg.DBTimeout)
+	return err
+}
+
+// CompactBoltDB writes a new temporary DB file to disk with the compacted
+// content of the given one, then atomically swaps them. The DB must not be
+// open while it's compacted. It returns the size of the DB file before and
+// after the compaction.
+func CompactBoltDB(
+	dbPath, dbFileName string, dbTimeout time.Duration,
+) (int64, int64, error) {
+	if dbFileName == "" {
+		return 0, 0, fmt.Errorf("cannot compact DB with empty name")
+	}
+	sourceFilePath := filepath.Join(dbPath, dbFileName)
+	tempDestFilePath := filepath.Join(dbPath, DefaultTempDBFileName)
+
 	log.Infof("Compacting database file at %v", sourceFilePath)
 
 	// If the old temporary DB file still exists, then we'll delete it
@@ -142,7 +158,7 @@ func compactAndSwap(cfg *BoltBackendConfig) error {
 
 		err = os.Remove(tempDestFilePath)
 		if err != nil {
-			return fmt.Errorf("unable to remove old temp DB file: "+
+			return 0, 0, fmt.Errorf("unable to remove old temp DB file: "+
 				"%v", err)
 		}
 	}
@@ -151,10 +167,10 @@ func compactAndSwap(cfg *BoltBackendConfig) error {
 	// temporary DB file and close it before we write the new DB to it.
 	tempFile, err := os.Create(tempDestFilePath)
 	if err != nil {
-		return fmt.Errorf("unable to create temp DB file: %v", err)
+		return 0, 0, fmt.Errorf("unable to create temp DB file: %v", err)
 	}
 	if err := tempFile.Close(); err != nil {
-		return fmt.Errorf("unable to close file: %v", err)
+		return 0, 0, fmt.Errorf("unable to close file: %v", err)
 	}
 
 	// With the file created, we'll start the compaction and remove the
@@ -168,11 +184,11 @@ func compactAndSwap(cfg *BoltBackendConfig) error {
 	c := &compacter{
 		srcPath:   sourceFilePath,
 		dstPath:   tempDestFilePath,
-		dbTimeout: cfg.DBTimeout,
+		dbTimeout: dbTimeout,
 	}
 	initialSize, newSize, err := c.execute()
 	if err != nil {
-		return fmt.Errorf("error during compact: %v", err)
+		return 0, 0, fmt.Errorf("error during compact: %v", err)
 	}
 
 	log.Infof("DB compaction of %v successful, %d -> %d bytes (gain=%.2fx)",
@@ -197,14 +213,17 @@ func compactAndSwap(cfg *BoltBackendConfig) error {
 	// Finally, we'll attempt to atomically rename the temporary file to
 	// the main back up file. If this succeeds, then we'll only have a
 	// single file on disk once this method exits.
-	return os.Rename(tempDestFilePath, sourceFilePath)
+	if err := os.Rename(tempDestFilePath, sourceFilePath); err != nil {
+		return 0, 0, err
+	}
+	return initialSize, newSize, nil
 }
 
-// lastCompactionDate returns the date the given database file was last
+// LastCompactionDate returns the date the given database file was last
 // compacted or a zero time.Time if no compaction was recorded before. The
 // compaction date is read from a file in the same directory and with the same
 // name as the DB file, but with the suffix ".last-compacted".
-func lastCompactionDate(dbFile string) (time.Time, error) {
+func LastCompactionDate(dbFile string) (time.Time, error) {
 	zeroTime := time.Unix(0, 0)
 
 	tsFile := fmt.Sprintf("%s%s", dbFile, LastCompactionFileNameSuffix)
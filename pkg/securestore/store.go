@@ -31,6 +31,8 @@
 
 package securestore
 
+import "time"
+
 // SecureStorage interface defines the methods for a key/value DB that secures
 // its content by encrypting the values of the entries.
 type SecureStorage interface {
@@ -59,3 +61,23 @@ type SecureStorage interface {
 	// RemoveBucket removes a bucket from the root one.
 	RemoveBucket(bucketKey []byte) (err error)
 }
+
+// StorageStats holds the usage stats of a SecureStorage.
+type StorageStats struct {
+	// DiskUsage is the size in bytes of the DB on disk.
+	DiskUsage int64
+	// KeyCount is the number of entries stored in the DB, buckets excluded.
+	KeyCount uint64
+	// LastCompaction is the time the DB was last compacted, the unix epoch if
+	// never.
+	LastCompaction time.Time
+}
+
+// CompactableStorage is implemented by the SecureStorage whose DB can be
+// inspected and compacted while in use.
+type CompactableStorage interface {
+	// Stats returns the usage stats of the DB.
+	Stats() (stats StorageStats, err error)
+	// Compact rewrites the DB to reclaim the space left unused on disk.
+	Compact() (err error)
+}
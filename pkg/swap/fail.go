@@ -15,6 +15,8 @@ const (
 	ErrCodeBadPricingSwapRequest
 	ErrCodeAborted
 	ErrCodeFailedToBroadcast
+	ErrCodeTooManyRequests
+	ErrCodeTooManyPendingTrades
 )
 
 var errMsg = map[int]string{
@@ -25,6 +27,8 @@ var errMsg = map[int]string{
 	ErrCodeBadPricingSwapRequest: "swap request price not accepted",
 	ErrCodeAborted:               "aborted by counter-party ",
 	ErrCodeFailedToBroadcast:     "swap completed but didn't get included in blockchain ",
+	ErrCodeTooManyRequests:       "too many requests, retry later",
+	ErrCodeTooManyPendingTrades:  "too many pending trades for market, retry later",
 }
 
 type FailOpts struct {
@@ -189,7 +189,7 @@ func main() {
 
 	log.Info("setting up market feeder...")
 	out, err = runCLICommand(
-		"feeder", "add", "--source", "kraken", "--ticker", "XBT/USDT",
+		"feeder", "add", "--source", "static", "--ticker", `{"price": "20000"}`,
 	)
 	if err != nil {
 		log.WithError(err).Fatal("failed to add market feeder")
@@ -198,6 +198,11 @@ func main() {
 	if _, err = runCLICommand("feeder", "start", "--id", feederId); err != nil {
 		log.WithError(err).Fatal("failed to start market feeder")
 	}
+	if _, err = runCLICommand(
+		"feeder", "push", "--id", feederId, "--quote-price", "20100",
+	); err != nil {
+		log.WithError(err).Fatal("failed to push price to market feeder")
+	}
 	log.Infof("done\n\n")
 
 	time.Sleep(20 * time.Second)